@@ -0,0 +1,277 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: miniwasm/sendrestriction/v1/tx.proto
+
+package sendrestrictionv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Msg_UpdateParams_FullMethodName        = "/miniwasm.sendrestriction.v1.Msg/UpdateParams"
+	Msg_AddToDenylist_FullMethodName       = "/miniwasm.sendrestriction.v1.Msg/AddToDenylist"
+	Msg_RemoveFromDenylist_FullMethodName  = "/miniwasm.sendrestriction.v1.Msg/RemoveFromDenylist"
+	Msg_AddToAllowlist_FullMethodName      = "/miniwasm.sendrestriction.v1.Msg/AddToAllowlist"
+	Msg_RemoveFromAllowlist_FullMethodName = "/miniwasm.sendrestriction.v1.Msg/RemoveFromAllowlist"
+)
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateParams defines an operation for updating the x/sendrestriction
+	// module parameters.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// AddToDenylist defines a governance operation for adding addresses to the
+	// denylist.
+	AddToDenylist(ctx context.Context, in *MsgAddToDenylist, opts ...grpc.CallOption) (*MsgAddToDenylistResponse, error)
+	// RemoveFromDenylist defines a governance operation for removing addresses
+	// from the denylist.
+	RemoveFromDenylist(ctx context.Context, in *MsgRemoveFromDenylist, opts ...grpc.CallOption) (*MsgRemoveFromDenylistResponse, error)
+	// AddToAllowlist defines a governance operation for adding addresses to the
+	// allowlist.
+	AddToAllowlist(ctx context.Context, in *MsgAddToAllowlist, opts ...grpc.CallOption) (*MsgAddToAllowlistResponse, error)
+	// RemoveFromAllowlist defines a governance operation for removing addresses
+	// from the allowlist.
+	RemoveFromAllowlist(ctx context.Context, in *MsgRemoveFromAllowlist, opts ...grpc.CallOption) (*MsgRemoveFromAllowlistResponse, error)
+}
+
+type msgClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMsgClient(cc grpc.ClientConnInterface) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateParams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddToDenylist(ctx context.Context, in *MsgAddToDenylist, opts ...grpc.CallOption) (*MsgAddToDenylistResponse, error) {
+	out := new(MsgAddToDenylistResponse)
+	err := c.cc.Invoke(ctx, Msg_AddToDenylist_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveFromDenylist(ctx context.Context, in *MsgRemoveFromDenylist, opts ...grpc.CallOption) (*MsgRemoveFromDenylistResponse, error) {
+	out := new(MsgRemoveFromDenylistResponse)
+	err := c.cc.Invoke(ctx, Msg_RemoveFromDenylist_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddToAllowlist(ctx context.Context, in *MsgAddToAllowlist, opts ...grpc.CallOption) (*MsgAddToAllowlistResponse, error) {
+	out := new(MsgAddToAllowlistResponse)
+	err := c.cc.Invoke(ctx, Msg_AddToAllowlist_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveFromAllowlist(ctx context.Context, in *MsgRemoveFromAllowlist, opts ...grpc.CallOption) (*MsgRemoveFromAllowlistResponse, error) {
+	out := new(MsgRemoveFromAllowlistResponse)
+	err := c.cc.Invoke(ctx, Msg_RemoveFromAllowlist_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+// All implementations must embed UnimplementedMsgServer
+// for forward compatibility
+type MsgServer interface {
+	// UpdateParams defines an operation for updating the x/sendrestriction
+	// module parameters.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// AddToDenylist defines a governance operation for adding addresses to the
+	// denylist.
+	AddToDenylist(context.Context, *MsgAddToDenylist) (*MsgAddToDenylistResponse, error)
+	// RemoveFromDenylist defines a governance operation for removing addresses
+	// from the denylist.
+	RemoveFromDenylist(context.Context, *MsgRemoveFromDenylist) (*MsgRemoveFromDenylistResponse, error)
+	// AddToAllowlist defines a governance operation for adding addresses to the
+	// allowlist.
+	AddToAllowlist(context.Context, *MsgAddToAllowlist) (*MsgAddToAllowlistResponse, error)
+	// RemoveFromAllowlist defines a governance operation for removing addresses
+	// from the allowlist.
+	RemoveFromAllowlist(context.Context, *MsgRemoveFromAllowlist) (*MsgRemoveFromAllowlistResponse, error)
+	mustEmbedUnimplementedMsgServer()
+}
+
+// UnimplementedMsgServer must be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (UnimplementedMsgServer) AddToDenylist(context.Context, *MsgAddToDenylist) (*MsgAddToDenylistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddToDenylist not implemented")
+}
+func (UnimplementedMsgServer) RemoveFromDenylist(context.Context, *MsgRemoveFromDenylist) (*MsgRemoveFromDenylistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFromDenylist not implemented")
+}
+func (UnimplementedMsgServer) AddToAllowlist(context.Context, *MsgAddToAllowlist) (*MsgAddToAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddToAllowlist not implemented")
+}
+func (UnimplementedMsgServer) RemoveFromAllowlist(context.Context, *MsgRemoveFromAllowlist) (*MsgRemoveFromAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFromAllowlist not implemented")
+}
+func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
+
+// UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MsgServer will
+// result in compilation errors.
+type UnsafeMsgServer interface {
+	mustEmbedUnimplementedMsgServer()
+}
+
+func RegisterMsgServer(s grpc.ServiceRegistrar, srv MsgServer) {
+	s.RegisterService(&Msg_ServiceDesc, srv)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddToDenylist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddToDenylist)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddToDenylist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AddToDenylist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddToDenylist(ctx, req.(*MsgAddToDenylist))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveFromDenylist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveFromDenylist)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveFromDenylist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoveFromDenylist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveFromDenylist(ctx, req.(*MsgRemoveFromDenylist))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddToAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddToAllowlist)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddToAllowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AddToAllowlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddToAllowlist(ctx, req.(*MsgAddToAllowlist))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveFromAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveFromAllowlist)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveFromAllowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoveFromAllowlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveFromAllowlist(ctx, req.(*MsgRemoveFromAllowlist))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Msg_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "miniwasm.sendrestriction.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "AddToDenylist",
+			Handler:    _Msg_AddToDenylist_Handler,
+		},
+		{
+			MethodName: "RemoveFromDenylist",
+			Handler:    _Msg_RemoveFromDenylist_Handler,
+		},
+		{
+			MethodName: "AddToAllowlist",
+			Handler:    _Msg_AddToAllowlist_Handler,
+		},
+		{
+			MethodName: "RemoveFromAllowlist",
+			Handler:    _Msg_RemoveFromAllowlist_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "miniwasm/sendrestriction/v1/tx.proto",
+}
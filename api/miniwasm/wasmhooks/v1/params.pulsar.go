@@ -0,0 +1,788 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package wasmhooksv1
+
+import (
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var (
+	md_Params                        protoreflect.MessageDescriptor
+	fd_Params_callback_gas_limit     protoreflect.FieldDescriptor
+	fd_Params_failed_callback_expiry protoreflect.FieldDescriptor
+	fd_Params_acl_mode               protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_miniwasm_wasmhooks_v1_params_proto_init()
+	md_Params = File_miniwasm_wasmhooks_v1_params_proto.Messages().ByName("Params")
+	fd_Params_callback_gas_limit = md_Params.Fields().ByName("callback_gas_limit")
+	fd_Params_failed_callback_expiry = md_Params.Fields().ByName("failed_callback_expiry")
+	fd_Params_acl_mode = md_Params.Fields().ByName("acl_mode")
+}
+
+var _ protoreflect.Message = (*fastReflection_Params)(nil)
+
+type fastReflection_Params Params
+
+func (x *Params) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Params)(x)
+}
+
+func (x *Params) slowProtoReflect() protoreflect.Message {
+	mi := &file_miniwasm_wasmhooks_v1_params_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Params_messageType fastReflection_Params_messageType
+var _ protoreflect.MessageType = fastReflection_Params_messageType{}
+
+type fastReflection_Params_messageType struct{}
+
+func (x fastReflection_Params_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Params)(nil)
+}
+func (x fastReflection_Params_messageType) New() protoreflect.Message {
+	return new(fastReflection_Params)
+}
+func (x fastReflection_Params_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Params
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Params) Descriptor() protoreflect.MessageDescriptor {
+	return md_Params
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Params) Type() protoreflect.MessageType {
+	return _fastReflection_Params_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Params) New() protoreflect.Message {
+	return new(fastReflection_Params)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
+	return (*Params)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.CallbackGasLimit != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.CallbackGasLimit)
+		if !f(fd_Params_callback_gas_limit, value) {
+			return
+		}
+	}
+	if x.FailedCallbackExpiry != nil {
+		value := protoreflect.ValueOfMessage(x.FailedCallbackExpiry.ProtoReflect())
+		if !f(fd_Params_failed_callback_expiry, value) {
+			return
+		}
+	}
+	if x.AclMode != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.AclMode))
+		if !f(fd_Params_acl_mode, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "miniwasm.wasmhooks.v1.Params.callback_gas_limit":
+		return x.CallbackGasLimit != uint64(0)
+	case "miniwasm.wasmhooks.v1.Params.failed_callback_expiry":
+		return x.FailedCallbackExpiry != nil
+	case "miniwasm.wasmhooks.v1.Params.acl_mode":
+		return x.AclMode != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: miniwasm.wasmhooks.v1.Params"))
+		}
+		panic(fmt.Errorf("message miniwasm.wasmhooks.v1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "miniwasm.wasmhooks.v1.Params.callback_gas_limit":
+		x.CallbackGasLimit = uint64(0)
+	case "miniwasm.wasmhooks.v1.Params.failed_callback_expiry":
+		x.FailedCallbackExpiry = nil
+	case "miniwasm.wasmhooks.v1.Params.acl_mode":
+		x.AclMode = 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: miniwasm.wasmhooks.v1.Params"))
+		}
+		panic(fmt.Errorf("message miniwasm.wasmhooks.v1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "miniwasm.wasmhooks.v1.Params.callback_gas_limit":
+		value := x.CallbackGasLimit
+		return protoreflect.ValueOfUint64(value)
+	case "miniwasm.wasmhooks.v1.Params.failed_callback_expiry":
+		value := x.FailedCallbackExpiry
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "miniwasm.wasmhooks.v1.Params.acl_mode":
+		value := x.AclMode
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: miniwasm.wasmhooks.v1.Params"))
+		}
+		panic(fmt.Errorf("message miniwasm.wasmhooks.v1.Params does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "miniwasm.wasmhooks.v1.Params.callback_gas_limit":
+		x.CallbackGasLimit = value.Uint()
+	case "miniwasm.wasmhooks.v1.Params.failed_callback_expiry":
+		x.FailedCallbackExpiry = value.Message().Interface().(*durationpb.Duration)
+	case "miniwasm.wasmhooks.v1.Params.acl_mode":
+		x.AclMode = (AclMode)(value.Enum())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: miniwasm.wasmhooks.v1.Params"))
+		}
+		panic(fmt.Errorf("message miniwasm.wasmhooks.v1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "miniwasm.wasmhooks.v1.Params.failed_callback_expiry":
+		if x.FailedCallbackExpiry == nil {
+			x.FailedCallbackExpiry = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.FailedCallbackExpiry.ProtoReflect())
+	case "miniwasm.wasmhooks.v1.Params.callback_gas_limit":
+		panic(fmt.Errorf("field callback_gas_limit of message miniwasm.wasmhooks.v1.Params is not mutable"))
+	case "miniwasm.wasmhooks.v1.Params.acl_mode":
+		panic(fmt.Errorf("field acl_mode of message miniwasm.wasmhooks.v1.Params is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: miniwasm.wasmhooks.v1.Params"))
+		}
+		panic(fmt.Errorf("message miniwasm.wasmhooks.v1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "miniwasm.wasmhooks.v1.Params.callback_gas_limit":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "miniwasm.wasmhooks.v1.Params.failed_callback_expiry":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "miniwasm.wasmhooks.v1.Params.acl_mode":
+		return protoreflect.ValueOfEnum(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: miniwasm.wasmhooks.v1.Params"))
+		}
+		panic(fmt.Errorf("message miniwasm.wasmhooks.v1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Params) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in miniwasm.wasmhooks.v1.Params", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Params) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Params) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.CallbackGasLimit != 0 {
+			n += 1 + runtime.Sov(uint64(x.CallbackGasLimit))
+		}
+		if x.FailedCallbackExpiry != nil {
+			l = options.Size(x.FailedCallbackExpiry)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.AclMode != 0 {
+			n += 1 + runtime.Sov(uint64(x.AclMode))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.AclMode != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AclMode))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.FailedCallbackExpiry != nil {
+			encoded, err := options.Marshal(x.FailedCallbackExpiry)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.CallbackGasLimit != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CallbackGasLimit))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CallbackGasLimit", wireType)
+				}
+				x.CallbackGasLimit = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CallbackGasLimit |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FailedCallbackExpiry", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.FailedCallbackExpiry == nil {
+					x.FailedCallbackExpiry = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FailedCallbackExpiry); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AclMode", wireType)
+				}
+				x.AclMode = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AclMode |= AclMode(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: miniwasm/wasmhooks/v1/params.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// AclMode defines the global access control mode of wasm hooks.
+type AclMode int32
+
+const (
+	// ACL_MODE_RESTRICTED uses the contract acl of the contract if exists,
+	// and falls back to the ibchooks allowed flag otherwise.
+	AclMode_ACL_MODE_RESTRICTED AclMode = 0
+	// ACL_MODE_OPEN allows all contracts to be used in wasm hooks. This mode
+	// is intended for devnets.
+	AclMode_ACL_MODE_OPEN AclMode = 1
+)
+
+// Enum value maps for AclMode.
+var (
+	AclMode_name = map[int32]string{
+		0: "ACL_MODE_RESTRICTED",
+		1: "ACL_MODE_OPEN",
+	}
+	AclMode_value = map[string]int32{
+		"ACL_MODE_RESTRICTED": 0,
+		"ACL_MODE_OPEN":       1,
+	}
+)
+
+func (x AclMode) Enum() *AclMode {
+	p := new(AclMode)
+	*p = x
+	return p
+}
+
+func (x AclMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AclMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_miniwasm_wasmhooks_v1_params_proto_enumTypes[0].Descriptor()
+}
+
+func (AclMode) Type() protoreflect.EnumType {
+	return &file_miniwasm_wasmhooks_v1_params_proto_enumTypes[0]
+}
+
+func (x AclMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AclMode.Descriptor instead.
+func (AclMode) EnumDescriptor() ([]byte, []int) {
+	return file_miniwasm_wasmhooks_v1_params_proto_rawDescGZIP(), []int{0}
+}
+
+// Params defines the parameters for the wasmhooks module.
+type Params struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// CallbackGasLimit defines the maximum amount of gas an async callback
+	// (ack or timeout) can consume. The relayer pays for the callback, so the
+	// limit prevents a contract from griefing relayers. Zero disables the limit.
+	CallbackGasLimit uint64 `protobuf:"varint,1,opt,name=callback_gas_limit,json=callbackGasLimit,proto3" json:"callback_gas_limit,omitempty"`
+	// FailedCallbackExpiry defines how long a failed callback is kept in the
+	// retry queue before it is pruned.
+	FailedCallbackExpiry *durationpb.Duration `protobuf:"bytes,2,opt,name=failed_callback_expiry,json=failedCallbackExpiry,proto3" json:"failed_callback_expiry,omitempty"`
+	// AclMode defines how the hook permissions of contracts are resolved.
+	AclMode AclMode `protobuf:"varint,3,opt,name=acl_mode,json=aclMode,proto3,enum=miniwasm.wasmhooks.v1.AclMode" json:"acl_mode,omitempty"`
+}
+
+func (x *Params) Reset() {
+	*x = Params{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_miniwasm_wasmhooks_v1_params_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Params) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Params) ProtoMessage() {}
+
+// Deprecated: Use Params.ProtoReflect.Descriptor instead.
+func (*Params) Descriptor() ([]byte, []int) {
+	return file_miniwasm_wasmhooks_v1_params_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Params) GetCallbackGasLimit() uint64 {
+	if x != nil {
+		return x.CallbackGasLimit
+	}
+	return 0
+}
+
+func (x *Params) GetFailedCallbackExpiry() *durationpb.Duration {
+	if x != nil {
+		return x.FailedCallbackExpiry
+	}
+	return nil
+}
+
+func (x *Params) GetAclMode() AclMode {
+	if x != nil {
+		return x.AclMode
+	}
+	return AclMode_ACL_MODE_RESTRICTED
+}
+
+var File_miniwasm_wasmhooks_v1_params_proto protoreflect.FileDescriptor
+
+var file_miniwasm_wasmhooks_v1_params_proto_rawDesc = []byte{
+	0x0a, 0x22, 0x6d, 0x69, 0x6e, 0x69, 0x77, 0x61, 0x73, 0x6d, 0x2f, 0x77, 0x61, 0x73, 0x6d, 0x68,
+	0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x15, 0x6d, 0x69, 0x6e, 0x69, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x77,
+	0x61, 0x73, 0x6d, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x2e, 0x76, 0x31, 0x1a, 0x14, 0x67, 0x6f, 0x67,
+	0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0xa1, 0x02, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x4b, 0x0a, 0x12,
+	0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1d, 0xf2, 0xde, 0x1f, 0x19, 0x79, 0x61,
+	0x6d, 0x6c, 0x3a, 0x22, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x67, 0x61, 0x73,
+	0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x52, 0x10, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63,
+	0x6b, 0x47, 0x61, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x7a, 0x0a, 0x16, 0x66, 0x61, 0x69,
+	0x6c, 0x65, 0x64, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x29, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x1d, 0x79, 0x61,
+	0x6d, 0x6c, 0x3a, 0x22, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x62,
+	0x61, 0x63, 0x6b, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x22, 0x98, 0xdf, 0x1f, 0x01, 0x52,
+	0x14, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x45,
+	0x78, 0x70, 0x69, 0x72, 0x79, 0x12, 0x4e, 0x0a, 0x08, 0x61, 0x63, 0x6c, 0x5f, 0x6d, 0x6f, 0x64,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x6d, 0x69, 0x6e, 0x69, 0x77, 0x61,
+	0x73, 0x6d, 0x2e, 0x77, 0x61, 0x73, 0x6d, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x63, 0x6c, 0x4d, 0x6f, 0x64, 0x65, 0x42, 0x13, 0xf2, 0xde, 0x1f, 0x0f, 0x79, 0x61, 0x6d,
+	0x6c, 0x3a, 0x22, 0x61, 0x63, 0x6c, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0x52, 0x07, 0x61, 0x63,
+	0x6c, 0x4d, 0x6f, 0x64, 0x65, 0x2a, 0x63, 0x0a, 0x07, 0x41, 0x63, 0x6c, 0x4d, 0x6f, 0x64, 0x65,
+	0x12, 0x2e, 0x0a, 0x13, 0x41, 0x43, 0x4c, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x52, 0x45, 0x53,
+	0x54, 0x52, 0x49, 0x43, 0x54, 0x45, 0x44, 0x10, 0x00, 0x1a, 0x15, 0x8a, 0x9d, 0x20, 0x11, 0x41,
+	0x63, 0x6c, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x65, 0x64,
+	0x12, 0x22, 0x0a, 0x0d, 0x41, 0x43, 0x4c, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x4f, 0x50, 0x45,
+	0x4e, 0x10, 0x01, 0x1a, 0x0f, 0x8a, 0x9d, 0x20, 0x0b, 0x41, 0x63, 0x6c, 0x4d, 0x6f, 0x64, 0x65,
+	0x4f, 0x70, 0x65, 0x6e, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x42, 0xd2, 0x01, 0x0a, 0x19, 0x63,
+	0x6f, 0x6d, 0x2e, 0x6d, 0x69, 0x6e, 0x69, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x77, 0x61, 0x73, 0x6d,
+	0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x32, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
+	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x69, 0x6e, 0x69, 0x77, 0x61,
+	0x73, 0x6d, 0x2f, 0x77, 0x61, 0x73, 0x6d, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x76, 0x31, 0x3b,
+	0x77, 0x61, 0x73, 0x6d, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4d, 0x57,
+	0x58, 0xaa, 0x02, 0x15, 0x4d, 0x69, 0x6e, 0x69, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x57, 0x61, 0x73,
+	0x6d, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x15, 0x4d, 0x69, 0x6e, 0x69,
+	0x77, 0x61, 0x73, 0x6d, 0x5c, 0x57, 0x61, 0x73, 0x6d, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x5c, 0x56,
+	0x31, 0xe2, 0x02, 0x21, 0x4d, 0x69, 0x6e, 0x69, 0x77, 0x61, 0x73, 0x6d, 0x5c, 0x57, 0x61, 0x73,
+	0x6d, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x17, 0x4d, 0x69, 0x6e, 0x69, 0x77, 0x61, 0x73, 0x6d,
+	0x3a, 0x3a, 0x57, 0x61, 0x73, 0x6d, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x3a, 0x3a, 0x56, 0x31, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_miniwasm_wasmhooks_v1_params_proto_rawDescOnce sync.Once
+	file_miniwasm_wasmhooks_v1_params_proto_rawDescData = file_miniwasm_wasmhooks_v1_params_proto_rawDesc
+)
+
+func file_miniwasm_wasmhooks_v1_params_proto_rawDescGZIP() []byte {
+	file_miniwasm_wasmhooks_v1_params_proto_rawDescOnce.Do(func() {
+		file_miniwasm_wasmhooks_v1_params_proto_rawDescData = protoimpl.X.CompressGZIP(file_miniwasm_wasmhooks_v1_params_proto_rawDescData)
+	})
+	return file_miniwasm_wasmhooks_v1_params_proto_rawDescData
+}
+
+var file_miniwasm_wasmhooks_v1_params_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_miniwasm_wasmhooks_v1_params_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_miniwasm_wasmhooks_v1_params_proto_goTypes = []interface{}{
+	(AclMode)(0),                // 0: miniwasm.wasmhooks.v1.AclMode
+	(*Params)(nil),              // 1: miniwasm.wasmhooks.v1.Params
+	(*durationpb.Duration)(nil), // 2: google.protobuf.Duration
+}
+var file_miniwasm_wasmhooks_v1_params_proto_depIdxs = []int32{
+	2, // 0: miniwasm.wasmhooks.v1.Params.failed_callback_expiry:type_name -> google.protobuf.Duration
+	0, // 1: miniwasm.wasmhooks.v1.Params.acl_mode:type_name -> miniwasm.wasmhooks.v1.AclMode
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_miniwasm_wasmhooks_v1_params_proto_init() }
+func file_miniwasm_wasmhooks_v1_params_proto_init() {
+	if File_miniwasm_wasmhooks_v1_params_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_miniwasm_wasmhooks_v1_params_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Params); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_miniwasm_wasmhooks_v1_params_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_miniwasm_wasmhooks_v1_params_proto_goTypes,
+		DependencyIndexes: file_miniwasm_wasmhooks_v1_params_proto_depIdxs,
+		EnumInfos:         file_miniwasm_wasmhooks_v1_params_proto_enumTypes,
+		MessageInfos:      file_miniwasm_wasmhooks_v1_params_proto_msgTypes,
+	}.Build()
+	File_miniwasm_wasmhooks_v1_params_proto = out.File
+	file_miniwasm_wasmhooks_v1_params_proto_rawDesc = nil
+	file_miniwasm_wasmhooks_v1_params_proto_goTypes = nil
+	file_miniwasm_wasmhooks_v1_params_proto_depIdxs = nil
+}
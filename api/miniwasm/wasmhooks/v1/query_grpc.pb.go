@@ -0,0 +1,316 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: miniwasm/wasmhooks/v1/query.proto
+
+package wasmhooksv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Query_Params_FullMethodName          = "/miniwasm.wasmhooks.v1.Query/Params"
+	Query_FailedCallback_FullMethodName  = "/miniwasm.wasmhooks.v1.Query/FailedCallback"
+	Query_FailedCallbacks_FullMethodName = "/miniwasm.wasmhooks.v1.Query/FailedCallbacks"
+	Query_ContractACL_FullMethodName     = "/miniwasm.wasmhooks.v1.Query/ContractACL"
+	Query_ContractACLs_FullMethodName    = "/miniwasm.wasmhooks.v1.Query/ContractACLs"
+	Query_SimulateIBCHook_FullMethodName = "/miniwasm.wasmhooks.v1.Query/SimulateIBCHook"
+)
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params defines a gRPC query method that returns the wasmhooks module's
+	// parameters.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// FailedCallback defines a gRPC query method for fetching a failed
+	// callback by id.
+	FailedCallback(ctx context.Context, in *QueryFailedCallbackRequest, opts ...grpc.CallOption) (*QueryFailedCallbackResponse, error)
+	// FailedCallbacks defines a gRPC query method for fetching all failed
+	// callbacks waiting in the retry queue.
+	FailedCallbacks(ctx context.Context, in *QueryFailedCallbacksRequest, opts ...grpc.CallOption) (*QueryFailedCallbacksResponse, error)
+	// ContractACL defines a gRPC query method for fetching the acl of a
+	// contract.
+	ContractACL(ctx context.Context, in *QueryContractACLRequest, opts ...grpc.CallOption) (*QueryContractACLResponse, error)
+	// ContractACLs defines a gRPC query method for fetching all contract acls.
+	ContractACLs(ctx context.Context, in *QueryContractACLsRequest, opts ...grpc.CallOption) (*QueryContractACLsResponse, error)
+	// SimulateIBCHook defines a gRPC query method for simulating the receive
+	// of a packet with a wasm hook memo without committing any state.
+	SimulateIBCHook(ctx context.Context, in *QuerySimulateIBCHookRequest, opts ...grpc.CallOption) (*QuerySimulateIBCHookResponse, error)
+}
+
+type queryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewQueryClient(cc grpc.ClientConnInterface) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, Query_Params_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FailedCallback(ctx context.Context, in *QueryFailedCallbackRequest, opts ...grpc.CallOption) (*QueryFailedCallbackResponse, error) {
+	out := new(QueryFailedCallbackResponse)
+	err := c.cc.Invoke(ctx, Query_FailedCallback_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FailedCallbacks(ctx context.Context, in *QueryFailedCallbacksRequest, opts ...grpc.CallOption) (*QueryFailedCallbacksResponse, error) {
+	out := new(QueryFailedCallbacksResponse)
+	err := c.cc.Invoke(ctx, Query_FailedCallbacks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ContractACL(ctx context.Context, in *QueryContractACLRequest, opts ...grpc.CallOption) (*QueryContractACLResponse, error) {
+	out := new(QueryContractACLResponse)
+	err := c.cc.Invoke(ctx, Query_ContractACL_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ContractACLs(ctx context.Context, in *QueryContractACLsRequest, opts ...grpc.CallOption) (*QueryContractACLsResponse, error) {
+	out := new(QueryContractACLsResponse)
+	err := c.cc.Invoke(ctx, Query_ContractACLs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SimulateIBCHook(ctx context.Context, in *QuerySimulateIBCHookRequest, opts ...grpc.CallOption) (*QuerySimulateIBCHookResponse, error) {
+	out := new(QuerySimulateIBCHookResponse)
+	err := c.cc.Invoke(ctx, Query_SimulateIBCHook_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+// All implementations must embed UnimplementedQueryServer
+// for forward compatibility
+type QueryServer interface {
+	// Params defines a gRPC query method that returns the wasmhooks module's
+	// parameters.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// FailedCallback defines a gRPC query method for fetching a failed
+	// callback by id.
+	FailedCallback(context.Context, *QueryFailedCallbackRequest) (*QueryFailedCallbackResponse, error)
+	// FailedCallbacks defines a gRPC query method for fetching all failed
+	// callbacks waiting in the retry queue.
+	FailedCallbacks(context.Context, *QueryFailedCallbacksRequest) (*QueryFailedCallbacksResponse, error)
+	// ContractACL defines a gRPC query method for fetching the acl of a
+	// contract.
+	ContractACL(context.Context, *QueryContractACLRequest) (*QueryContractACLResponse, error)
+	// ContractACLs defines a gRPC query method for fetching all contract acls.
+	ContractACLs(context.Context, *QueryContractACLsRequest) (*QueryContractACLsResponse, error)
+	// SimulateIBCHook defines a gRPC query method for simulating the receive
+	// of a packet with a wasm hook memo without committing any state.
+	SimulateIBCHook(context.Context, *QuerySimulateIBCHookRequest) (*QuerySimulateIBCHookResponse, error)
+	mustEmbedUnimplementedQueryServer()
+}
+
+// UnimplementedQueryServer must be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (UnimplementedQueryServer) FailedCallback(context.Context, *QueryFailedCallbackRequest) (*QueryFailedCallbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FailedCallback not implemented")
+}
+func (UnimplementedQueryServer) FailedCallbacks(context.Context, *QueryFailedCallbacksRequest) (*QueryFailedCallbacksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FailedCallbacks not implemented")
+}
+func (UnimplementedQueryServer) ContractACL(context.Context, *QueryContractACLRequest) (*QueryContractACLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractACL not implemented")
+}
+func (UnimplementedQueryServer) ContractACLs(context.Context, *QueryContractACLsRequest) (*QueryContractACLsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractACLs not implemented")
+}
+func (UnimplementedQueryServer) SimulateIBCHook(context.Context, *QuerySimulateIBCHookRequest) (*QuerySimulateIBCHookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateIBCHook not implemented")
+}
+func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
+
+// UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to QueryServer will
+// result in compilation errors.
+type UnsafeQueryServer interface {
+	mustEmbedUnimplementedQueryServer()
+}
+
+func RegisterQueryServer(s grpc.ServiceRegistrar, srv QueryServer) {
+	s.RegisterService(&Query_ServiceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Params_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FailedCallback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFailedCallbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FailedCallback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_FailedCallback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FailedCallback(ctx, req.(*QueryFailedCallbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FailedCallbacks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFailedCallbacksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FailedCallbacks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_FailedCallbacks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FailedCallbacks(ctx, req.(*QueryFailedCallbacksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ContractACL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContractACLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContractACL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ContractACL_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractACL(ctx, req.(*QueryContractACLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ContractACLs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContractACLsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContractACLs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ContractACLs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractACLs(ctx, req.(*QueryContractACLsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulateIBCHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateIBCHookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateIBCHook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SimulateIBCHook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateIBCHook(ctx, req.(*QuerySimulateIBCHookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Query_ServiceDesc is the grpc.ServiceDesc for Query service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Query_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "miniwasm.wasmhooks.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "FailedCallback",
+			Handler:    _Query_FailedCallback_Handler,
+		},
+		{
+			MethodName: "FailedCallbacks",
+			Handler:    _Query_FailedCallbacks_Handler,
+		},
+		{
+			MethodName: "ContractACL",
+			Handler:    _Query_ContractACL_Handler,
+		},
+		{
+			MethodName: "ContractACLs",
+			Handler:    _Query_ContractACLs_Handler,
+		},
+		{
+			MethodName: "SimulateIBCHook",
+			Handler:    _Query_SimulateIBCHook_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "miniwasm/wasmhooks/v1/query.proto",
+}
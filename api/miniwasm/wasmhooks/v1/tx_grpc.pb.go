@@ -0,0 +1,236 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: miniwasm/wasmhooks/v1/tx.proto
+
+package wasmhooksv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Msg_RetryHookCallback_FullMethodName = "/miniwasm.wasmhooks.v1.Msg/RetryHookCallback"
+	Msg_UpdateParams_FullMethodName      = "/miniwasm.wasmhooks.v1.Msg/UpdateParams"
+	Msg_SetContractACL_FullMethodName    = "/miniwasm.wasmhooks.v1.Msg/SetContractACL"
+	Msg_RemoveContractACL_FullMethodName = "/miniwasm.wasmhooks.v1.Msg/RemoveContractACL"
+)
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MsgClient interface {
+	// RetryHookCallback defines a gRPC service method for retrying a failed
+	// async callback.
+	RetryHookCallback(ctx context.Context, in *MsgRetryHookCallback, opts ...grpc.CallOption) (*MsgRetryHookCallbackResponse, error)
+	// UpdateParams defines an operation for updating the x/wasmhooks module
+	// parameters.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// SetContractACL defines a governance operation for setting the acl of a
+	// contract.
+	SetContractACL(ctx context.Context, in *MsgSetContractACL, opts ...grpc.CallOption) (*MsgSetContractACLResponse, error)
+	// RemoveContractACL defines a governance operation for removing the acl of
+	// a contract.
+	RemoveContractACL(ctx context.Context, in *MsgRemoveContractACL, opts ...grpc.CallOption) (*MsgRemoveContractACLResponse, error)
+}
+
+type msgClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMsgClient(cc grpc.ClientConnInterface) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) RetryHookCallback(ctx context.Context, in *MsgRetryHookCallback, opts ...grpc.CallOption) (*MsgRetryHookCallbackResponse, error) {
+	out := new(MsgRetryHookCallbackResponse)
+	err := c.cc.Invoke(ctx, Msg_RetryHookCallback_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateParams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetContractACL(ctx context.Context, in *MsgSetContractACL, opts ...grpc.CallOption) (*MsgSetContractACLResponse, error) {
+	out := new(MsgSetContractACLResponse)
+	err := c.cc.Invoke(ctx, Msg_SetContractACL_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveContractACL(ctx context.Context, in *MsgRemoveContractACL, opts ...grpc.CallOption) (*MsgRemoveContractACLResponse, error) {
+	out := new(MsgRemoveContractACLResponse)
+	err := c.cc.Invoke(ctx, Msg_RemoveContractACL_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+// All implementations must embed UnimplementedMsgServer
+// for forward compatibility
+type MsgServer interface {
+	// RetryHookCallback defines a gRPC service method for retrying a failed
+	// async callback.
+	RetryHookCallback(context.Context, *MsgRetryHookCallback) (*MsgRetryHookCallbackResponse, error)
+	// UpdateParams defines an operation for updating the x/wasmhooks module
+	// parameters.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// SetContractACL defines a governance operation for setting the acl of a
+	// contract.
+	SetContractACL(context.Context, *MsgSetContractACL) (*MsgSetContractACLResponse, error)
+	// RemoveContractACL defines a governance operation for removing the acl of
+	// a contract.
+	RemoveContractACL(context.Context, *MsgRemoveContractACL) (*MsgRemoveContractACLResponse, error)
+	mustEmbedUnimplementedMsgServer()
+}
+
+// UnimplementedMsgServer must be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (UnimplementedMsgServer) RetryHookCallback(context.Context, *MsgRetryHookCallback) (*MsgRetryHookCallbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryHookCallback not implemented")
+}
+func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (UnimplementedMsgServer) SetContractACL(context.Context, *MsgSetContractACL) (*MsgSetContractACLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetContractACL not implemented")
+}
+func (UnimplementedMsgServer) RemoveContractACL(context.Context, *MsgRemoveContractACL) (*MsgRemoveContractACLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveContractACL not implemented")
+}
+func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
+
+// UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MsgServer will
+// result in compilation errors.
+type UnsafeMsgServer interface {
+	mustEmbedUnimplementedMsgServer()
+}
+
+func RegisterMsgServer(s grpc.ServiceRegistrar, srv MsgServer) {
+	s.RegisterService(&Msg_ServiceDesc, srv)
+}
+
+func _Msg_RetryHookCallback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRetryHookCallback)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RetryHookCallback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RetryHookCallback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RetryHookCallback(ctx, req.(*MsgRetryHookCallback))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetContractACL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetContractACL)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetContractACL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetContractACL_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetContractACL(ctx, req.(*MsgSetContractACL))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveContractACL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveContractACL)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveContractACL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoveContractACL_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveContractACL(ctx, req.(*MsgRemoveContractACL))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Msg_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "miniwasm.wasmhooks.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RetryHookCallback",
+			Handler:    _Msg_RetryHookCallback_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "SetContractACL",
+			Handler:    _Msg_SetContractACL_Handler,
+		},
+		{
+			MethodName: "RemoveContractACL",
+			Handler:    _Msg_RemoveContractACL_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "miniwasm/wasmhooks/v1/tx.proto",
+}
@@ -11,10 +11,12 @@ import (
 	ibcante "github.com/cosmos/ibc-go/v8/modules/core/ante"
 	ibckeeper "github.com/cosmos/ibc-go/v8/modules/core/keeper"
 
-	opchildante "github.com/initia-labs/OPinit/x/opchild/ante"
 	opchildtypes "github.com/initia-labs/OPinit/x/opchild/types"
 	"github.com/initia-labs/initia/app/ante/accnum"
 
+	feeabsante "github.com/initia-labs/miniwasm/x/feeabs/ante"
+	lanepolicyante "github.com/initia-labs/miniwasm/x/lanepolicy/ante"
+
 	"github.com/skip-mev/block-sdk/v2/block"
 	auctionante "github.com/skip-mev/block-sdk/v2/x/auction/ante"
 	auctionkeeper "github.com/skip-mev/block-sdk/v2/x/auction/keeper"
@@ -27,13 +29,18 @@ import (
 // channel keeper.
 type HandlerOptions struct {
 	ante.HandlerOptions
-	Codec         codec.BinaryCodec
-	IBCkeeper     *ibckeeper.Keeper
-	OPChildKeeper opchildtypes.AnteKeeper
-	AuctionKeeper auctionkeeper.Keeper
-	TxEncoder     sdk.TxEncoder
-	MevLane       auctionante.MEVLane
-	FreeLane      block.Lane
+	Codec            codec.Codec
+	IBCkeeper        *ibckeeper.Keeper
+	OPChildKeeper    opchildtypes.AnteKeeper
+	FeeAbsKeeper     feeabsante.FeeAbsKeeper
+	LanePolicyKeeper lanepolicyante.FreeTxKeeper
+	AuctionKeeper    auctionkeeper.Keeper
+	TxEncoder        sdk.TxEncoder
+	// MevLane is nil if the mev lane is disabled, and then the auction bid txs
+	// are rejected.
+	MevLane auctionante.MEVLane
+	// FreeLane is nil if the free lane is disabled.
+	FreeLane block.Lane
 
 	// wasm ante options
 	WasmKeeper            *wasmkeeper.Keeper
@@ -65,10 +72,19 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "wasm keeper is required for ante builder")
 	}
 
+	if options.LanePolicyKeeper == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "lane policy keeper is required for ante builder")
+	}
+
 	if options.TXCounterStoreService == nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "wasm store service is required for ante builder")
 	}
 
+	extensionOptionChecker := options.ExtensionOptionChecker
+	if extensionOptionChecker == nil {
+		extensionOptionChecker = feeabsante.ExtensionOptionChecker
+	}
+
 	sigGasConsumer := options.SigGasConsumer
 	if sigGasConsumer == nil {
 		sigGasConsumer = ante.DefaultSigVerificationGasConsumer
@@ -76,12 +92,19 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 
 	txFeeChecker := options.TxFeeChecker
 	if txFeeChecker == nil {
-		txFeeChecker = opchildante.NewMempoolFeeChecker(options.OPChildKeeper).CheckTxFeeWithMinGasPrices
+		txFeeChecker = feeabsante.NewMempoolFeeChecker(options.OPChildKeeper, options.FeeAbsKeeper).CheckTxFeeWithMinGasPrices
 	}
 
 	freeLaneFeeChecker := func(ctx sdk.Context, tx sdk.Tx) (sdk.Coins, int64, error) {
-		// skip fee checker if the tx is free lane tx.
-		if !options.FreeLane.Match(ctx, tx) {
+		// skip fee checker if the tx is free lane tx; the free lane is nil if disabled.
+		if options.FreeLane == nil || !options.FreeLane.Match(ctx, tx) {
+			return txFeeChecker(ctx, tx)
+		}
+
+		// the free contract txs of the fee payers over the rate limit pay the fees
+		if limited, err := lanepolicyante.IsFreeTxLimited(ctx, options.LanePolicyKeeper, tx); err != nil {
+			return nil, 0, err
+		} else if limited {
 			return txFeeChecker(ctx, tx)
 		}
 
@@ -97,7 +120,7 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 	anteDecorators := []sdk.AnteDecorator{
 		accnum.NewAccountNumberDecorator(options.AccountKeeper),
 		ante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
-		ante.NewExtensionOptionsDecorator(options.ExtensionOptionChecker),
+		ante.NewExtensionOptionsDecorator(extensionOptionChecker),
 		// NOTE - WASM simulation gas limit can affect other module messages.
 		wasmkeeper.NewLimitSimulationGasDecorator(options.WasmConfig.SimulationGasLimit),
 		wasmkeeper.NewCountTXDecorator(options.TXCounterStoreService),
@@ -106,15 +129,26 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		ante.NewTxTimeoutHeightDecorator(),
 		ante.NewValidateMemoDecorator(options.AccountKeeper),
 		ante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
-		ante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, freeLaneFeeChecker),
+		feeabsante.NewSponsoredFeeDecorator(
+			freeLaneFeeChecker,
+			ante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, freeLaneFeeChecker),
+		),
 		// SetPubKeyDecorator must be called before all signature verification decorators
 		ante.NewSetPubKeyDecorator(options.AccountKeeper),
 		ante.NewValidateSigCountDecorator(options.AccountKeeper),
 		ante.NewSigGasConsumeDecorator(options.AccountKeeper, sigGasConsumer),
 		ante.NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler),
+		// the sponsoring contract is asked only with the verified signer
+		feeabsante.NewDeductSponsoredFeeDecorator(options.Codec, options.AccountKeeper, options.BankKeeper, options.WasmKeeper),
 		ante.NewIncrementSequenceDecorator(options.AccountKeeper),
+		lanepolicyante.NewFreeTxDecorator(options.LanePolicyKeeper, options.FreeLane),
 		ibcante.NewRedundantRelayDecorator(options.IBCkeeper),
-		auctionante.NewAuctionDecorator(options.AuctionKeeper, options.TxEncoder, options.MevLane),
+	}
+
+	if options.MevLane != nil {
+		anteDecorators = append(anteDecorators, auctionante.NewAuctionDecorator(options.AuctionKeeper, options.TxEncoder, options.MevLane))
+	} else {
+		anteDecorators = append(anteDecorators, rejectAuctionBidDecorator{})
 	}
 
 	return sdk.ChainAnteDecorators(anteDecorators...), nil
@@ -0,0 +1,24 @@
+package ante
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	auctiontypes "github.com/skip-mev/block-sdk/v2/x/auction/types"
+)
+
+// rejectAuctionBidDecorator rejects the auction bid txs. It replaces the auction
+// decorator when the mev lane is disabled, as the bid txs would otherwise fall
+// into the default lane and still be processed as bids.
+type rejectAuctionBidDecorator struct{}
+
+func (rejectAuctionBidDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	for _, msg := range tx.GetMsgs() {
+		if _, ok := msg.(*auctiontypes.MsgAuctionBid); ok {
+			return ctx, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "auction bids are not allowed; the mev lane is disabled")
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
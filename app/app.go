@@ -70,6 +70,7 @@ import (
 
 	// local imports
 	"github.com/initia-labs/miniwasm/app/keepers"
+	applanes "github.com/initia-labs/miniwasm/app/lanes"
 
 	// kvindexer
 	kvindexermodule "github.com/initia-labs/kvindexer/x/kvindexer"
@@ -146,6 +147,11 @@ func NewMinitiaApp(
 	logger.Info("mempool max txs", "max_txs", mempoolMaxTxs)
 	logger.Info("query gas limit", "gas_limit", queryGasLimit)
 
+	lanesConfig, err := applanes.NewConfig(appOpts)
+	if err != nil {
+		tmos.Exit(err.Error())
+	}
+
 	encodingConfig := params.MakeEncodingConfig()
 	std.RegisterLegacyAminoCodec(encodingConfig.Amino)
 	std.RegisterInterfaces(encodingConfig.InterfaceRegistry)
@@ -263,10 +269,17 @@ func NewMinitiaApp(
 	}
 
 	// register upgrade handler for later use
-	app.RegisterUpgradeHandlers(app.configurator)
+	err = app.RegisterUpgradeHandlers(app.configurator)
+	if err != nil {
+		tmos.Exit(err.Error())
+	}
 
 	// register executor change plans for later use
-	err = app.RegisterExecutorChangePlans()
+	executorChangePlans, err := LoadExecutorChangePlans(appOpts)
+	if err != nil {
+		tmos.Exit(err.Error())
+	}
+	err = app.RegisterExecutorChangePlans(executorChangePlans)
 	if err != nil {
 		tmos.Exit(err.Error())
 	}
@@ -292,7 +305,7 @@ func NewMinitiaApp(
 	app.SetEndBlocker(app.EndBlocker)
 
 	// setup BlockSDK
-	mempool, anteHandler, checkTx, prepareProposalHandler, processProposalHandler, err := setupBlockSDK(app, mempoolMaxTxs, wasmConfig, app.GetKVStoreKey()[wasmtypes.StoreKey])
+	mempool, anteHandler, checkTx, prepareProposalHandler, processProposalHandler, err := setupBlockSDK(app, mempoolMaxTxs, lanesConfig, wasmConfig, app.GetKVStoreKey()[wasmtypes.StoreKey])
 	if err != nil {
 		tmos.Exit(err.Error())
 	}
@@ -361,6 +374,10 @@ func NewMinitiaApp(
 		if err := app.WasmKeeper.InitializePinnedCodes(ctx); err != nil {
 			tmos.Exit(fmt.Sprintf("failed initialize pinned codes %s", err))
 		}
+
+		if err := app.checkExecutorChangePlans(ctx, executorChangePlans); err != nil {
+			tmos.Exit(err.Error())
+		}
 	}
 
 	return app
@@ -1,7 +1,6 @@
 package app
 
 import (
-	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/runtime"
@@ -18,8 +17,10 @@ import (
 	"github.com/skip-mev/block-sdk/v2/block"
 	blockbase "github.com/skip-mev/block-sdk/v2/block/base"
 	mevlane "github.com/skip-mev/block-sdk/v2/lanes/mev"
+	auctionante "github.com/skip-mev/block-sdk/v2/x/auction/ante"
 
 	appante "github.com/initia-labs/miniwasm/app/ante"
+	applanes "github.com/initia-labs/miniwasm/app/lanes"
 
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 )
@@ -27,6 +28,7 @@ import (
 func setupBlockSDK(
 	app *MinitiaApp,
 	mempoolMaxTxs int,
+	lanesConfig applanes.LanesConfig,
 	wasmConfig wasmtypes.WasmConfig,
 	txCounterStoreKey *storetypes.KVStoreKey,
 ) (
@@ -47,18 +49,27 @@ func setupBlockSDK(
 		Logger:          app.Logger(),
 		TxEncoder:       app.txConfig.TxEncoder(),
 		TxDecoder:       app.txConfig.TxDecoder(),
-		MaxBlockSpace:   math.LegacyMustNewDecFromStr("0.01"),
-		MaxTxs:          1,
+		MaxBlockSpace:   lanesConfig.System.MaxBlockSpace,
+		MaxTxs:          lanesConfig.System.MaxTxs,
 		SignerExtractor: signerExtractor,
 	}, opchildlanes.SystemLaneMatchHandler())
 
+	relayerLane := applanes.NewRelayerLane(blockbase.LaneConfig{
+		Logger:          app.Logger(),
+		TxEncoder:       app.txConfig.TxEncoder(),
+		TxDecoder:       app.txConfig.TxDecoder(),
+		MaxBlockSpace:   lanesConfig.Relayer.MaxBlockSpace,
+		MaxTxs:          lanesConfig.Relayer.MaxTxs,
+		SignerExtractor: signerExtractor,
+	}, applanes.RelayerLaneMatchHandler(app.LanePolicyKeeper))
+
 	factory := mevlane.NewDefaultAuctionFactory(app.txConfig.TxDecoder(), signerExtractor)
 	mevLane := mevlane.NewMEVLane(blockbase.LaneConfig{
 		Logger:          app.Logger(),
 		TxEncoder:       app.txConfig.TxEncoder(),
 		TxDecoder:       app.txConfig.TxDecoder(),
-		MaxBlockSpace:   math.LegacyMustNewDecFromStr("0.09"),
-		MaxTxs:          100,
+		MaxBlockSpace:   lanesConfig.MEV.MaxBlockSpace,
+		MaxTxs:          lanesConfig.MEV.MaxTxs,
 		SignerExtractor: signerExtractor,
 	}, factory, factory.MatchHandler())
 
@@ -66,21 +77,42 @@ func setupBlockSDK(
 		Logger:          app.Logger(),
 		TxEncoder:       app.txConfig.TxEncoder(),
 		TxDecoder:       app.txConfig.TxDecoder(),
-		MaxBlockSpace:   math.LegacyMustNewDecFromStr("0.1"),
-		MaxTxs:          100,
+		MaxBlockSpace:   lanesConfig.Free.MaxBlockSpace,
+		MaxTxs:          lanesConfig.Free.MaxTxs,
 		SignerExtractor: signerExtractor,
-	}, opchildlanes.NewFreeLaneMatchHandler(app.ac, app.OPChildKeeper).MatchHandler())
+	}, applanes.FreeLaneMatchHandler(
+		opchildlanes.NewFreeLaneMatchHandler(app.ac, app.OPChildKeeper).MatchHandler(),
+		app.LanePolicyKeeper,
+	))
 
 	defaultLane := initialanes.NewDefaultLane(blockbase.LaneConfig{
 		Logger:          app.Logger(),
 		TxEncoder:       app.txConfig.TxEncoder(),
 		TxDecoder:       app.txConfig.TxDecoder(),
-		MaxBlockSpace:   math.LegacyMustNewDecFromStr("0.8"),
+		MaxBlockSpace:   lanesConfig.Default.MaxBlockSpace,
 		MaxTxs:          mempoolMaxTxs,
 		SignerExtractor: signerExtractor,
 	})
 
-	lanes := []block.Lane{systemLane, mevLane, freeLane, defaultLane}
+	// the disabled lanes are left out of the mempool, so their txs fall into
+	// the default lane.
+	lanes := []block.Lane{systemLane}
+	if lanesConfig.Relayer.Enable {
+		lanes = append(lanes, relayerLane)
+	}
+
+	var anteMevLane auctionante.MEVLane
+	if lanesConfig.MEV.Enable {
+		lanes = append(lanes, mevLane)
+		anteMevLane = mevLane
+	}
+
+	var anteFreeLane block.Lane
+	if lanesConfig.Free.Enable {
+		lanes = append(lanes, freeLane)
+		anteFreeLane = freeLane
+	}
+	lanes = append(lanes, defaultLane)
 	mempool, err := block.NewLanedMempool(app.Logger(), lanes)
 	if err != nil {
 		return nil, nil, nil, nil, nil, err
@@ -97,10 +129,12 @@ func setupBlockSDK(
 			IBCkeeper:             app.IBCKeeper,
 			Codec:                 app.appCodec,
 			OPChildKeeper:         app.OPChildKeeper,
+			FeeAbsKeeper:          app.FeeAbsKeeper,
+			LanePolicyKeeper:      app.LanePolicyKeeper,
 			TxEncoder:             app.txConfig.TxEncoder(),
 			AuctionKeeper:         *app.AuctionKeeper,
-			MevLane:               mevLane,
-			FreeLane:              freeLane,
+			MevLane:               anteMevLane,
+			FreeLane:              anteFreeLane,
 			WasmKeeper:            app.WasmKeeper,
 			WasmConfig:            &wasmConfig,
 			TXCounterStoreService: runtime.NewKVStoreService(txCounterStoreKey),
@@ -122,16 +156,19 @@ func setupBlockSDK(
 		}
 	}
 
-	mevCheckTx := blockchecktx.NewMEVCheckTxHandler(
-		app.BaseApp,
-		app.txConfig.TxDecoder(),
-		mevLane,
-		anteHandler,
-		app.BaseApp.CheckTx,
-	)
+	baseCheckTx := blockchecktx.CheckTx(app.BaseApp.CheckTx)
+	if lanesConfig.MEV.Enable {
+		baseCheckTx = blockchecktx.NewMEVCheckTxHandler(
+			app.BaseApp,
+			app.txConfig.TxDecoder(),
+			mevLane,
+			anteHandler,
+			app.BaseApp.CheckTx,
+		).CheckTx()
+	}
 	checkTxHandler := blockchecktx.NewMempoolParityCheckTx(
 		app.Logger(), mempool,
-		app.txConfig.TxDecoder(), mevCheckTx.CheckTx(),
+		app.txConfig.TxDecoder(), baseCheckTx,
 	)
 	checkTx := checkTxHandler.CheckTx()
 
@@ -0,0 +1,64 @@
+package app
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/skip-mev/block-sdk/v2/block"
+	mevlane "github.com/skip-mev/block-sdk/v2/lanes/mev"
+	auctiontypes "github.com/skip-mev/block-sdk/v2/x/auction/types"
+)
+
+func TestMEVLaneDisabled(t *testing.T) {
+	appOpts := viper.New()
+	appOpts.Set(flags.FlagHome, t.TempDir())
+	appOpts.Set("lanes.mev.enable", false)
+	// the block space of the mev lane is given to the default lane
+	appOpts.Set("lanes.default.max-block-space", "0.79")
+
+	privKey := secp256k1.GenPrivKey()
+	addr := sdk.AccAddress(privKey.PubKey().Address())
+	app := setupWithGenesisAccounts(appOpts, nil,
+		[]authtypes.GenesisAccount{authtypes.NewBaseAccount(addr, nil, 0, 0)},
+		banktypes.Balance{Address: addr.String(), Coins: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000_000))},
+	)
+
+	// the mev lane is left out of the mempool
+	for _, lane := range app.Mempool().(*block.LanedMempool).Registry() {
+		require.NotEqual(t, mevlane.LaneName, lane.Name())
+	}
+
+	// the bid txs are rejected instead of being processed in the default lane
+	ctx := app.NewUncachedContext(false, cmtproto.Header{Height: app.LastBlockHeight() + 1})
+	acc := app.AccountKeeper.GetAccount(ctx, addr)
+	tx, err := simtestutil.GenSignedMockTx(
+		rand.New(rand.NewSource(1)),
+		app.txConfig,
+		[]sdk.Msg{&auctiontypes.MsgAuctionBid{
+			Bidder: addr.String(),
+			Bid:    sdk.NewInt64Coin(sdk.DefaultBondDenom, 100),
+		}},
+		sdk.NewCoins(),
+		simtestutil.DefaultGenTxGas,
+		ctx.ChainID(),
+		[]uint64{acc.GetAccountNumber()},
+		[]uint64{acc.GetSequence()},
+		privKey,
+	)
+	require.NoError(t, err)
+
+	_, err = app.AnteHandler()(ctx, tx, false)
+	require.ErrorContains(t, err, "the mev lane is disabled")
+}
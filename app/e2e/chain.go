@@ -0,0 +1,260 @@
+// Package e2e runs MinitiaApp chains on the ibc-go testing coordinator, so the
+// packets are relayed in-process through the real ibc middleware stacks of the
+// chains.
+package e2e
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	dbm "github.com/cosmos/cosmos-db"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sims "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+	"github.com/cosmos/ibc-go/v8/testing/mock"
+	ibctestingtypes "github.com/cosmos/ibc-go/v8/testing/types"
+
+	opchildtypes "github.com/initia-labs/OPinit/x/opchild/types"
+
+	minitiaapp "github.com/initia-labs/miniwasm/app"
+	"github.com/initia-labs/miniwasm/types"
+)
+
+// startTime is the block time of the first blocks of the chains.
+var startTime = time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC)
+
+// consensusParams are the consensus params of the chains, which allow the wasm
+// codes to be stored by the transactions.
+var consensusParams = &tmproto.ConsensusParams{
+	Block: &tmproto.BlockParams{
+		MaxBytes: 8000000,
+		MaxGas:   1234000000,
+	},
+	Evidence: &tmproto.EvidenceParams{
+		MaxAgeNumBlocks: 302400,
+		MaxAgeDuration:  504 * time.Hour,
+		MaxBytes:        10000,
+	},
+	Validator: &tmproto.ValidatorParams{
+		PubKeyTypes: []string{
+			tmtypes.ABCIPubKeyTypeEd25519,
+		},
+	},
+}
+
+// TestingApp wraps MinitiaApp to implement the TestingApp interface of the
+// ibc-go testing package.
+type TestingApp struct {
+	*minitiaapp.MinitiaApp
+}
+
+var _ ibctesting.TestingApp = TestingApp{}
+
+// GetStakingKeeper implements the TestingApp interface. It returns opchild,
+// which tracks the historical info of the validators.
+func (app TestingApp) GetStakingKeeper() ibctestingtypes.StakingKeeper {
+	return app.OPChildKeeper
+}
+
+// GetTxConfig implements the TestingApp interface.
+func (app TestingApp) GetTxConfig() client.TxConfig {
+	return app.TxConfig()
+}
+
+// NewCoordinator returns a coordinator of n MinitiaApp chains, whose chain ids
+// are given by ibctesting.GetChainID from 1 to n.
+func NewCoordinator(t *testing.T, n int) *ibctesting.Coordinator {
+	coord := &ibctesting.Coordinator{
+		T:           t,
+		CurrentTime: startTime,
+		Chains:      make(map[string]*ibctesting.TestChain, n),
+	}
+
+	for i := 1; i <= n; i++ {
+		chainID := ibctesting.GetChainID(i)
+		coord.Chains[chainID] = NewTestChain(t, coord, chainID)
+	}
+
+	return coord
+}
+
+// NewTestChain returns a MinitiaApp chain with a single validator and
+// ibctesting.MaxAccounts sender accounts funded with the bond denom. The
+// first sender is the admin and the bridge executor of the chain.
+//
+// Every chain has its own home, as the wasm vm locks the home of the app.
+func NewTestChain(t *testing.T, coord *ibctesting.Coordinator, chainID string) *ibctesting.TestChain {
+	privVal := mock.NewPV()
+	pubKey, err := privVal.GetPubKey()
+	require.NoError(t, err)
+
+	valSet := tmtypes.NewValidatorSet([]*tmtypes.Validator{tmtypes.NewValidator(pubKey, 1)})
+	signers := map[string]tmtypes.PrivValidator{pubKey.Address().String(): privVal}
+
+	amount, ok := math.NewIntFromString(ibctesting.DefaultGenesisAccBalance)
+	require.True(t, ok)
+
+	genAccs := make([]authtypes.GenesisAccount, 0, ibctesting.MaxAccounts)
+	balances := make([]banktypes.Balance, 0, ibctesting.MaxAccounts)
+	senderAccs := make([]ibctesting.SenderAccount, 0, ibctesting.MaxAccounts)
+	for i := 0; i < ibctesting.MaxAccounts; i++ {
+		senderPrivKey := secp256k1.GenPrivKey()
+		acc := authtypes.NewBaseAccount(senderPrivKey.PubKey().Address().Bytes(), senderPrivKey.PubKey(), uint64(i), 0)
+
+		genAccs = append(genAccs, acc)
+		balances = append(balances, banktypes.Balance{
+			Address: acc.GetAddress().String(),
+			Coins:   sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, amount)),
+		})
+		senderAccs = append(senderAccs, ibctesting.SenderAccount{
+			SenderPrivKey: senderPrivKey,
+			SenderAccount: acc,
+		})
+	}
+
+	app := setupWithGenesisValSet(t, chainID, valSet, genAccs, balances)
+
+	chain := &ibctesting.TestChain{
+		TB:          t,
+		Coordinator: coord,
+		ChainID:     chainID,
+		App:         app,
+		CurrentHeader: tmproto.Header{
+			ChainID: chainID,
+			Height:  1,
+			Time:    coord.CurrentTime.UTC(),
+		},
+		QueryServer:    app.GetIBCKeeper(),
+		TxConfig:       app.GetTxConfig(),
+		Codec:          app.AppCodec(),
+		Vals:           valSet,
+		NextVals:       valSet,
+		Signers:        signers,
+		SenderPrivKey:  senderAccs[0].SenderPrivKey,
+		SenderAccount:  senderAccs[0].SenderAccount,
+		SenderAccounts: senderAccs,
+	}
+
+	// commit genesis block
+	chain.NextBlock()
+
+	return chain
+}
+
+// GetMinitiaApp returns the MinitiaApp of the test chain.
+func GetMinitiaApp(chain *ibctesting.TestChain) *minitiaapp.MinitiaApp {
+	app, ok := chain.App.(TestingApp)
+	require.True(chain.TB, ok, "chain %s is not a MinitiaApp chain", chain.ChainID)
+
+	return app.MinitiaApp
+}
+
+// NewTransferPath returns a transfer path between the chains, whose light
+// clients have the unbonding period of opchild.
+func NewTransferPath(chainA, chainB *ibctesting.TestChain) *ibctesting.Path {
+	path := ibctesting.NewTransferPath(chainA, chainB)
+	setClientConfig(path.EndpointA)
+	setClientConfig(path.EndpointB)
+
+	return path
+}
+
+// setClientConfig sets the periods of the light client of the endpoint to the
+// unbonding period of the counterparty chain.
+func setClientConfig(endpoint *ibctesting.Endpoint) {
+	counterparty := endpoint.Counterparty.Chain
+	unbondingPeriod, err := GetMinitiaApp(counterparty).OPChildKeeper.UnbondingTime(counterparty.GetContext())
+	require.NoError(endpoint.Chain.TB, err)
+
+	clientConfig, ok := endpoint.ClientConfig.(*ibctesting.TendermintConfig)
+	require.True(endpoint.Chain.TB, ok)
+	clientConfig.UnbondingPeriod = unbondingPeriod
+	clientConfig.TrustingPeriod = unbondingPeriod * 2 / 3
+}
+
+// setupWithGenesisValSet initializes a MinitiaApp with the opchild validators
+// of the validator set, and the genesis accounts and balances.
+func setupWithGenesisValSet(
+	t *testing.T,
+	chainID string,
+	valSet *tmtypes.ValidatorSet,
+	genAccs []authtypes.GenesisAccount,
+	balances []banktypes.Balance,
+) TestingApp {
+	app := minitiaapp.NewMinitiaApp(
+		log.NewNopLogger(),
+		dbm.NewMemDB(),
+		dbm.NewMemDB(),
+		nil,
+		true,
+		nil,
+		sims.AppOptionsMap{flags.FlagHome: t.TempDir()},
+		baseapp.SetChainID(chainID),
+	)
+	t.Cleanup(func() {
+		require.NoError(t, app.Close())
+	})
+
+	cdc := app.AppCodec()
+	genesisState := minitiaapp.NewDefaultGenesisState(cdc, app.BasicModuleManager, types.BaseDenom)
+
+	authGenesis := authtypes.NewGenesisState(authtypes.DefaultParams(), genAccs)
+	genesisState[authtypes.ModuleName] = cdc.MustMarshalJSON(authGenesis)
+
+	validators := make([]opchildtypes.Validator, 0, len(valSet.Validators))
+	for _, val := range valSet.Validators {
+		pk, err := cryptocodec.FromCmtPubKeyInterface(val.PubKey)
+		require.NoError(t, err)
+		pkAny, err := codectypes.NewAnyWithValue(pk)
+		require.NoError(t, err)
+
+		validators = append(validators, opchildtypes.Validator{
+			Moniker:         chainID,
+			OperatorAddress: sdk.ValAddress(val.Address).String(),
+			ConsensusPubkey: pkAny,
+			ConsPower:       val.VotingPower,
+		})
+	}
+
+	var opchildGenesis opchildtypes.GenesisState
+	cdc.MustUnmarshalJSON(genesisState[opchildtypes.ModuleName], &opchildGenesis)
+	opchildGenesis.Params.Admin = genAccs[0].GetAddress().String()
+	opchildGenesis.Params.BridgeExecutors = []string{genAccs[0].GetAddress().String()}
+	opchildGenesis = *opchildtypes.NewGenesisState(opchildGenesis.Params, validators, nil)
+	genesisState[opchildtypes.ModuleName] = cdc.MustMarshalJSON(&opchildGenesis)
+
+	bankGenesis := banktypes.NewGenesisState(banktypes.DefaultGenesisState().Params, balances, sdk.NewCoins(), []banktypes.Metadata{}, []banktypes.SendEnabled{})
+	genesisState[banktypes.ModuleName] = cdc.MustMarshalJSON(bankGenesis)
+
+	stateBytes, err := json.MarshalIndent(genesisState, "", " ")
+	require.NoError(t, err)
+
+	_, err = app.InitChain(&abci.RequestInitChain{
+		ChainId:         chainID,
+		Validators:      []abci.ValidatorUpdate{},
+		ConsensusParams: consensusParams,
+		AppStateBytes:   stateBytes,
+	})
+	require.NoError(t, err)
+
+	return TestingApp{app}
+}
@@ -0,0 +1,121 @@
+package e2e_test
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+
+	"github.com/initia-labs/miniwasm/app/e2e"
+)
+
+const counterWasmPath = "../ibc-hooks/contracts/artifacts/counter-aarch64.wasm"
+
+// setupTransferPath opens a transfer channel between the chains.
+func setupTransferPath(coord *ibctesting.Coordinator, chainA, chainB *ibctesting.TestChain) *ibctesting.Path {
+	path := e2e.NewTransferPath(chainA, chainB)
+	coord.Setup(path)
+
+	return path
+}
+
+// sendTransfer sends the coin over the channel of the endpoint from the sender
+// of the chain, and returns the sent packet.
+func sendTransfer(t *testing.T, endpoint *ibctesting.Endpoint, coin sdk.Coin, receiver, memo string) channeltypes.Packet {
+	return sendTransferWithTimeout(t, endpoint, coin, receiver, memo, time.Hour)
+}
+
+// sendTransferWithTimeout sends the coin like sendTransfer, with the packet
+// timed out after the timeout from the current time of the coordinator.
+func sendTransferWithTimeout(t *testing.T, endpoint *ibctesting.Endpoint, coin sdk.Coin, receiver, memo string, timeout time.Duration) channeltypes.Packet {
+	chain := endpoint.Chain
+	msg := transfertypes.NewMsgTransfer(
+		endpoint.ChannelConfig.PortID,
+		endpoint.ChannelID,
+		coin,
+		chain.SenderAccount.GetAddress().String(),
+		receiver,
+		clienttypes.ZeroHeight(),
+		uint64(chain.Coordinator.CurrentTime.Add(timeout).UnixNano()),
+		memo,
+	)
+
+	res, err := chain.SendMsgs(msg)
+	require.NoError(t, err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+	require.NoError(t, err)
+
+	return packet
+}
+
+// ibcDenom returns the denom of the coin received over the channel of the
+// endpoint.
+func ibcDenom(endpoint *ibctesting.Endpoint, denom string) string {
+	prefixedDenom := transfertypes.GetPrefixedDenom(endpoint.ChannelConfig.PortID, endpoint.ChannelID, denom)
+	return transfertypes.ParseDenomTrace(prefixedDenom).IBCDenom()
+}
+
+// balance returns the balance of the address on the chain.
+func balance(chain *ibctesting.TestChain, addr sdk.AccAddress, denom string) math.Int {
+	return e2e.GetMinitiaApp(chain).BankKeeper.GetBalance(chain.GetContext(), addr, denom).Amount
+}
+
+// instantiateContract stores and instantiates the wasm contract on the chain,
+// and returns the address of the contract.
+func instantiateContract(t *testing.T, chain *ibctesting.TestChain, wasmPath string) sdk.AccAddress {
+	code, err := os.ReadFile(wasmPath)
+	require.NoError(t, err)
+
+	ctx := chain.GetContext()
+	sender := chain.SenderAccount.GetAddress().String()
+	msgServer := wasmkeeper.NewMsgServerImpl(e2e.GetMinitiaApp(chain).WasmKeeper)
+	storeRes, err := msgServer.StoreCode(ctx, &wasmtypes.MsgStoreCode{
+		Sender:       sender,
+		WASMByteCode: code,
+	})
+	require.NoError(t, err)
+
+	instantiateRes, err := msgServer.InstantiateContract(ctx, &wasmtypes.MsgInstantiateContract{
+		Sender: sender,
+		Admin:  sender,
+		CodeID: storeRes.CodeID,
+		Label:  "e2e",
+		Msg:    []byte("{}"),
+	})
+	require.NoError(t, err)
+
+	chain.Coordinator.CommitBlock(chain)
+
+	contractAddr, err := sdk.AccAddressFromBech32(instantiateRes.Address)
+	require.NoError(t, err)
+
+	return contractAddr
+}
+
+// queryCounter returns the count of the counter contract.
+func queryCounter(t *testing.T, chain *ibctesting.TestChain, contractAddr sdk.AccAddress) string {
+	res, err := e2e.GetMinitiaApp(chain).WasmKeeper.QuerySmart(chain.GetContext(), contractAddr, []byte(`{"get":{}}`))
+	require.NoError(t, err)
+
+	return string(res)
+}
+
+// allowHooks allows the contract to be executed by the wasm hooks of the chain.
+func allowHooks(t *testing.T, chain *ibctesting.TestChain, contractAddr sdk.AccAddress) {
+	require.NoError(t, e2e.GetMinitiaApp(chain).IBCHooksKeeper.SetAllowed(chain.GetContext(), contractAddr, true))
+	chain.Coordinator.CommitBlock(chain)
+}
@@ -0,0 +1,114 @@
+package e2e_test
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+
+	"github.com/initia-labs/miniwasm/app/e2e"
+	ibchooks "github.com/initia-labs/miniwasm/app/ibc-hooks"
+)
+
+func Test_WasmHook_Receive(t *testing.T) {
+	coord := e2e.NewCoordinator(t, 2)
+	chainA := coord.GetChain(ibctesting.GetChainID(1))
+	chainB := coord.GetChain(ibctesting.GetChainID(2))
+	path := setupTransferPath(coord, chainA, chainB)
+
+	contractAddr := instantiateContract(t, chainB, counterWasmPath)
+	sender := chainA.SenderAccount.GetAddress()
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100))
+	senderBalance := balance(chainA, sender, sdk.DefaultBondDenom)
+	memo := fmt.Sprintf(`{"wasm":{"message":{"contract":"%s","msg":{"increase":{}}}}}`, contractAddr)
+
+	// the hook is not allowed, so the coin is refunded by the error ack
+	packet := sendTransfer(t, path.EndpointA, coin, contractAddr.String(), memo)
+	_, ackBz, err := path.RelayPacketWithResults(packet)
+	require.NoError(t, err)
+
+	var ack channeltypes.Acknowledgement
+	require.NoError(t, channeltypes.SubModuleCdc.UnmarshalJSON(ackBz, &ack))
+	require.False(t, ack.Success())
+	require.Equal(t, senderBalance, balance(chainA, sender, sdk.DefaultBondDenom))
+	require.Equal(t, "0", queryCounter(t, chainB, contractAddr))
+
+	// the hook is executed by the intermediate sender with the received coin
+	allowHooks(t, chainB, contractAddr)
+	packet = sendTransfer(t, path.EndpointA, coin, contractAddr.String(), memo)
+	_, ackBz, err = path.RelayPacketWithResults(packet)
+	require.NoError(t, err)
+
+	require.NoError(t, channeltypes.SubModuleCdc.UnmarshalJSON(ackBz, &ack))
+	require.True(t, ack.Success())
+	require.Equal(t, "1", queryCounter(t, chainB, contractAddr))
+
+	voucherDenom := ibcDenom(path.EndpointB, sdk.DefaultBondDenom)
+	require.Equal(t, coin.Amount, balance(chainB, contractAddr, voucherDenom))
+
+	intermediateSender, err := sdk.AccAddressFromBech32(ibchooks.DeriveIntermediateSender(path.EndpointB.ChannelID, sender.String()))
+	require.NoError(t, err)
+	require.True(t, balance(chainB, intermediateSender, voucherDenom).IsZero())
+}
+
+func Test_WasmHook_AckCallback(t *testing.T) {
+	coord := e2e.NewCoordinator(t, 2)
+	chainA := coord.GetChain(ibctesting.GetChainID(1))
+	chainB := coord.GetChain(ibctesting.GetChainID(2))
+	path := setupTransferPath(coord, chainA, chainB)
+
+	contractAddr := instantiateContract(t, chainA, counterWasmPath)
+	receiver := chainB.SenderAccounts[1].SenderAccount.GetAddress()
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100))
+	memo := fmt.Sprintf(`{"wasm":{"async_callback":"%s"}}`, contractAddr)
+
+	// the callback is not allowed, so the contract is not called
+	packet := sendTransfer(t, path.EndpointA, coin, receiver.String(), memo)
+	require.NoError(t, path.RelayPacket(packet))
+	require.Equal(t, "0", queryCounter(t, chainA, contractAddr))
+
+	// the contract adds the sequence of the packet on the success ack
+	allowHooks(t, chainA, contractAddr)
+	packet = sendTransfer(t, path.EndpointA, coin, receiver.String(), memo)
+	require.NoError(t, path.RelayPacket(packet))
+	require.Equal(t, strconv.FormatUint(packet.Sequence, 10), queryCounter(t, chainA, contractAddr))
+
+	voucherDenom := ibcDenom(path.EndpointB, sdk.DefaultBondDenom)
+	require.Equal(t, coin.Amount.MulRaw(2), balance(chainB, receiver, voucherDenom))
+}
+
+func Test_WasmHook_TimeoutCallback(t *testing.T) {
+	coord := e2e.NewCoordinator(t, 2)
+	chainA := coord.GetChain(ibctesting.GetChainID(1))
+	chainB := coord.GetChain(ibctesting.GetChainID(2))
+	path := setupTransferPath(coord, chainA, chainB)
+
+	contractAddr := instantiateContract(t, chainA, counterWasmPath)
+	allowHooks(t, chainA, contractAddr)
+
+	sender := chainA.SenderAccount.GetAddress()
+	receiver := chainB.SenderAccounts[1].SenderAccount.GetAddress()
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100))
+	senderBalance := balance(chainA, sender, sdk.DefaultBondDenom)
+	memo := fmt.Sprintf(`{"wasm":{"async_callback":"%s"}}`, contractAddr)
+
+	packet := sendTransferWithTimeout(t, path.EndpointA, coin, receiver.String(), memo, time.Minute)
+
+	coord.IncrementTimeBy(time.Hour)
+	coord.CommitBlock(chainB)
+	require.NoError(t, path.EndpointA.UpdateClient())
+	require.NoError(t, path.EndpointA.TimeoutPacket(packet))
+
+	// the contract adds the sequence of the packet on the timeout
+	require.Equal(t, strconv.FormatUint(packet.Sequence, 10), queryCounter(t, chainA, contractAddr))
+	require.Equal(t, senderBalance, balance(chainA, sender, sdk.DefaultBondDenom))
+}
@@ -0,0 +1,127 @@
+package e2e_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+
+	"github.com/initia-labs/miniwasm/app/e2e"
+)
+
+func Test_PacketForward(t *testing.T) {
+	coord := e2e.NewCoordinator(t, 3)
+	chainA := coord.GetChain(ibctesting.GetChainID(1))
+	chainB := coord.GetChain(ibctesting.GetChainID(2))
+	chainC := coord.GetChain(ibctesting.GetChainID(3))
+	pathAB := setupTransferPath(coord, chainA, chainB)
+	pathBC := setupTransferPath(coord, chainB, chainC)
+
+	sender := chainA.SenderAccount.GetAddress()
+	receiver := chainC.SenderAccounts[1].SenderAccount.GetAddress()
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100))
+	senderBalance := balance(chainA, sender, sdk.DefaultBondDenom)
+	memo := fmt.Sprintf(`{"forward":{"receiver":"%s","port":"%s","channel":"%s"}}`,
+		receiver, pathBC.EndpointA.ChannelConfig.PortID, pathBC.EndpointA.ChannelID)
+
+	packet := sendTransfer(t, pathAB.EndpointA, coin, chainB.SenderAccount.GetAddress().String(), memo)
+
+	// chain B forwards the packet to chain C, and holds the ack of the packet
+	require.NoError(t, pathAB.EndpointB.UpdateClient())
+	res, err := pathAB.EndpointB.RecvPacketWithResult(packet)
+	require.NoError(t, err)
+	_, err = ibctesting.ParseAckFromEvents(res.Events)
+	require.Error(t, err)
+
+	forwardPacket, err := ibctesting.ParsePacketFromEvents(res.Events)
+	require.NoError(t, err)
+	require.Equal(t, pathBC.EndpointA.ChannelID, forwardPacket.SourceChannel)
+
+	// the ack of chain C is written back for the packet of chain A
+	ackBz := relayForwardedPacket(t, pathBC, forwardPacket)
+	require.NoError(t, pathAB.EndpointA.UpdateClient())
+	require.NoError(t, pathAB.EndpointA.AcknowledgePacket(packet, ackBz))
+
+	var ack channeltypes.Acknowledgement
+	require.NoError(t, channeltypes.SubModuleCdc.UnmarshalJSON(ackBz, &ack))
+	require.True(t, ack.Success())
+
+	// the receiver on chain C has the voucher of the voucher
+	prefixedDenom := transfertypes.GetPrefixedDenom(pathBC.EndpointB.ChannelConfig.PortID, pathBC.EndpointB.ChannelID,
+		transfertypes.GetPrefixedDenom(pathAB.EndpointB.ChannelConfig.PortID, pathAB.EndpointB.ChannelID, sdk.DefaultBondDenom))
+	voucherDenom := transfertypes.ParseDenomTrace(prefixedDenom).IBCDenom()
+	require.Equal(t, coin.Amount, balance(chainC, receiver, voucherDenom))
+	require.Equal(t, senderBalance.Sub(coin.Amount), balance(chainA, sender, sdk.DefaultBondDenom))
+
+	// chain B escrows the voucher of chain A for chain C
+	escrowAddr := transfertypes.GetEscrowAddress(pathBC.EndpointA.ChannelConfig.PortID, pathBC.EndpointA.ChannelID)
+	require.Equal(t, coin.Amount, balance(chainB, escrowAddr, ibcDenom(pathAB.EndpointB, sdk.DefaultBondDenom)))
+}
+
+func Test_PacketForward_Refund(t *testing.T) {
+	coord := e2e.NewCoordinator(t, 3)
+	chainA := coord.GetChain(ibctesting.GetChainID(1))
+	chainB := coord.GetChain(ibctesting.GetChainID(2))
+	chainC := coord.GetChain(ibctesting.GetChainID(3))
+	pathAB := setupTransferPath(coord, chainA, chainB)
+	pathBC := setupTransferPath(coord, chainB, chainC)
+
+	sender := chainA.SenderAccount.GetAddress()
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100))
+	senderBalance := balance(chainA, sender, sdk.DefaultBondDenom)
+
+	// the receiver on chain C is invalid, so chain C writes the error ack
+	memo := fmt.Sprintf(`{"forward":{"receiver":"invalid","port":"%s","channel":"%s"}}`,
+		pathBC.EndpointA.ChannelConfig.PortID, pathBC.EndpointA.ChannelID)
+	packet := sendTransfer(t, pathAB.EndpointA, coin, chainB.SenderAccount.GetAddress().String(), memo)
+
+	require.NoError(t, pathAB.EndpointB.UpdateClient())
+	res, err := pathAB.EndpointB.RecvPacketWithResult(packet)
+	require.NoError(t, err)
+	forwardPacket, err := ibctesting.ParsePacketFromEvents(res.Events)
+	require.NoError(t, err)
+
+	// chain B writes the error ack for the packet of chain A, which refunds the sender
+	ackBz := relayForwardedPacket(t, pathBC, forwardPacket)
+	require.NoError(t, pathAB.EndpointA.UpdateClient())
+	require.NoError(t, pathAB.EndpointA.AcknowledgePacket(packet, ackBz))
+
+	var ack channeltypes.Acknowledgement
+	require.NoError(t, channeltypes.SubModuleCdc.UnmarshalJSON(ackBz, &ack))
+	require.False(t, ack.Success())
+	require.Equal(t, senderBalance, balance(chainA, sender, sdk.DefaultBondDenom))
+
+	escrowAddr := transfertypes.GetEscrowAddress(pathBC.EndpointA.ChannelConfig.PortID, pathBC.EndpointA.ChannelID)
+	require.True(t, balance(chainB, escrowAddr, ibcDenom(pathAB.EndpointB, sdk.DefaultBondDenom)).IsZero())
+}
+
+// relayForwardedPacket relays the packet forwarded by chain A of the path to
+// chain B, and returns the ack written by chain A for the original packet on
+// the ack of chain B.
+func relayForwardedPacket(t *testing.T, path *ibctesting.Path, packet channeltypes.Packet) []byte {
+	require.NoError(t, path.EndpointB.UpdateClient())
+	res, err := path.EndpointB.RecvPacketWithResult(packet)
+	require.NoError(t, err)
+	ack, err := ibctesting.ParseAckFromEvents(res.Events)
+	require.NoError(t, err)
+
+	packetKey := host.PacketAcknowledgementKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	proof, proofHeight := path.EndpointB.QueryProof(packetKey)
+
+	chain := path.EndpointA.Chain
+	res, err = chain.SendMsgs(channeltypes.NewMsgAcknowledgement(packet, ack, proof, proofHeight, chain.SenderAccount.GetAddress().String()))
+	require.NoError(t, err)
+	ack, err = ibctesting.ParseAckFromEvents(res.Events)
+	require.NoError(t, err)
+
+	return ack
+}
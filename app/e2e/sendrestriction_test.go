@@ -0,0 +1,100 @@
+package e2e_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+
+	"github.com/initia-labs/miniwasm/app/e2e"
+	sendrestrictiontypes "github.com/initia-labs/miniwasm/x/sendrestriction/types"
+)
+
+// enableAllowlistOnly enables the allowlist only mode of the chain with the
+// allowlisted addresses.
+func enableAllowlistOnly(t *testing.T, chain *ibctesting.TestChain, allowlist ...sdk.AccAddress) {
+	app := e2e.GetMinitiaApp(chain)
+	ctx := chain.GetContext()
+	require.NoError(t, app.SendRestrictionKeeper.SetParams(ctx, sendrestrictiontypes.NewParams(true)))
+	for _, addr := range allowlist {
+		require.NoError(t, app.SendRestrictionKeeper.Allowlist.Set(ctx, addr))
+	}
+
+	chain.Coordinator.CommitBlock(chain)
+}
+
+func Test_SendRestriction_AllowlistOnly_Transfer(t *testing.T) {
+	coord := e2e.NewCoordinator(t, 2)
+	chainA := coord.GetChain(ibctesting.GetChainID(1))
+	chainB := coord.GetChain(ibctesting.GetChainID(2))
+	path := setupTransferPath(coord, chainA, chainB)
+
+	sender := chainA.SenderAccount.GetAddress()
+	receiver := chainB.SenderAccounts[1].SenderAccount.GetAddress()
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100))
+	senderBalance := balance(chainA, sender, sdk.DefaultBondDenom)
+
+	// the escrow address is exempt without being allowlisted
+	enableAllowlistOnly(t, chainA, sender)
+
+	packet := sendTransfer(t, path.EndpointA, coin, receiver.String(), "")
+	require.NoError(t, path.RelayPacket(packet))
+
+	escrowAddr := transfertypes.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	require.Equal(t, coin.Amount, balance(chainA, escrowAddr, sdk.DefaultBondDenom))
+
+	voucherDenom := ibcDenom(path.EndpointB, sdk.DefaultBondDenom)
+	require.Equal(t, coin.Amount, balance(chainB, receiver, voucherDenom))
+
+	// the coin is released from the escrow address to the allowlisted receiver
+	chainB.SenderAccount = chainB.SenderAccounts[1].SenderAccount
+	chainB.SenderPrivKey = chainB.SenderAccounts[1].SenderPrivKey
+	packet = sendTransfer(t, path.EndpointB, sdk.NewCoin(voucherDenom, coin.Amount), sender.String(), "")
+	require.NoError(t, path.RelayPacket(packet))
+
+	require.True(t, balance(chainA, escrowAddr, sdk.DefaultBondDenom).IsZero())
+	require.Equal(t, senderBalance, balance(chainA, sender, sdk.DefaultBondDenom))
+}
+
+func Test_SendRestriction_AllowlistOnly_WasmHook(t *testing.T) {
+	coord := e2e.NewCoordinator(t, 2)
+	chainA := coord.GetChain(ibctesting.GetChainID(1))
+	chainB := coord.GetChain(ibctesting.GetChainID(2))
+	path := setupTransferPath(coord, chainA, chainB)
+
+	contractAddr := instantiateContract(t, chainB, counterWasmPath)
+	allowHooks(t, chainB, contractAddr)
+
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100))
+	memo := fmt.Sprintf(`{"wasm":{"message":{"contract":"%s","msg":{"increase":{}}}}}`, contractAddr)
+	enableAllowlistOnly(t, chainB, chainB.SenderAccount.GetAddress())
+
+	// the intermediate sender is exempt, but the contract must be allowlisted
+	// to receive the coin
+	packet := sendTransfer(t, path.EndpointA, coin, contractAddr.String(), memo)
+	_, ackBz, err := path.RelayPacketWithResults(packet)
+	require.NoError(t, err)
+
+	var ack channeltypes.Acknowledgement
+	require.NoError(t, channeltypes.SubModuleCdc.UnmarshalJSON(ackBz, &ack))
+	require.False(t, ack.Success())
+	require.Equal(t, "0", queryCounter(t, chainB, contractAddr))
+
+	enableAllowlistOnly(t, chainB, contractAddr)
+	packet = sendTransfer(t, path.EndpointA, coin, contractAddr.String(), memo)
+	_, ackBz, err = path.RelayPacketWithResults(packet)
+	require.NoError(t, err)
+
+	require.NoError(t, channeltypes.SubModuleCdc.UnmarshalJSON(ackBz, &ack))
+	require.True(t, ack.Success())
+	require.Equal(t, "1", queryCounter(t, chainB, contractAddr))
+	require.Equal(t, coin.Amount, balance(chainB, contractAddr, ibcDenom(path.EndpointB, sdk.DefaultBondDenom)))
+}
@@ -0,0 +1,61 @@
+package e2e_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+
+	"github.com/initia-labs/miniwasm/app/e2e"
+	feeabstypes "github.com/initia-labs/miniwasm/x/feeabs/types"
+)
+
+func Test_SponsoredFee_ForgedSigner(t *testing.T) {
+	coord := e2e.NewCoordinator(t, 1)
+	chain := coord.GetChain(ibctesting.GetChainID(1))
+	app := e2e.GetMinitiaApp(chain)
+
+	// the counter contract rejects any sponsorship, so a sponsored tx fails with
+	// ErrSponsorRejected once the contract is asked.
+	contractAddr := instantiateContract(t, chain, counterWasmPath)
+	extOpt, err := codectypes.NewAnyWithValue(&feeabstypes.ExtensionOptionSponsoredFee{Contract: contractAddr.String()})
+	require.NoError(t, err)
+
+	signer := chain.SenderAccounts[1].SenderAccount
+	fee := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000))
+	newTx := func(sig []byte) sdk.Tx {
+		builder := app.TxConfig().NewTxBuilder()
+		require.NoError(t, builder.SetMsgs(banktypes.NewMsgSend(signer.GetAddress(), contractAddr, fee)))
+		builder.SetGasLimit(1_000_000)
+		builder.SetFeeAmount(fee)
+		builder.(authtx.ExtensionOptionsTxBuilder).SetExtensionOptions(extOpt)
+		require.NoError(t, builder.SetSignatures(signing.SignatureV2{
+			PubKey:   chain.SenderAccounts[1].SenderPrivKey.PubKey(),
+			Data:     &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT, Signature: sig},
+			Sequence: signer.GetSequence(),
+		}))
+
+		return builder.GetTx()
+	}
+
+	// the signature of the signer is forged, so the tx is rejected before the
+	// contract is asked to sponsor the fee
+	_, err = app.AnteHandler()(chain.GetContext(), newTx(make([]byte, 64)), false)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	require.NotErrorIs(t, err, feeabstypes.ErrSponsorRejected)
+
+	// the contract is asked only in the simulation, which skips the signature
+	// verification
+	ctx := chain.GetContext()
+	ctx = ctx.WithConsensusParams(app.GetConsensusParams(ctx))
+	_, err = app.AnteHandler()(ctx, newTx(nil), true)
+	require.ErrorIs(t, err, feeabstypes.ErrSponsorRejected)
+}
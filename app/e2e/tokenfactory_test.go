@@ -0,0 +1,77 @@
+package e2e_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+
+	"github.com/initia-labs/miniwasm/app/e2e"
+	tokenfactorytypes "github.com/initia-labs/miniwasm/x/tokenfactory/types"
+)
+
+func Test_TokenFactory_BeforeSendHook(t *testing.T) {
+	coord := e2e.NewCoordinator(t, 2)
+	chainA := coord.GetChain(ibctesting.GetChainID(1))
+	chainB := coord.GetChain(ibctesting.GetChainID(2))
+	path := setupTransferPath(coord, chainA, chainB)
+
+	sender := chainA.SenderAccount.GetAddress()
+	denom, err := tokenfactorytypes.GetTokenDenom(sender.String(), "bitcoin")
+	require.NoError(t, err)
+
+	_, err = chainA.SendMsgs(
+		tokenfactorytypes.NewMsgCreateDenom(sender.String(), "bitcoin"),
+		tokenfactorytypes.NewMsgMint(sender.String(), sdk.NewInt64Coin(denom, 1000)),
+	)
+	require.NoError(t, err)
+
+	receiver := chainB.SenderAccounts[1].SenderAccount.GetAddress()
+	escrowAddr := transfertypes.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	voucherDenom := ibcDenom(path.EndpointB, denom)
+	coin := sdk.NewInt64Coin(denom, 100)
+
+	packet := sendTransfer(t, path.EndpointA, coin, receiver.String(), "")
+	require.NoError(t, path.RelayPacket(packet))
+	require.Equal(t, coin.Amount, balance(chainA, escrowAddr, denom))
+	require.Equal(t, coin.Amount, balance(chainB, receiver, voucherDenom))
+
+	// the counter contract does not handle the before send messages, so the
+	// hook blocks every send of the denom
+	contractAddr := instantiateContract(t, chainA, counterWasmPath)
+	_, err = chainA.SendMsgs(tokenfactorytypes.NewMsgSetBeforeSendHook(sender.String(), denom, contractAddr.String()))
+	require.NoError(t, err)
+
+	// the hook blocks the escrow
+	_, err = chainA.SendMsgs(transfertypes.NewMsgTransfer(
+		path.EndpointA.ChannelConfig.PortID,
+		path.EndpointA.ChannelID,
+		coin,
+		sender.String(),
+		receiver.String(),
+		clienttypes.ZeroHeight(),
+		uint64(coord.CurrentTime.Add(time.Hour).UnixNano()),
+		"",
+	))
+	require.ErrorContains(t, err, "failed to call before send hook")
+
+	// the hook blocks the unescrow, so the voucher is refunded on chain B
+	chainB.SenderAccount = chainB.SenderAccounts[1].SenderAccount
+	chainB.SenderPrivKey = chainB.SenderAccounts[1].SenderPrivKey
+	packet = sendTransfer(t, path.EndpointB, sdk.NewCoin(voucherDenom, coin.Amount), sender.String(), "")
+	_, ackBz, err := path.RelayPacketWithResults(packet)
+	require.NoError(t, err)
+
+	var ack channeltypes.Acknowledgement
+	require.NoError(t, channeltypes.SubModuleCdc.UnmarshalJSON(ackBz, &ack))
+	require.False(t, ack.Success())
+	require.Equal(t, coin.Amount, balance(chainA, escrowAddr, denom))
+	require.Equal(t, coin.Amount, balance(chainB, receiver, voucherDenom))
+}
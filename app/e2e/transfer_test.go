@@ -0,0 +1,77 @@
+package e2e_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+
+	"github.com/initia-labs/miniwasm/app/e2e"
+)
+
+func Test_Transfer(t *testing.T) {
+	coord := e2e.NewCoordinator(t, 2)
+	chainA := coord.GetChain(ibctesting.GetChainID(1))
+	chainB := coord.GetChain(ibctesting.GetChainID(2))
+	path := setupTransferPath(coord, chainA, chainB)
+
+	sender := chainA.SenderAccount.GetAddress()
+	receiver := chainB.SenderAccounts[1].SenderAccount.GetAddress()
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100))
+	senderBalance := balance(chainA, sender, sdk.DefaultBondDenom)
+
+	packet := sendTransfer(t, path.EndpointA, coin, receiver.String(), "")
+	require.NoError(t, path.RelayPacket(packet))
+
+	// the coin is escrowed on chain A, and minted on chain B
+	escrowAddr := transfertypes.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	require.Equal(t, senderBalance.Sub(coin.Amount), balance(chainA, sender, sdk.DefaultBondDenom))
+	require.Equal(t, coin.Amount, balance(chainA, escrowAddr, sdk.DefaultBondDenom))
+
+	voucherDenom := ibcDenom(path.EndpointB, sdk.DefaultBondDenom)
+	require.Equal(t, coin.Amount, balance(chainB, receiver, voucherDenom))
+
+	// send the voucher back to chain A from the receiver
+	chainB.SenderAccount = chainB.SenderAccounts[1].SenderAccount
+	chainB.SenderPrivKey = chainB.SenderAccounts[1].SenderPrivKey
+	packet = sendTransfer(t, path.EndpointB, sdk.NewCoin(voucherDenom, coin.Amount), sender.String(), "")
+	require.NoError(t, path.RelayPacket(packet))
+
+	require.True(t, balance(chainB, receiver, voucherDenom).IsZero())
+	require.True(t, balance(chainA, escrowAddr, sdk.DefaultBondDenom).IsZero())
+	require.Equal(t, senderBalance, balance(chainA, sender, sdk.DefaultBondDenom))
+}
+
+func Test_Transfer_Timeout(t *testing.T) {
+	coord := e2e.NewCoordinator(t, 2)
+	chainA := coord.GetChain(ibctesting.GetChainID(1))
+	chainB := coord.GetChain(ibctesting.GetChainID(2))
+	path := setupTransferPath(coord, chainA, chainB)
+
+	sender := chainA.SenderAccount.GetAddress()
+	receiver := chainB.SenderAccounts[1].SenderAccount.GetAddress()
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100))
+	senderBalance := balance(chainA, sender, sdk.DefaultBondDenom)
+
+	packet := sendTransferWithTimeout(t, path.EndpointA, coin, receiver.String(), "", time.Minute)
+	require.Equal(t, senderBalance.Sub(coin.Amount), balance(chainA, sender, sdk.DefaultBondDenom))
+
+	// the packet can not be received after the timeout
+	coord.IncrementTimeBy(time.Hour)
+	coord.CommitBlock(chainB)
+	require.NoError(t, path.EndpointB.UpdateClient())
+	require.Error(t, path.EndpointB.RecvPacket(packet))
+
+	// the coin is refunded by the timeout
+	require.NoError(t, path.EndpointA.UpdateClient())
+	require.NoError(t, path.EndpointA.TimeoutPacket(packet))
+	require.Equal(t, senderBalance, balance(chainA, sender, sdk.DefaultBondDenom))
+	require.True(t, balance(chainB, receiver, ibcDenom(path.EndpointB, sdk.DefaultBondDenom)).IsZero())
+}
@@ -1,21 +1,283 @@
 package app
 
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sort"
+
+	"github.com/spf13/cast"
+	"github.com/spf13/viper"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+)
+
 // Executor change plans
 
-func (app *MinitiaApp) RegisterExecutorChangePlans() error {
-	// err := app.OPChildKeeper.RegisterExecutorChangePlan(
-	// 	1,
-	// 	361,
-	// 	"initvaloper158x0dpu5f4x703fhtseg5kpytsj02hw04qyw0t",
-	// 	"init158x0dpu5f4x703fhtseg5kpytsj02hw0p9ahpm",
-	// 	"op2",
-	// 	"{\"@type\":\"/cosmos.crypto.ed25519.PubKey\",\"key\":\"GTJEksmVK7gkzPXdj+YIJxipfJ+yYUlc6jzIuh9s2t0=\"}",
-	// 	"testestestestsetsetestsetest",
-	// )
-
-	// if err != nil {
-	// 	return err
-	// }
+const (
+	flagExecutorChangePrefix = "executor-change"
+
+	flagExecutorChangePlansFile = "plans-file"
+	flagExecutorChangePlans     = "plans"
+
+	// executorChangePlansKey is the key of the plans in the plans file.
+	executorChangePlansKey = "plans"
+)
+
+// ExecutorChangeConfig defines the executor change plans of the node.
+type ExecutorChangeConfig struct {
+	// PlansFile is the path of the json or toml file of the plans. A relative
+	// path is relative to the node home.
+	PlansFile string `mapstructure:"plans-file"`
+}
+
+// DefaultExecutorChangeConfig returns the default config without plans.
+func DefaultExecutorChangeConfig() ExecutorChangeConfig {
+	return ExecutorChangeConfig{}
+}
+
+// ExecutorChangePlan defines the change of the executor at a height, which is
+// agreed upon by the proposal on L1.
+type ExecutorChangePlan struct {
+	// BridgeID is the id of the bridge of the chain.
+	BridgeID uint64 `json:"bridge_id"`
+
+	// ProposalID is the id of the L1 proposal of the change.
+	ProposalID uint64 `json:"proposal_id"`
+
+	// Height is the height at which the executor is changed.
+	Height uint64 `json:"height"`
+
+	// NextValidator is the operator address of the new validator.
+	NextValidator string `json:"next_validator"`
+
+	// NextExecutors are the addresses of the new bridge executors.
+	NextExecutors []string `json:"next_executors"`
+
+	// Moniker is the moniker of the new validator.
+	Moniker string `json:"moniker"`
+
+	// ConsensusPubKey is the consensus public key of the new validator in the
+	// json of the Any, e.g. {"@type":"/cosmos.crypto.ed25519.PubKey","key":"..."}.
+	ConsensusPubKey json.RawMessage `json:"consensus_pubkey"`
+
+	// Info is the description of the change.
+	Info string `json:"info"`
+}
+
+// Validate checks the plan is well-formed.
+func (p ExecutorChangePlan) Validate(cdc codec.Codec, ac, vc address.Codec) error {
+	if p.BridgeID == 0 {
+		return errors.New("bridge id must be greater than 0")
+	}
+	if p.ProposalID == 0 {
+		return errors.New("proposal id must be greater than 0")
+	}
+	if p.Height == 0 {
+		return errors.New("height must be greater than 0")
+	}
+
+	if _, err := vc.StringToBytes(p.NextValidator); err != nil {
+		return fmt.Errorf("invalid next validator %s: %w", p.NextValidator, err)
+	}
+
+	if len(p.NextExecutors) == 0 {
+		return errors.New("next executors must not be empty")
+	}
+	for _, executor := range p.NextExecutors {
+		if _, err := ac.StringToBytes(executor); err != nil {
+			return fmt.Errorf("invalid next executor %s: %w", executor, err)
+		}
+	}
+
+	if p.Moniker == "" {
+		return errors.New("moniker must not be empty")
+	}
+
+	var pubKey cryptotypes.PubKey
+	if err := cdc.UnmarshalInterfaceJSON(p.ConsensusPubKey, &pubKey); err != nil {
+		return fmt.Errorf("invalid consensus pubkey: %w", err)
+	}
+	if _, err := cryptocodec.ToCmtPubKeyInterface(pubKey); err != nil {
+		return fmt.Errorf("invalid consensus pubkey: %w", err)
+	}
+
+	return nil
+}
+
+// ValidateExecutorChangePlans checks the plans are well-formed, and their
+// heights are unique.
+func ValidateExecutorChangePlans(plans []ExecutorChangePlan, cdc codec.Codec, ac, vc address.Codec) error {
+	heights := make(map[uint64]bool, len(plans))
+	for i, plan := range plans {
+		if err := plan.Validate(cdc, ac, vc); err != nil {
+			return fmt.Errorf("invalid executor change plan %d: %w", i, err)
+		}
+
+		if heights[plan.Height] {
+			return fmt.Errorf("duplicate executor change plan at height %d", plan.Height)
+		}
+		heights[plan.Height] = true
+	}
+
+	return nil
+}
+
+// LoadExecutorChangePlans reads the executor change plans of app.toml and the
+// plans file, sorted by height.
+func LoadExecutorChangePlans(appOpts servertypes.AppOptions) ([]ExecutorChangePlan, error) {
+	plans, err := decodeExecutorChangePlans(appOpts.Get(fmt.Sprintf("%s.%s", flagExecutorChangePrefix, flagExecutorChangePlans)))
+	if err != nil {
+		return nil, fmt.Errorf("invalid executor change plans in app.toml: %w", err)
+	}
+
+	plansFile := cast.ToString(appOpts.Get(fmt.Sprintf("%s.%s", flagExecutorChangePrefix, flagExecutorChangePlansFile)))
+	if plansFile != "" {
+		if !filepath.IsAbs(plansFile) {
+			plansFile = filepath.Join(cast.ToString(appOpts.Get(flags.FlagHome)), plansFile)
+		}
+
+		filePlans, err := ReadExecutorChangePlansFile(plansFile)
+		if err != nil {
+			return nil, err
+		}
+
+		plans = append(plans, filePlans...)
+	}
+
+	sort.SliceStable(plans, func(i, j int) bool {
+		return plans[i].Height < plans[j].Height
+	})
+
+	return plans, nil
+}
+
+// ReadExecutorChangePlansFile reads the executor change plans of the json or
+// toml file, which has the plans under the "plans" key.
+func ReadExecutorChangePlansFile(path string) ([]ExecutorChangePlan, error) {
+	v := viper.New()
+	v.SetConfigFile(path)
+	if err := v.ReadInConfig(); err != nil {
+		return nil, fmt.Errorf("failed to read executor change plans file %s: %w", path, err)
+	}
+
+	plans, err := decodeExecutorChangePlans(v.Get(executorChangePlansKey))
+	if err != nil {
+		return nil, fmt.Errorf("invalid executor change plans file %s: %w", path, err)
+	}
+
+	return plans, nil
+}
+
+// decodeExecutorChangePlans decodes the plans read by viper through json, so
+// the json and the toml plans are decoded in the same way.
+func decodeExecutorChangePlans(raw interface{}) ([]ExecutorChangePlan, error) {
+	if raw == nil {
+		return nil, nil
+	}
+
+	bz, err := json.Marshal(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	var plans []ExecutorChangePlan
+	if err := json.Unmarshal(bz, &plans); err != nil {
+		return nil, err
+	}
+
+	// the consensus pubkey may be given as a json string
+	for i, plan := range plans {
+		var pubKey string
+		if err := json.Unmarshal(plan.ConsensusPubKey, &pubKey); err == nil {
+			plans[i].ConsensusPubKey = json.RawMessage(pubKey)
+		}
+	}
+
+	return plans, nil
+}
+
+// RegisterExecutorChangePlans registers the executor change plans to the
+// opchild keeper.
+func (app *MinitiaApp) RegisterExecutorChangePlans(plans []ExecutorChangePlan) error {
+	if err := ValidateExecutorChangePlans(plans, app.appCodec, app.ac, app.vc); err != nil {
+		return err
+	}
+
+	for _, plan := range plans {
+		err := app.OPChildKeeper.RegisterExecutorChangePlan(
+			plan.ProposalID,
+			plan.Height,
+			plan.NextValidator,
+			plan.Moniker,
+			string(plan.ConsensusPubKey),
+			plan.Info,
+			plan.NextExecutors,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to register executor change plan at height %d: %w", plan.Height, err)
+		}
+	}
 
 	return nil
 }
+
+// checkExecutorChangePlans checks the plans are for the bridge of the chain.
+// The plans are not checked before the bridge info is set.
+func (app *MinitiaApp) checkExecutorChangePlans(ctx context.Context, plans []ExecutorChangePlan) error {
+	bridgeInfo, err := app.OPChildKeeper.BridgeInfo.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	for _, plan := range plans {
+		if plan.BridgeID != bridgeInfo.BridgeId {
+			return fmt.Errorf("executor change plan at height %d is for bridge %d, but the bridge of the chain is %d", plan.Height, plan.BridgeID, bridgeInfo.BridgeId)
+		}
+	}
+
+	return nil
+}
+
+// ExecutorChangeConfigTemplate is the app.toml template of the executor change
+// plans.
+const ExecutorChangeConfigTemplate = `
+###############################################################################
+###                         Executor Change                                 ###
+###############################################################################
+
+# The executor change plans change the validator and the bridge executors at
+# the given heights, as agreed upon by the proposals on L1. All the nodes must
+# have the same plans. The plans are checked at startup, and can be checked
+# offline with "minitiad executor-plans validate".
+#
+# The plans are read from the plans file and from the [[executor-change.plans]]
+# tables following the [executor-change] section, e.g.
+#
+# [[executor-change.plans]]
+# bridge_id = 1
+# proposal_id = 1
+# height = 1000
+# next_validator = "initvaloper1..."
+# next_executors = ["init1..."]
+# moniker = "executor"
+# consensus_pubkey = '{"@type":"/cosmos.crypto.ed25519.PubKey","key":"..."}'
+# info = "rotate the executor"
+
+[executor-change]
+
+# The path of the json or toml file of the plans, which has the plans under the
+# "plans" key. A relative path is relative to the node home.
+plans-file = "{{ .ExecutorChangeConfig.PlansFile }}"
+`
@@ -0,0 +1,170 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	opchildtypes "github.com/initia-labs/OPinit/x/opchild/types"
+)
+
+func testExecutorChangePlan(t *testing.T, height uint64) ExecutorChangePlan {
+	privKey := ed25519.GenPrivKey()
+	pubKey, err := MakeEncodingConfig().Codec.MarshalInterfaceJSON(privKey.PubKey())
+	require.NoError(t, err)
+
+	return ExecutorChangePlan{
+		BridgeID:        1,
+		ProposalID:      1,
+		Height:          height,
+		NextValidator:   sdk.ValAddress(privKey.PubKey().Address()).String(),
+		NextExecutors:   []string{sdk.AccAddress(privKey.PubKey().Address()).String()},
+		Moniker:         "executor",
+		ConsensusPubKey: pubKey,
+		Info:            "rotate the executor",
+	}
+}
+
+func TestLoadExecutorChangePlans(t *testing.T) {
+	home := t.TempDir()
+	plan1 := testExecutorChangePlan(t, 100)
+	plan2 := testExecutorChangePlan(t, 50)
+
+	// plan1 in the plans file
+	bz, err := json.Marshal(map[string]interface{}{"plans": []ExecutorChangePlan{plan1}})
+	require.NoError(t, err)
+	require.NoError(t, os.MkdirAll(filepath.Join(home, "config"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(home, "config", "executor_change_plans.json"), bz, 0o600))
+
+	// plan2 in app.toml
+	appToml := fmt.Sprintf(`
+[executor-change]
+plans-file = "config/executor_change_plans.json"
+
+[[executor-change.plans]]
+bridge_id = %d
+proposal_id = %d
+height = %d
+next_validator = "%s"
+next_executors = ["%s"]
+moniker = "%s"
+consensus_pubkey = '%s'
+info = "%s"
+`, plan2.BridgeID, plan2.ProposalID, plan2.Height, plan2.NextValidator, plan2.NextExecutors[0], plan2.Moniker, plan2.ConsensusPubKey, plan2.Info)
+
+	appOpts := viper.New()
+	appOpts.SetConfigType("toml")
+	require.NoError(t, appOpts.ReadConfig(strings.NewReader(appToml)))
+	appOpts.Set(flags.FlagHome, home)
+
+	plans, err := LoadExecutorChangePlans(appOpts)
+	require.NoError(t, err)
+	require.Len(t, plans, 2)
+	require.Equal(t, plan2.Height, plans[0].Height)
+	require.Equal(t, plan2.NextValidator, plans[0].NextValidator)
+	require.JSONEq(t, string(plan2.ConsensusPubKey), string(plans[0].ConsensusPubKey))
+	require.Equal(t, plan1.Height, plans[1].Height)
+	require.JSONEq(t, string(plan1.ConsensusPubKey), string(plans[1].ConsensusPubKey))
+
+	encodingConfig := MakeEncodingConfig()
+	signingContext := encodingConfig.TxConfig.SigningContext()
+	require.NoError(t, ValidateExecutorChangePlans(plans, encodingConfig.Codec, signingContext.AddressCodec(), signingContext.ValidatorAddressCodec()))
+
+	// no plans
+	plans, err = LoadExecutorChangePlans(EmptyAppOptions{homeDir: home})
+	require.NoError(t, err)
+	require.Empty(t, plans)
+
+	// missing plans file
+	appOpts.Set("executor-change.plans-file", "missing.json")
+	_, err = LoadExecutorChangePlans(appOpts)
+	require.Error(t, err)
+}
+
+func TestReadExecutorChangePlansFile_TOML(t *testing.T) {
+	plan := testExecutorChangePlan(t, 10)
+
+	path := filepath.Join(t.TempDir(), "plans.toml")
+	require.NoError(t, os.WriteFile(path, []byte(fmt.Sprintf(`
+[[plans]]
+bridge_id = 1
+proposal_id = 2
+height = 10
+next_validator = "%s"
+next_executors = ["%s"]
+moniker = "executor"
+consensus_pubkey = '%s'
+`, plan.NextValidator, plan.NextExecutors[0], plan.ConsensusPubKey)), 0o600))
+
+	plans, err := ReadExecutorChangePlansFile(path)
+	require.NoError(t, err)
+	require.Len(t, plans, 1)
+	require.Equal(t, uint64(2), plans[0].ProposalID)
+	require.JSONEq(t, string(plan.ConsensusPubKey), string(plans[0].ConsensusPubKey))
+}
+
+func TestValidateExecutorChangePlans(t *testing.T) {
+	encodingConfig := MakeEncodingConfig()
+	signingContext := encodingConfig.TxConfig.SigningContext()
+	validate := func(plans ...ExecutorChangePlan) error {
+		return ValidateExecutorChangePlans(plans, encodingConfig.Codec, signingContext.AddressCodec(), signingContext.ValidatorAddressCodec())
+	}
+
+	secp256r1PrivKey, err := secp256r1.GenPrivKey()
+	require.NoError(t, err)
+	secp256r1PubKey, err := encodingConfig.Codec.MarshalInterfaceJSON(secp256r1PrivKey.PubKey())
+	require.NoError(t, err)
+
+	for name, malleate := range map[string]func(plan *ExecutorChangePlan){
+		"zero bridge id":          func(plan *ExecutorChangePlan) { plan.BridgeID = 0 },
+		"zero proposal id":        func(plan *ExecutorChangePlan) { plan.ProposalID = 0 },
+		"zero height":             func(plan *ExecutorChangePlan) { plan.Height = 0 },
+		"invalid next validator":  func(plan *ExecutorChangePlan) { plan.NextValidator = plan.NextExecutors[0] },
+		"empty next executors":    func(plan *ExecutorChangePlan) { plan.NextExecutors = nil },
+		"invalid next executor":   func(plan *ExecutorChangePlan) { plan.NextExecutors = []string{"invalid"} },
+		"empty moniker":           func(plan *ExecutorChangePlan) { plan.Moniker = "" },
+		"invalid consensus key":   func(plan *ExecutorChangePlan) { plan.ConsensusPubKey = []byte(`{}`) },
+		"secp256r1 consensus key": func(plan *ExecutorChangePlan) { plan.ConsensusPubKey = secp256r1PubKey },
+	} {
+		plan := testExecutorChangePlan(t, 10)
+		malleate(&plan)
+		require.Error(t, validate(plan), name)
+	}
+
+	require.NoError(t, validate(testExecutorChangePlan(t, 10), testExecutorChangePlan(t, 20)))
+	require.ErrorContains(t, validate(testExecutorChangePlan(t, 10), testExecutorChangePlan(t, 10)), "duplicate")
+}
+
+func TestRegisterExecutorChangePlans(t *testing.T) {
+	app := SetupWithGenesisAccounts(t.TempDir(), nil, nil)
+
+	plan := testExecutorChangePlan(t, 100)
+	require.NoError(t, app.RegisterExecutorChangePlans([]ExecutorChangePlan{plan}))
+
+	registered, ok := app.OPChildKeeper.ExecutorChangePlans[plan.Height]
+	require.True(t, ok)
+	require.Equal(t, plan.ProposalID, registered.ProposalID)
+	require.Equal(t, plan.NextExecutors, registered.NextExecutors)
+	require.Equal(t, plan.Moniker, registered.NextValidator.Moniker)
+
+	// the plans are checked against the bridge of the chain
+	ctx := app.NewContext(true)
+	require.NoError(t, app.checkExecutorChangePlans(ctx, []ExecutorChangePlan{plan}))
+
+	require.NoError(t, app.OPChildKeeper.BridgeInfo.Set(ctx, opchildtypes.BridgeInfo{BridgeId: 1}))
+	require.NoError(t, app.checkExecutorChangePlans(ctx, []ExecutorChangePlan{plan}))
+
+	require.NoError(t, app.OPChildKeeper.BridgeInfo.Set(ctx, opchildtypes.BridgeInfo{BridgeId: 2}))
+	require.Error(t, app.checkExecutorChangePlans(ctx, []ExecutorChangePlan{plan}))
+}
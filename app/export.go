@@ -23,6 +23,11 @@ func (app *MinitiaApp) ExportAppStateAndValidators(
 		height = 0
 	}
 
+	// the export order includes the modules without the app module, e.g. genutil
+	if len(modulesToExport) == 0 {
+		modulesToExport = app.exportableModules()
+	}
+
 	genState, err := app.ModuleManager.ExportGenesisForModules(ctx, app.appCodec, modulesToExport)
 	if err != nil {
 		return servertypes.ExportedApp{}, err
@@ -44,3 +49,16 @@ func (app *MinitiaApp) ExportAppStateAndValidators(
 		ConsensusParams: app.BaseApp.GetConsensusParams(ctx),
 	}, nil
 }
+
+// exportableModules returns the modules in the export genesis order, which are
+// registered to the module manager.
+func (app *MinitiaApp) exportableModules() []string {
+	var modules []string
+	for _, moduleName := range app.ModuleManager.OrderExportGenesis {
+		if _, ok := app.ModuleManager.Modules[moduleName]; ok {
+			modules = append(modules, moduleName)
+		}
+	}
+
+	return modules
+}
@@ -0,0 +1,542 @@
+package app
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"sort"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+
+	"cosmossdk.io/core/address"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/ioutils"
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	wasmvm "github.com/CosmWasm/wasmvm/v2"
+
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+
+	opchildtypes "github.com/initia-labs/OPinit/x/opchild/types"
+
+	tokenfactorytypes "github.com/initia-labs/miniwasm/x/tokenfactory/types"
+	wasmhookstypes "github.com/initia-labs/miniwasm/x/wasmhooks/types"
+)
+
+// GenesisFactoryDenom defines a tokenfactory denom to be created at genesis.
+type GenesisFactoryDenom struct {
+	Creator  string
+	Subdenom string
+
+	// Admin is the admin of the denom, defaults to the creator.
+	Admin string
+
+	// HookAddress is the before send hook contract of the denom.
+	HookAddress string
+
+	// Metadata of the denom. The display unit is only added if the decimals
+	// is not zero.
+	Name        string
+	Symbol      string
+	Description string
+	Display     string
+	Decimals    uint32
+	URI         string
+
+	InitialBalances []GenesisDenomBalance
+}
+
+// GenesisDenomBalance defines an initial balance of a genesis factory denom.
+type GenesisDenomBalance struct {
+	Address string
+	Amount  sdkmath.Int
+}
+
+// GenesisContract defines a contract to be instantiated at genesis.
+type GenesisContract struct {
+	CodeID  uint64
+	Creator string
+	Admin   string
+	Label   string
+	Msg     []byte
+
+	// Salt is used to derive the contract address with instantiate2. The
+	// classic sequence based address is used if the salt is empty.
+	Salt   []byte
+	FixMsg bool
+}
+
+// AddWasmCode adds the wasm code to the wasm genesis state, and returns the
+// code id assigned to it.
+func (genState GenesisState) AddWasmCode(
+	cdc codec.JSONCodec,
+	creator string,
+	wasmCode []byte,
+	instantiateConfig wasmtypes.AccessConfig,
+	pinned bool,
+) (uint64, error) {
+	var wasmGenState wasmtypes.GenesisState
+	if err := cdc.UnmarshalJSON(genState[wasmtypes.ModuleName], &wasmGenState); err != nil {
+		return 0, err
+	}
+
+	code := wasmCode
+	if ioutils.IsGzip(code) {
+		var err error
+		code, err = ioutils.Uncompress(code, math.MaxInt64)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	checksum, err := wasmvm.CreateChecksum(code)
+	if err != nil {
+		return 0, err
+	}
+
+	codeID := getWasmSequence(wasmGenState, wasmtypes.KeySequenceCodeID)
+	for _, c := range wasmGenState.Codes {
+		if bytes.Equal(c.CodeInfo.CodeHash, checksum) {
+			return 0, fmt.Errorf("code already exists with id %d", c.CodeID)
+		}
+		if c.CodeID >= codeID {
+			codeID = c.CodeID + 1
+		}
+	}
+
+	wasmGenState.Codes = append(wasmGenState.Codes, wasmtypes.Code{
+		CodeID: codeID,
+		CodeInfo: wasmtypes.CodeInfo{
+			CodeHash:          checksum,
+			Creator:           creator,
+			InstantiateConfig: instantiateConfig,
+		},
+		CodeBytes: wasmCode,
+		Pinned:    pinned,
+	})
+	setWasmSequence(&wasmGenState, wasmtypes.KeySequenceCodeID, codeID+1)
+	setWasmSequence(&wasmGenState, wasmtypes.KeySequenceInstanceID, getWasmSequence(wasmGenState, wasmtypes.KeySequenceInstanceID))
+
+	if err := wasmGenState.ValidateBasic(); err != nil {
+		return 0, err
+	}
+
+	bz, err := cdc.MarshalJSON(&wasmGenState)
+	if err != nil {
+		return 0, err
+	}
+
+	genState[wasmtypes.ModuleName] = bz
+	return codeID, nil
+}
+
+// getWasmSequence returns the value of the wasm sequence, or 1 if the sequence
+// is not set.
+func getWasmSequence(wasmGenState wasmtypes.GenesisState, key []byte) uint64 {
+	for _, seq := range wasmGenState.Sequences {
+		if bytes.Equal(seq.IDKey, key) {
+			return seq.Value
+		}
+	}
+
+	return 1
+}
+
+func setWasmSequence(wasmGenState *wasmtypes.GenesisState, key []byte, value uint64) {
+	for i, seq := range wasmGenState.Sequences {
+		if bytes.Equal(seq.IDKey, key) {
+			wasmGenState.Sequences[i].Value = value
+			return
+		}
+	}
+
+	wasmGenState.Sequences = append(wasmGenState.Sequences, wasmtypes.Sequence{IDKey: key, Value: value})
+}
+
+// AddFactoryDenom adds the tokenfactory denom with its bank metadata and the
+// initial balances to the genesis state, and returns the full denom.
+func (genState GenesisState) AddFactoryDenom(cdc codec.JSONCodec, ac address.Codec, factoryDenom GenesisFactoryDenom) (string, error) {
+	if _, err := ac.StringToBytes(factoryDenom.Creator); err != nil {
+		return "", errorsmod.Wrap(err, "invalid creator")
+	}
+
+	denom, err := tokenfactorytypes.GetTokenDenom(factoryDenom.Creator, factoryDenom.Subdenom)
+	if err != nil {
+		return "", err
+	}
+
+	admin := factoryDenom.Admin
+	if admin == "" {
+		admin = factoryDenom.Creator
+	}
+
+	var tokenFactoryGenState tokenfactorytypes.GenesisState
+	if err := cdc.UnmarshalJSON(genState[tokenfactorytypes.ModuleName], &tokenFactoryGenState); err != nil {
+		return "", err
+	}
+
+	tokenFactoryGenState.FactoryDenoms = append(tokenFactoryGenState.FactoryDenoms, tokenfactorytypes.GenesisDenom{
+		Denom:             denom,
+		AuthorityMetadata: tokenfactorytypes.DenomAuthorityMetadata{Admin: admin},
+		HookAddress:       factoryDenom.HookAddress,
+	})
+	if err := tokenFactoryGenState.Validate(ac); err != nil {
+		return "", err
+	}
+
+	var bankGenState banktypes.GenesisState
+	if err := cdc.UnmarshalJSON(genState[banktypes.ModuleName], &bankGenState); err != nil {
+		return "", err
+	}
+
+	metadata := factoryDenom.metadata(denom)
+	if err := metadata.Validate(); err != nil {
+		return "", err
+	}
+	for _, md := range bankGenState.DenomMetadata {
+		if md.Base == denom {
+			return "", fmt.Errorf("metadata of %s already exists", denom)
+		}
+	}
+	bankGenState.DenomMetadata = append(bankGenState.DenomMetadata, metadata)
+
+	totalSupply := sdkmath.ZeroInt()
+	for _, balance := range factoryDenom.InitialBalances {
+		if _, err := ac.StringToBytes(balance.Address); err != nil {
+			return "", errorsmod.Wrapf(err, "invalid balance address %s", balance.Address)
+		}
+		if !balance.Amount.IsPositive() {
+			return "", fmt.Errorf("balance amount of %s must be positive", balance.Address)
+		}
+
+		coins := sdk.NewCoins(sdk.NewCoin(denom, balance.Amount))
+		totalSupply = totalSupply.Add(balance.Amount)
+
+		found := false
+		for i, bal := range bankGenState.Balances {
+			if bal.Address == balance.Address {
+				bankGenState.Balances[i].Coins = bal.Coins.Add(coins...)
+				found = true
+				break
+			}
+		}
+		if !found {
+			bankGenState.Balances = append(bankGenState.Balances, banktypes.Balance{Address: balance.Address, Coins: coins})
+		}
+	}
+
+	// the supply is computed from the balances at InitGenesis if it is empty
+	if !bankGenState.Supply.Empty() && totalSupply.IsPositive() {
+		bankGenState.Supply = bankGenState.Supply.Add(sdk.NewCoin(denom, totalSupply))
+	}
+
+	if err := bankGenState.Validate(); err != nil {
+		return "", err
+	}
+
+	bankBz, err := cdc.MarshalJSON(&bankGenState)
+	if err != nil {
+		return "", err
+	}
+
+	tokenFactoryBz, err := cdc.MarshalJSON(&tokenFactoryGenState)
+	if err != nil {
+		return "", err
+	}
+
+	genState[banktypes.ModuleName] = bankBz
+	genState[tokenfactorytypes.ModuleName] = tokenFactoryBz
+	return denom, nil
+}
+
+func (factoryDenom GenesisFactoryDenom) metadata(denom string) banktypes.Metadata {
+	metadata := banktypes.Metadata{
+		Description: factoryDenom.Description,
+		DenomUnits: []*banktypes.DenomUnit{{
+			Denom:    denom,
+			Exponent: 0,
+		}},
+		Base:    denom,
+		Display: denom,
+		Name:    factoryDenom.Name,
+		Symbol:  factoryDenom.Symbol,
+		URI:     factoryDenom.URI,
+	}
+
+	if metadata.Name == "" {
+		metadata.Name = denom
+	}
+	if metadata.Symbol == "" {
+		metadata.Symbol = denom
+	}
+
+	if factoryDenom.Decimals != 0 && factoryDenom.Display != "" {
+		metadata.DenomUnits = append(metadata.DenomUnits, &banktypes.DenomUnit{
+			Denom:    factoryDenom.Display,
+			Exponent: factoryDenom.Decimals,
+		})
+		metadata.Display = factoryDenom.Display
+	}
+
+	return metadata
+}
+
+// SetIBCHookACL sets the ibc hook acl of the contract to the wasmhooks genesis
+// state, replacing the existing one.
+func (genState GenesisState) SetIBCHookACL(cdc codec.JSONCodec, ac address.Codec, acl wasmhookstypes.ContractACL) error {
+	var wasmHooksGenState wasmhookstypes.GenesisState
+	if err := cdc.UnmarshalJSON(genState[wasmhookstypes.ModuleName], &wasmHooksGenState); err != nil {
+		return err
+	}
+
+	found := false
+	for i, existing := range wasmHooksGenState.ContractAcls {
+		if existing.Contract == acl.Contract {
+			wasmHooksGenState.ContractAcls[i] = acl
+			found = true
+			break
+		}
+	}
+	if !found {
+		wasmHooksGenState.ContractAcls = append(wasmHooksGenState.ContractAcls, acl)
+	}
+
+	if err := wasmHooksGenState.Validate(ac); err != nil {
+		return err
+	}
+
+	bz, err := cdc.MarshalJSON(&wasmHooksGenState)
+	if err != nil {
+		return err
+	}
+
+	genState[wasmhookstypes.ModuleName] = bz
+	return nil
+}
+
+// InstantiateGenesisContract instantiates the contract on top of the genesis
+// with a dry InitChain, and writes the exported wasm state back to the genesis.
+// The capability state is written back as well, as a contract with the ibc
+// entry points binds its ibc port at the instantiation. The instantiation must
+// not change the state of the other modules.
+func InstantiateGenesisContract(appGenesis *genutiltypes.AppGenesis, contract GenesisContract) (string, error) {
+	var contractAddr string
+	var changedGenStates map[string]json.RawMessage
+	err := DryInitChain(appGenesis, func(app *MinitiaApp, ctx sdk.Context) error {
+		creator, err := app.ac.StringToBytes(contract.Creator)
+		if err != nil {
+			return errorsmod.Wrap(err, "invalid creator")
+		}
+
+		var admin sdk.AccAddress
+		if contract.Admin != "" {
+			admin, err = app.ac.StringToBytes(contract.Admin)
+			if err != nil {
+				return errorsmod.Wrap(err, "invalid admin")
+			}
+		}
+
+		modules := app.exportableModules()
+		before, err := app.ModuleManager.ExportGenesisForModules(ctx, app.appCodec, modules)
+		if err != nil {
+			return err
+		}
+
+		contractKeeper := wasmkeeper.NewGovPermissionKeeper(app.WasmKeeper)
+
+		var addr sdk.AccAddress
+		if len(contract.Salt) == 0 {
+			addr, _, err = contractKeeper.Instantiate(ctx, contract.CodeID, creator, admin, contract.Msg, contract.Label, nil)
+		} else {
+			addr, _, err = contractKeeper.Instantiate2(ctx, contract.CodeID, creator, admin, contract.Msg, contract.Label, nil, contract.Salt, contract.FixMsg)
+		}
+		if err != nil {
+			return err
+		}
+
+		after, err := app.ModuleManager.ExportGenesisForModules(ctx, app.appCodec, modules)
+		if err != nil {
+			return err
+		}
+
+		// the contract account is created at instantiation, but it is not
+		// required to be in the genesis.
+		var changed []string
+		for moduleName, bz := range after {
+			if moduleName == wasmtypes.ModuleName || moduleName == capabilitytypes.ModuleName || moduleName == authtypes.ModuleName {
+				continue
+			}
+			if !bytes.Equal(before[moduleName], bz) {
+				changed = append(changed, moduleName)
+			}
+		}
+		if len(changed) > 0 {
+			sort.Strings(changed)
+			return fmt.Errorf("contract instantiation changed the state of the other modules: %v", changed)
+		}
+
+		contractAddr, err = app.ac.BytesToString(addr)
+		if err != nil {
+			return err
+		}
+
+		changedGenStates = map[string]json.RawMessage{
+			wasmtypes.ModuleName:       after[wasmtypes.ModuleName],
+			capabilitytypes.ModuleName: after[capabilitytypes.ModuleName],
+		}
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	var genState GenesisState
+	if err := json.Unmarshal(appGenesis.AppState, &genState); err != nil {
+		return "", err
+	}
+	for moduleName, bz := range changedGenStates {
+		genState[moduleName] = bz
+	}
+
+	appState, err := json.Marshal(genState)
+	if err != nil {
+		return "", err
+	}
+
+	appGenesis.AppState = appState
+	return contractAddr, nil
+}
+
+// DryInitChain runs InitChain with the genesis on an in-memory app to check the
+// genesis can be loaded. If the callback is given, it is called with the
+// context right after the InitChain. Nothing is persisted.
+//
+// The genesis can be seeded before the launch configures the opchild module,
+// so a temporary admin, bridge executor and validator are used in the dry run
+// if they are missing.
+func DryInitChain(appGenesis *genutiltypes.AppGenesis, callback func(app *MinitiaApp, ctx sdk.Context) error) (err error) {
+	homeDir, err := os.MkdirTemp("", AppName)
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(homeDir)
+
+	app := NewMinitiaApp(
+		log.NewNopLogger(),
+		dbm.NewMemDB(),
+		dbm.NewMemDB(),
+		nil,
+		true,
+		[]wasmkeeper.Option{},
+		EmptyAppOptions{homeDir: homeDir},
+		baseapp.SetChainID(appGenesis.ChainID),
+	)
+	defer app.Close()
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("failed to init chain: %v", r)
+		}
+	}()
+
+	consensusParams := tmtypes.DefaultConsensusParams().ToProto()
+	if appGenesis.Consensus != nil && appGenesis.Consensus.Params != nil {
+		consensusParams = appGenesis.Consensus.Params.ToProto()
+	}
+
+	initialHeight := appGenesis.InitialHeight
+	if initialHeight == 0 {
+		initialHeight = 1
+	}
+
+	appState, err := dryRunAppState(app.appCodec, appGenesis.AppState)
+	if err != nil {
+		return err
+	}
+
+	_, err = app.InitChain(&abci.RequestInitChain{
+		Time:            appGenesis.GenesisTime,
+		ChainId:         appGenesis.ChainID,
+		ConsensusParams: &consensusParams,
+		AppStateBytes:   appState,
+		InitialHeight:   initialHeight,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to init chain: %w", err)
+	}
+
+	if callback == nil {
+		return nil
+	}
+
+	ctx := app.NewContextLegacy(false, tmproto.Header{
+		ChainID: appGenesis.ChainID,
+		Height:  initialHeight,
+		Time:    appGenesis.GenesisTime,
+	})
+
+	return callback(app, ctx)
+}
+
+// dryRunAppState fills the missing opchild admin, bridge executor and validator
+// of the app state with the temporary ones.
+func dryRunAppState(cdc codec.Codec, appState json.RawMessage) (json.RawMessage, error) {
+	var genState GenesisState
+	if err := json.Unmarshal(appState, &genState); err != nil {
+		return nil, err
+	}
+
+	var opchildGenState opchildtypes.GenesisState
+	if err := cdc.UnmarshalJSON(genState[opchildtypes.ModuleName], &opchildGenState); err != nil {
+		return nil, err
+	}
+
+	privKey := ed25519.GenPrivKey()
+	addr := sdk.AccAddress(privKey.PubKey().Address())
+	if opchildGenState.Params.Admin == "" {
+		opchildGenState.Params.Admin = addr.String()
+	}
+	for i, executor := range opchildGenState.Params.BridgeExecutors {
+		if executor == "" {
+			opchildGenState.Params.BridgeExecutors[i] = addr.String()
+		}
+	}
+	if len(opchildGenState.Validators) == 0 {
+		pkAny, err := codectypes.NewAnyWithValue(privKey.PubKey())
+		if err != nil {
+			return nil, err
+		}
+
+		opchildGenState.Validators = []opchildtypes.Validator{{
+			Moniker:         "dry-run",
+			OperatorAddress: sdk.ValAddress(addr).String(),
+			ConsensusPubkey: pkAny,
+			ConsPower:       1,
+		}}
+	}
+
+	bz, err := cdc.MarshalJSON(&opchildGenState)
+	if err != nil {
+		return nil, err
+	}
+
+	genState[opchildtypes.ModuleName] = bz
+	return json.Marshal(genState)
+}
@@ -0,0 +1,190 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+
+	"github.com/initia-labs/miniwasm/types"
+	tokenfactorytypes "github.com/initia-labs/miniwasm/x/tokenfactory/types"
+	wasmhookstypes "github.com/initia-labs/miniwasm/x/wasmhooks/types"
+)
+
+func newTestAppGenesis(t *testing.T, genState GenesisState) *genutiltypes.AppGenesis {
+	appState, err := json.Marshal(genState)
+	require.NoError(t, err)
+
+	return &genutiltypes.AppGenesis{
+		ChainID:       "test-chain",
+		GenesisTime:   time.Now().UTC(),
+		InitialHeight: 1,
+		AppState:      appState,
+	}
+}
+
+func TestGenesisSeed(t *testing.T) {
+	encodingConfig := MakeEncodingConfig()
+	cdc := encodingConfig.Codec
+	ac := cdc.InterfaceRegistry().SigningContext().AddressCodec()
+	genState := NewDefaultGenesisState(cdc, BasicManager(), types.BaseDenom)
+
+	creator := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	receiver := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+
+	// add wasm code
+	wasmCode, err := os.ReadFile("../contrib/wasm/cw721_base.wasm")
+	require.NoError(t, err)
+
+	codeID, err := genState.AddWasmCode(cdc, creator, wasmCode, wasmtypes.AllowEverybody, false)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), codeID)
+
+	_, err = genState.AddWasmCode(cdc, creator, wasmCode, wasmtypes.AllowEverybody, false)
+	require.Error(t, err)
+
+	// add factory denom
+	denom, err := genState.AddFactoryDenom(cdc, ac, GenesisFactoryDenom{
+		Creator:     creator,
+		Subdenom:    "token",
+		HookAddress: receiver,
+		Symbol:      "TOKEN",
+		Display:     "token",
+		Decimals:    6,
+		InitialBalances: []GenesisDenomBalance{
+			{Address: receiver, Amount: sdkmath.NewInt(1_000_000)},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, fmt.Sprintf("factory/%s/token", creator), denom)
+
+	_, err = genState.AddFactoryDenom(cdc, ac, GenesisFactoryDenom{Creator: creator, Subdenom: "token"})
+	require.Error(t, err)
+
+	// set ibc hook acl
+	require.NoError(t, genState.SetIBCHookACL(cdc, ac, wasmhookstypes.ContractACL{Contract: receiver, AllowReceive: true}))
+	require.NoError(t, genState.SetIBCHookACL(cdc, ac, wasmhookstypes.ContractACL{Contract: receiver, AllowCallback: true}))
+
+	var wasmHooksGenState wasmhookstypes.GenesisState
+	cdc.MustUnmarshalJSON(genState[wasmhookstypes.ModuleName], &wasmHooksGenState)
+	require.Len(t, wasmHooksGenState.ContractAcls, 1)
+	require.False(t, wasmHooksGenState.ContractAcls[0].AllowReceive)
+	require.True(t, wasmHooksGenState.ContractAcls[0].AllowCallback)
+
+	// instantiate contract
+	appGenesis := newTestAppGenesis(t, genState)
+	contractAddr, err := InstantiateGenesisContract(appGenesis, GenesisContract{
+		CodeID:  codeID,
+		Creator: creator,
+		Admin:   creator,
+		Label:   "cw721",
+		Msg:     []byte(fmt.Sprintf(`{"name":"nft","symbol":"NFT","minter":"%s"}`, creator)),
+	})
+	require.NoError(t, err)
+	require.Equal(t, wasmkeeper.BuildContractAddressClassic(codeID, 1).String(), contractAddr)
+
+	// the genesis is loaded with the seeded states
+	err = DryInitChain(appGenesis, func(app *MinitiaApp, ctx sdk.Context) error {
+		require.True(t, app.WasmKeeper.HasContractInfo(ctx, sdk.MustAccAddressFromBech32(contractAddr)))
+		require.True(t, app.TokenFactoryKeeper.GetBeforeSendHook(ctx, denom) == receiver)
+		require.Equal(t, sdkmath.NewInt(1_000_000), app.BankKeeper.GetBalance(ctx, sdk.MustAccAddressFromBech32(receiver), denom).Amount)
+
+		metadata, found := app.BankKeeper.GetDenomMetaData(ctx, denom)
+		require.True(t, found)
+		require.Equal(t, "token", metadata.Display)
+		require.Equal(t, []*banktypes.DenomUnit{{Denom: denom}, {Denom: "token", Exponent: 6}}, metadata.DenomUnits)
+
+		authorityMetadata, err := app.TokenFactoryKeeper.GetAuthorityMetadata(ctx, denom)
+		require.NoError(t, err)
+		require.Equal(t, tokenfactorytypes.DenomAuthorityMetadata{Admin: creator}, authorityMetadata)
+		return nil
+	})
+	require.NoError(t, err)
+
+	// the next contract gets the next classic address
+	contractAddr, err = InstantiateGenesisContract(appGenesis, GenesisContract{
+		CodeID:  codeID,
+		Creator: creator,
+		Label:   "cw721-2",
+		Msg:     []byte(fmt.Sprintf(`{"name":"nft","symbol":"NFT","minter":"%s"}`, creator)),
+		Salt:    []byte("salt"),
+	})
+	require.NoError(t, err)
+	require.NotEqual(t, wasmkeeper.BuildContractAddressClassic(codeID, 2).String(), contractAddr)
+	require.NoError(t, DryInitChain(appGenesis, nil))
+}
+
+func TestDryInitChain_InvalidGenesis(t *testing.T) {
+	encodingConfig := MakeEncodingConfig()
+	cdc := encodingConfig.Codec
+	genState := NewDefaultGenesisState(cdc, BasicManager(), types.BaseDenom)
+
+	creator := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	wasmCode, err := os.ReadFile("../contrib/wasm/cw721_base.wasm")
+	require.NoError(t, err)
+
+	_, err = genState.AddWasmCode(cdc, creator, wasmCode, wasmtypes.AllowEverybody, false)
+	require.NoError(t, err)
+
+	// break the code hash
+	var wasmGenState wasmtypes.GenesisState
+	cdc.MustUnmarshalJSON(genState[wasmtypes.ModuleName], &wasmGenState)
+	wasmGenState.Codes[0].CodeInfo.CodeHash[0]++
+	genState[wasmtypes.ModuleName] = cdc.MustMarshalJSON(&wasmGenState)
+
+	require.Error(t, DryInitChain(newTestAppGenesis(t, genState), nil))
+}
+
+func TestInstantiateGenesisContract_IBCPort(t *testing.T) {
+	encodingConfig := MakeEncodingConfig()
+	cdc := encodingConfig.Codec
+	genState := NewDefaultGenesisState(cdc, BasicManager(), types.BaseDenom)
+
+	creator := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+
+	cw721Code, err := os.ReadFile("../contrib/wasm/cw721_base.wasm")
+	require.NoError(t, err)
+	cw721CodeID, err := genState.AddWasmCode(cdc, creator, cw721Code, wasmtypes.AllowEverybody, false)
+	require.NoError(t, err)
+
+	ics721Code, err := os.ReadFile("../contrib/wasm/ics721_base.wasm")
+	require.NoError(t, err)
+	ics721CodeID, err := genState.AddWasmCode(cdc, creator, ics721Code, wasmtypes.AllowEverybody, false)
+	require.NoError(t, err)
+
+	// the ics721 contract binds its ibc port at the instantiation
+	appGenesis := newTestAppGenesis(t, genState)
+	contractAddr, err := InstantiateGenesisContract(appGenesis, GenesisContract{
+		CodeID:  ics721CodeID,
+		Creator: creator,
+		Label:   "ics721",
+		Msg:     []byte(fmt.Sprintf(`{"cw721_base_code_id":%d}`, cw721CodeID)),
+	})
+	require.NoError(t, err)
+
+	// the port is bound to the contract after the genesis is loaded
+	err = DryInitChain(appGenesis, func(app *MinitiaApp, ctx sdk.Context) error {
+		contractInfo := app.WasmKeeper.GetContractInfo(ctx, sdk.MustAccAddressFromBech32(contractAddr))
+		require.NotNil(t, contractInfo)
+		require.Equal(t, wasmtypes.ModuleName+"."+contractAddr, contractInfo.IBCPortID)
+
+		moduleName, _, err := app.IBCKeeper.PortKeeper.LookupModuleByPort(ctx, contractInfo.IBCPortID)
+		require.NoError(t, err)
+		require.Equal(t, wasmtypes.ModuleName, moduleName)
+		return nil
+	})
+	require.NoError(t, err)
+}
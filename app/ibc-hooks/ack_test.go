@@ -12,6 +12,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibchookstypes "github.com/initia-labs/initia/x/ibc-hooks/types"
 	nfttransfertypes "github.com/initia-labs/initia/x/ibc/nft-transfer/types"
 
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
@@ -125,6 +126,41 @@ func Test_OnAckPacket_memo(t *testing.T) {
 	queryRes, err = input.WasmKeeper.QuerySmart(ctx, contractAddr, []byte(`{"get":{}}`))
 	require.NoError(t, err)
 	require.Equal(t, "100", string(queryRes))
+
+	// the message is addressed to the counterparty chain, so its unknown fields
+	// don't prevent the callback
+	data.Memo = fmt.Sprintf(`{
+		"wasm": {
+			"message": {"contract": "%s", "msg": {}, "funds": []},
+			"async_callback": "%s"
+		}
+	}`, contractAddrBech32, contractAddrBech32)
+	dataBz, err = json.Marshal(&data)
+	require.NoError(t, err)
+
+	err = input.IBCHooksMiddleware.OnAcknowledgementPacket(ctx, channeltypes.Packet{
+		Data:     dataBz,
+		Sequence: 1,
+	}, successAckBz, addr)
+	require.NoError(t, err)
+
+	queryRes, err = input.WasmKeeper.QuerySmart(ctx, contractAddr, []byte(`{"get":{}}`))
+	require.NoError(t, err)
+	require.Equal(t, "101", string(queryRes))
+
+	// an invalid callback emits the hook failed event
+	data.Memo = `{"wasm": {"async_callback": 1}}`
+	dataBz, err = json.Marshal(&data)
+	require.NoError(t, err)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	err = input.IBCHooksMiddleware.OnAcknowledgementPacket(ctx, channeltypes.Packet{
+		Data:     dataBz,
+		Sequence: 1,
+	}, successAckBz, addr)
+	require.NoError(t, err)
+	require.Len(t, ctx.EventManager().Events(), 1)
+	require.Equal(t, ibchookstypes.EventTypeHookFailed, ctx.EventManager().Events()[0].Type)
 }
 
 func Test_OnAckPacket_ICS721(t *testing.T) {
@@ -0,0 +1,205 @@
+package wasm_hooks_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	ibchooks "github.com/initia-labs/miniwasm/app/ibc-hooks"
+	wasmhookstypes "github.com/initia-labs/miniwasm/x/wasmhooks/types"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func Test_onReceivePacket_ContractACL(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	_, _, addr := keyPubAddr()
+	_, _, addr2 := keyPubAddr()
+
+	code, err := os.ReadFile("./contracts/artifacts/counter-aarch64.wasm")
+	require.NoError(t, err)
+
+	wasmMsgServer := wasmkeeper.NewMsgServerImpl(&input.WasmKeeper)
+	storeRes, err := wasmMsgServer.StoreCode(ctx, &wasmtypes.MsgStoreCode{
+		Sender:       addr.String(),
+		WASMByteCode: code,
+	})
+	require.NoError(t, err)
+
+	instantiateRes, err := wasmMsgServer.InstantiateContract(ctx, &wasmtypes.MsgInstantiateContract{
+		Sender: addr.String(),
+		Admin:  addr.String(),
+		CodeID: storeRes.CodeID,
+		Label:  "Counter",
+		Msg:    []byte("{}"),
+		Funds:  nil,
+	})
+	require.NoError(t, err)
+
+	contractAddrBech32 := instantiateRes.Address
+	contractAddr, err := sdk.AccAddressFromBech32(contractAddrBech32)
+	require.NoError(t, err)
+
+	// allowed by ibchooks acl, but the contract acl takes precedence
+	require.NoError(t, input.IBCHooksKeeper.SetAllowed(ctx, contractAddr, true))
+	require.NoError(t, input.WasmHooksKeeper.SetContractACL(ctx, wasmhookstypes.NewContractACL(
+		contractAddrBech32, true, false, []string{"channel-0"}, []string{addr.String()},
+	)))
+
+	recvPacket := func(sender sdk.AccAddress, channel string) bool {
+		data := transfertypes.FungibleTokenPacketData{
+			Denom:    "foo",
+			Amount:   "10000",
+			Sender:   sender.String(),
+			Receiver: contractAddrBech32,
+			Memo: fmt.Sprintf(`{
+				"wasm": {
+					"message": {
+						"contract": "%s",
+						"msg": {"increase":{}}
+					}
+				}
+			}`, contractAddrBech32),
+		}
+
+		dataBz, err := json.Marshal(&data)
+		require.NoError(t, err)
+
+		packet := channeltypes.Packet{
+			Data:               dataBz,
+			DestinationPort:    "wasm",
+			DestinationChannel: channel,
+		}
+
+		// funds foo coins to the intermediate sender
+		intermediateSender, err := sdk.AccAddressFromBech32(ibchooks.DeriveIntermediateSender(channel, data.GetSender()))
+		require.NoError(t, err)
+		denom := ibchooks.MustExtractDenomFromPacketOnRecv(packet)
+		input.Faucet.Fund(ctx, intermediateSender, sdk.NewCoin(denom, math.NewInt(10000)))
+
+		return input.IBCHooksMiddleware.OnRecvPacket(ctx, packet, addr).Success()
+	}
+
+	// not allowed channel
+	require.False(t, recvPacket(addr, "channel-1"))
+
+	// not allowed sender
+	require.False(t, recvPacket(addr2, "channel-0"))
+
+	// success
+	require.True(t, recvPacket(addr, "channel-0"))
+
+	queryRes, err := input.WasmKeeper.QuerySmart(ctx, contractAddr, []byte(`{"get":{}}`))
+	require.NoError(t, err)
+	require.Equal(t, "1", string(queryRes))
+
+	// open mode allows every channel and sender
+	params, err := input.WasmHooksKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	params.AclMode = wasmhookstypes.AclModeOpen
+	require.NoError(t, input.WasmHooksKeeper.SetParams(ctx, params))
+
+	require.True(t, recvPacket(addr2, "channel-1"))
+
+	queryRes, err = input.WasmKeeper.QuerySmart(ctx, contractAddr, []byte(`{"get":{}}`))
+	require.NoError(t, err)
+	require.Equal(t, "2", string(queryRes))
+}
+
+func Test_OnAckPacket_ContractACL(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	_, _, addr := keyPubAddr()
+
+	code, err := os.ReadFile("./contracts/artifacts/counter-aarch64.wasm")
+	require.NoError(t, err)
+
+	wasmMsgServer := wasmkeeper.NewMsgServerImpl(&input.WasmKeeper)
+	storeRes, err := wasmMsgServer.StoreCode(ctx, &wasmtypes.MsgStoreCode{
+		Sender:       addr.String(),
+		WASMByteCode: code,
+	})
+	require.NoError(t, err)
+
+	instantiateRes, err := wasmMsgServer.InstantiateContract(ctx, &wasmtypes.MsgInstantiateContract{
+		Sender: addr.String(),
+		Admin:  addr.String(),
+		CodeID: storeRes.CodeID,
+		Label:  "Counter",
+		Msg:    []byte("{}"),
+		Funds:  nil,
+	})
+	require.NoError(t, err)
+
+	contractAddrBech32 := instantiateRes.Address
+	contractAddr, err := sdk.AccAddressFromBech32(contractAddrBech32)
+	require.NoError(t, err)
+
+	// receive only; callbacks are not allowed
+	require.NoError(t, input.WasmHooksKeeper.SetContractACL(ctx, wasmhookstypes.NewContractACL(
+		contractAddrBech32, true, false, nil, nil,
+	)))
+
+	data := transfertypes.FungibleTokenPacketData{
+		Denom:    "foo",
+		Amount:   "10000",
+		Sender:   addr.String(),
+		Receiver: contractAddrBech32,
+		Memo: fmt.Sprintf(`{
+			"wasm": {
+				"async_callback": "%s"
+			}
+		}`, contractAddrBech32),
+	}
+
+	dataBz, err := json.Marshal(&data)
+	require.NoError(t, err)
+
+	successAckBz := channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement()
+
+	err = input.IBCHooksMiddleware.OnAcknowledgementPacket(ctx, channeltypes.Packet{
+		Data:          dataBz,
+		SourceChannel: "channel-0",
+		Sequence:      99,
+	}, successAckBz, addr)
+	require.NoError(t, err)
+
+	queryRes, err := input.WasmKeeper.QuerySmart(ctx, contractAddr, []byte(`{"get":{}}`))
+	require.NoError(t, err)
+	require.Equal(t, "0", string(queryRes))
+
+	// allow callbacks on channel-0
+	require.NoError(t, input.WasmHooksKeeper.SetContractACL(ctx, wasmhookstypes.NewContractACL(
+		contractAddrBech32, false, true, []string{"channel-0"}, nil,
+	)))
+
+	err = input.IBCHooksMiddleware.OnAcknowledgementPacket(ctx, channeltypes.Packet{
+		Data:          dataBz,
+		SourceChannel: "channel-1",
+		Sequence:      99,
+	}, successAckBz, addr)
+	require.NoError(t, err)
+
+	queryRes, err = input.WasmKeeper.QuerySmart(ctx, contractAddr, []byte(`{"get":{}}`))
+	require.NoError(t, err)
+	require.Equal(t, "0", string(queryRes))
+
+	err = input.IBCHooksMiddleware.OnAcknowledgementPacket(ctx, channeltypes.Packet{
+		Data:          dataBz,
+		SourceChannel: "channel-0",
+		Sequence:      99,
+	}, successAckBz, addr)
+	require.NoError(t, err)
+
+	queryRes, err = input.WasmKeeper.QuerySmart(ctx, contractAddr, []byte(`{"get":{}}`))
+	require.NoError(t, err)
+	require.Equal(t, "99", string(queryRes))
+}
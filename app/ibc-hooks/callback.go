@@ -0,0 +1,50 @@
+package wasm_hooks
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	wasmhookstypes "github.com/initia-labs/miniwasm/x/wasmhooks/types"
+)
+
+// execCallback delivers the sudo message to the async callback contract under the
+// callback gas limit. A failed callback is persisted to the retry queue, so anyone
+// can retry it later with MsgRetryHookCallback.
+func (h WasmHooks) execCallback(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	callbackType wasmhookstypes.CallbackType,
+	contractAddr sdk.AccAddress,
+	sudoMsg []byte,
+) {
+	params, err := h.hooksKeeper.GetParams(ctx)
+	if err != nil {
+		h.wasmKeeper.Logger(ctx).Error("failed to load params", "error", err)
+		return
+	}
+
+	execErr := h.hooksKeeper.ExecuteCallback(ctx, contractAddr, sudoMsg, params.CallbackGasLimit)
+	if execErr == nil {
+		return
+	}
+
+	h.wasmKeeper.Logger(ctx).Error("failed to execute callback", "error", execErr)
+	emitHookFailedEvent(ctx, "failed to execute callback", execErr)
+
+	contract, err := h.ac.BytesToString(contractAddr)
+	if err != nil {
+		h.wasmKeeper.Logger(ctx).Error("invalid contract address", "error", err)
+		return
+	}
+
+	if _, err := h.hooksKeeper.EnqueueFailedCallback(ctx, wasmhookstypes.FailedCallback{
+		Contract:      contract,
+		CallbackType:  callbackType,
+		SourceChannel: packet.SourceChannel,
+		Sequence:      packet.Sequence,
+		Msg:           sudoMsg,
+		Error:         execErr.Error(),
+	}); err != nil {
+		h.wasmKeeper.Logger(ctx).Error("failed to enqueue failed callback", "error", err)
+	}
+}
@@ -0,0 +1,193 @@
+package wasm_hooks_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+
+	wasmhookskeeper "github.com/initia-labs/miniwasm/x/wasmhooks/keeper"
+	wasmhookstypes "github.com/initia-labs/miniwasm/x/wasmhooks/types"
+)
+
+func Test_OnAckPacket_CallbackOutOfGas(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	_, _, addr := keyPubAddr()
+
+	code, err := os.ReadFile("./contracts/artifacts/counter-aarch64.wasm")
+	require.NoError(t, err)
+
+	wasmMsgServer := wasmkeeper.NewMsgServerImpl(&input.WasmKeeper)
+	storeRes, err := wasmMsgServer.StoreCode(ctx, &wasmtypes.MsgStoreCode{
+		Sender:       addr.String(),
+		WASMByteCode: code,
+	})
+	require.NoError(t, err)
+
+	instantiateRes, err := wasmMsgServer.InstantiateContract(ctx, &wasmtypes.MsgInstantiateContract{
+		Sender: addr.String(),
+		Admin:  addr.String(),
+		CodeID: storeRes.CodeID,
+		Label:  "Counter",
+		Msg:    []byte("{}"),
+		Funds:  nil,
+	})
+	require.NoError(t, err)
+
+	contractAddrBech32 := instantiateRes.Address
+	contractAddr, err := sdk.AccAddressFromBech32(contractAddrBech32)
+	require.NoError(t, err)
+
+	// set acl
+	require.NoError(t, input.IBCHooksKeeper.SetAllowed(ctx, contractAddr, true))
+
+	// set callback gas limit too low to execute the callback
+	params, err := input.WasmHooksKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	params.CallbackGasLimit = 1_000
+	require.NoError(t, input.WasmHooksKeeper.SetParams(ctx, params))
+
+	data := transfertypes.FungibleTokenPacketData{
+		Denom:    "foo",
+		Amount:   "10000",
+		Sender:   addr.String(),
+		Receiver: contractAddrBech32,
+		Memo: fmt.Sprintf(`{
+			"wasm": {
+				"async_callback": "%s"
+			}
+		}`, contractAddrBech32),
+	}
+
+	dataBz, err := json.Marshal(&data)
+	require.NoError(t, err)
+
+	successAckBz := channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement()
+
+	// the ack should not fail even if the callback is out of gas
+	gasBefore := ctx.GasMeter().GasConsumed()
+	err = input.IBCHooksMiddleware.OnAcknowledgementPacket(ctx, channeltypes.Packet{
+		Data:          dataBz,
+		SourceChannel: "channel-0",
+		Sequence:      99,
+	}, successAckBz, addr)
+	require.NoError(t, err)
+	require.Less(t, ctx.GasMeter().GasConsumed()-gasBefore, uint64(100_000))
+
+	// the contract state should not be changed
+	queryRes, err := input.WasmKeeper.QuerySmart(ctx, contractAddr, []byte(`{"get":{}}`))
+	require.NoError(t, err)
+	require.Equal(t, "0", string(queryRes))
+
+	// the failed callback should be queued
+	callback, err := input.WasmHooksKeeper.GetFailedCallback(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, contractAddrBech32, callback.Contract)
+	require.Equal(t, wasmhookstypes.CallbackTypeAck, callback.CallbackType)
+	require.Equal(t, "channel-0", callback.SourceChannel)
+	require.Equal(t, uint64(99), callback.Sequence)
+	require.Contains(t, callback.Error, wasmhookstypes.ErrCallbackOutOfGas.Error())
+
+	// retry the callback with the gas of the sender
+	msgServer := wasmhookskeeper.NewMsgServerImpl(input.WasmHooksKeeper)
+	_, err = msgServer.RetryHookCallback(ctx, wasmhookstypes.NewMsgRetryHookCallback(addr.String(), 1))
+	require.NoError(t, err)
+
+	// check the contract state
+	queryRes, err = input.WasmKeeper.QuerySmart(ctx, contractAddr, []byte(`{"get":{}}`))
+	require.NoError(t, err)
+	require.Equal(t, "99", string(queryRes))
+
+	// the callback should be removed from the queue
+	_, err = input.WasmHooksKeeper.GetFailedCallback(ctx, 1)
+	require.ErrorIs(t, err, wasmhookstypes.ErrFailedCallbackNotFound)
+}
+
+func Test_OnTimeoutPacket_CallbackRetryNotAllowed(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	_, _, addr := keyPubAddr()
+
+	code, err := os.ReadFile("./contracts/artifacts/counter-aarch64.wasm")
+	require.NoError(t, err)
+
+	wasmMsgServer := wasmkeeper.NewMsgServerImpl(&input.WasmKeeper)
+	storeRes, err := wasmMsgServer.StoreCode(ctx, &wasmtypes.MsgStoreCode{
+		Sender:       addr.String(),
+		WASMByteCode: code,
+	})
+	require.NoError(t, err)
+
+	instantiateRes, err := wasmMsgServer.InstantiateContract(ctx, &wasmtypes.MsgInstantiateContract{
+		Sender: addr.String(),
+		Admin:  addr.String(),
+		CodeID: storeRes.CodeID,
+		Label:  "Counter",
+		Msg:    []byte("{}"),
+		Funds:  nil,
+	})
+	require.NoError(t, err)
+
+	contractAddrBech32 := instantiateRes.Address
+	contractAddr, err := sdk.AccAddressFromBech32(contractAddrBech32)
+	require.NoError(t, err)
+
+	// set acl
+	require.NoError(t, input.IBCHooksKeeper.SetAllowed(ctx, contractAddr, true))
+
+	// set callback gas limit too low to execute the callback
+	params, err := input.WasmHooksKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	params.CallbackGasLimit = 1_000
+	require.NoError(t, input.WasmHooksKeeper.SetParams(ctx, params))
+
+	data := transfertypes.FungibleTokenPacketData{
+		Denom:    "foo",
+		Amount:   "10000",
+		Sender:   addr.String(),
+		Receiver: contractAddrBech32,
+		Memo: fmt.Sprintf(`{
+			"wasm": {
+				"async_callback": "%s"
+			}
+		}`, contractAddrBech32),
+	}
+
+	dataBz, err := json.Marshal(&data)
+	require.NoError(t, err)
+
+	err = input.IBCHooksMiddleware.OnTimeoutPacket(ctx, channeltypes.Packet{
+		Data:     dataBz,
+		Sequence: 7,
+	}, addr)
+	require.NoError(t, err)
+
+	callback, err := input.WasmHooksKeeper.GetFailedCallback(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, wasmhookstypes.CallbackTypeTimeout, callback.CallbackType)
+
+	// revoke acl; retry should fail and keep the callback in the queue
+	require.NoError(t, input.IBCHooksKeeper.SetAllowed(ctx, contractAddr, false))
+
+	msgServer := wasmhookskeeper.NewMsgServerImpl(input.WasmHooksKeeper)
+	_, err = msgServer.RetryHookCallback(ctx, wasmhookstypes.NewMsgRetryHookCallback(addr.String(), 1))
+	require.ErrorIs(t, err, wasmhookstypes.ErrCallbackNotAllowed)
+
+	_, err = input.WasmHooksKeeper.GetFailedCallback(ctx, 1)
+	require.NoError(t, err)
+
+	// pruned after expiry
+	ctx = ctx.WithBlockTime(callback.ExpireTime)
+	require.NoError(t, input.WasmHooksKeeper.PruneExpiredFailedCallbacks(ctx))
+
+	_, err = input.WasmHooksKeeper.GetFailedCallback(ctx, 1)
+	require.ErrorIs(t, err, wasmhookstypes.ErrFailedCallbackNotFound)
+}
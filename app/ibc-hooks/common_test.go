@@ -55,6 +55,8 @@ import (
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	wasmhooks "github.com/initia-labs/miniwasm/app/ibc-hooks"
+	wasmhookskeeper "github.com/initia-labs/miniwasm/x/wasmhooks/keeper"
+	wasmhookstypes "github.com/initia-labs/miniwasm/x/wasmhooks/types"
 )
 
 var ModuleBasics = module.NewBasicManager(
@@ -177,10 +179,14 @@ type TestKeepers struct {
 	IBCHooksKeeper     *ibchookskeeper.Keeper
 	IBCHooksMiddleware ibchooks.IBCMiddleware
 	WasmKeeper         wasmkeeper.Keeper
+	WasmHooksKeeper    *wasmhookskeeper.Keeper
 
 	EncodingConfig EncodingConfig
 	Faucet         *TestFaucet
 	MultiStore     storetypes.CommitMultiStore
+
+	// DeliveredPackets are the packets delivered to the app under the middleware.
+	DeliveredPackets *[]channeltypes.Packet
 }
 
 // createDefaultTestInput common settings for createTestInput
@@ -218,6 +224,7 @@ func _createTestInput(
 	keys := storetypes.NewKVStoreKeys(
 		authtypes.StoreKey, banktypes.StoreKey, stakingtypes.StoreKey,
 		distributiontypes.StoreKey, wasmtypes.StoreKey, ibchookstypes.StoreKey,
+		wasmhookstypes.StoreKey,
 	)
 	ms := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
 	for _, v := range keys {
@@ -303,34 +310,49 @@ func _createTestInput(
 	wasmParams := wasmtypes.DefaultParams()
 	require.NoError(t, wasmKeeper.SetParams(ctx, wasmParams))
 
+	wasmHooksKeeper := wasmhookskeeper.NewKeeper(
+		ac,
+		appCodec,
+		runtime.NewKVStoreService(keys[wasmhookstypes.StoreKey]),
+		&wasmKeeper,
+		ibcHooksKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+	wasmHooksKeeper.InitGenesis(ctx, *wasmhookstypes.DefaultGenesis())
+
 	faucet := NewTestFaucet(t, ctx, bankKeeper, authtypes.Minter, initialTotalSupply()...)
 
 	// ibc middleware setup
 
-	mockIBCMiddleware := mockIBCMiddleware{}
-	wasmHooks := wasmhooks.NewWasmHooks(appCodec, ac, &wasmKeeper)
+	mockIBCMiddleware := mockIBCMiddleware{packets: &[]channeltypes.Packet{}}
+	wasmHooks := wasmhooks.NewWasmHooks(appCodec, ac, &wasmKeeper, wasmHooksKeeper)
 
 	middleware := ibchooks.NewICS4Middleware(mockIBCMiddleware, wasmHooks)
 	ibcHookMiddleware := ibchooks.NewIBCMiddleware(mockIBCMiddleware, middleware, ibcHooksKeeper)
+	wasmHooksKeeper.SetPacketSimulator(wasmhooks.NewPacketSimulator(wasmHooks, ibcHookMiddleware, ibcHookMiddleware))
 
 	keepers := TestKeepers{
 		AccountKeeper:      accountKeeper,
 		IBCHooksKeeper:     ibcHooksKeeper,
 		IBCHooksMiddleware: ibcHookMiddleware,
 		WasmKeeper:         wasmKeeper,
+		WasmHooksKeeper:    wasmHooksKeeper,
 		BankKeeper:         bankKeeper,
 		EncodingConfig:     encodingConfig,
 		Faucet:             faucet,
 		MultiStore:         ms,
+		DeliveredPackets:   mockIBCMiddleware.packets,
 	}
 	return ctx, keepers
 }
 
-// do nothing ibc middleware
+// do nothing ibc middleware, which records the delivered packets
 var _ porttypes.IBCModule = mockIBCMiddleware{}
 var _ porttypes.ICS4Wrapper = mockIBCMiddleware{}
 
-type mockIBCMiddleware struct{}
+type mockIBCMiddleware struct {
+	packets *[]channeltypes.Packet
+}
 
 // GetAppVersion implements types.ICS4Wrapper.
 func (m mockIBCMiddleware) GetAppVersion(ctx sdk.Context, portID string, channelID string) (string, bool) {
@@ -349,6 +371,7 @@ func (m mockIBCMiddleware) WriteAcknowledgement(ctx sdk.Context, chanCap *capabi
 
 // OnAcknowledgementPacket implements types.IBCModule.
 func (m mockIBCMiddleware) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte, relayer sdk.AccAddress) error {
+	*m.packets = append(*m.packets, packet)
 	return nil
 }
 
@@ -384,10 +407,12 @@ func (m mockIBCMiddleware) OnChanOpenTry(ctx sdk.Context, order channeltypes.Ord
 
 // OnRecvPacket implements types.IBCModule.
 func (m mockIBCMiddleware) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) ibcexported.Acknowledgement {
+	*m.packets = append(*m.packets, packet)
 	return channeltypes.NewResultAcknowledgement([]byte{byte(1)})
 }
 
 // OnTimeoutPacket implements types.IBCModule.
 func (m mockIBCMiddleware) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) error {
+	*m.packets = append(*m.packets, packet)
 	return nil
 }
@@ -9,6 +9,8 @@ import (
 
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 	ibchooks "github.com/initia-labs/initia/x/ibc-hooks"
+
+	wasmhookskeeper "github.com/initia-labs/miniwasm/x/wasmhooks/keeper"
 )
 
 var (
@@ -18,20 +20,29 @@ var (
 )
 
 type WasmHooks struct {
-	codec      codec.Codec
-	ac         address.Codec
-	wasmKeeper *wasmkeeper.Keeper
+	codec       codec.Codec
+	ac          address.Codec
+	wasmKeeper  *wasmkeeper.Keeper
+	hooksKeeper *wasmhookskeeper.Keeper
 }
 
-func NewWasmHooks(codec codec.Codec, ac address.Codec, wasmKeeper *wasmkeeper.Keeper) *WasmHooks {
+func NewWasmHooks(codec codec.Codec, ac address.Codec, wasmKeeper *wasmkeeper.Keeper, hooksKeeper *wasmhookskeeper.Keeper) *WasmHooks {
 	return &WasmHooks{
-		codec:      codec,
-		ac:         ac,
-		wasmKeeper: wasmKeeper,
+		codec:       codec,
+		ac:          ac,
+		wasmKeeper:  wasmKeeper,
+		hooksKeeper: hooksKeeper,
 	}
 }
 
 func (h WasmHooks) OnRecvPacketOverride(im ibchooks.IBCMiddleware, ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) ibcexported.Acknowledgement {
+	ack, _ := h.onRecvPacket(ctx, im, packet, relayer)
+	return ack
+}
+
+// onRecvPacket returns the acknowledgement and the response data of the hook
+// contract execution, if any.
+func (h WasmHooks) onRecvPacket(ctx sdk.Context, im ibchooks.IBCMiddleware, packet channeltypes.Packet, relayer sdk.AccAddress) (ibcexported.Acknowledgement, []byte) {
 	if isIcs20, ics20Data := isIcs20Packet(packet.GetData()); isIcs20 {
 		return h.onRecvIcs20Packet(ctx, im, packet, relayer, ics20Data)
 	}
@@ -40,7 +51,11 @@ func (h WasmHooks) OnRecvPacketOverride(im ibchooks.IBCMiddleware, ctx sdk.Conte
 		return h.onRecvIcs721Packet(ctx, im, packet, relayer, ics721Data)
 	}
 
-	return im.App.OnRecvPacket(ctx, packet, relayer)
+	if isWasmPort(packet.GetDestPort()) {
+		return h.onRecvWasmPacket(ctx, im, packet, relayer), nil
+	}
+
+	return im.App.OnRecvPacket(ctx, packet, relayer), nil
 }
 
 func (h WasmHooks) OnAcknowledgementPacketOverride(im ibchooks.IBCMiddleware, ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte, relayer sdk.AccAddress) error {
@@ -52,6 +67,10 @@ func (h WasmHooks) OnAcknowledgementPacketOverride(im ibchooks.IBCMiddleware, ct
 		return h.onAckIcs721Packet(ctx, im, packet, acknowledgement, relayer, ics721Data)
 	}
 
+	if isWasmPort(packet.GetSourcePort()) {
+		return h.onAckWasmPacket(ctx, im, packet, acknowledgement, relayer)
+	}
+
 	return im.App.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
 }
 
@@ -64,14 +83,29 @@ func (h WasmHooks) OnTimeoutPacketOverride(im ibchooks.IBCMiddleware, ctx sdk.Co
 		return h.onTimeoutIcs721Packet(ctx, im, packet, relayer, ics721Data)
 	}
 
+	if isWasmPort(packet.GetSourcePort()) {
+		return h.onTimeoutWasmPacket(ctx, im, packet, relayer)
+	}
+
 	return im.App.OnTimeoutPacket(ctx, packet, relayer)
 }
 
-func (h WasmHooks) checkACL(im ibchooks.IBCMiddleware, ctx sdk.Context, addrStr string) (bool, error) {
+// checkReceiveACL checks whether the contract can be executed by the received packet.
+func (h WasmHooks) checkReceiveACL(ctx sdk.Context, packet channeltypes.Packet, addrStr, sender string) (bool, error) {
+	addr, err := h.ac.StringToBytes(addrStr)
+	if err != nil {
+		return false, err
+	}
+
+	return h.hooksKeeper.IsReceiveAllowed(ctx, addr, packet.GetDestChannel(), sender)
+}
+
+// checkCallbackACL checks whether the contract can receive the async callback of the sent packet.
+func (h WasmHooks) checkCallbackACL(ctx sdk.Context, packet channeltypes.Packet, addrStr string) (bool, error) {
 	addr, err := h.ac.StringToBytes(addrStr)
 	if err != nil {
 		return false, err
 	}
 
-	return im.HooksKeeper.GetAllowed(ctx, addr)
+	return h.hooksKeeper.IsCallbackAllowed(ctx, addr, packet.GetSourceChannel())
 }
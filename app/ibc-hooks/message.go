@@ -1,12 +1,21 @@
 package wasm_hooks
 
 import (
+	"encoding/json"
+
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 )
 
 const (
 	// The memo key is used to parse ics-20 or ics-712 memo fields.
 	wasmHookMemoKey = "wasm"
+
+	// The packet key is used to parse the hook data of json packets of wasm ports.
+	wasmHookPacketKey = "wasm_hook"
+
+	// The callback key is used to parse the async callback of the memo on the ack
+	// and the timeout.
+	wasmHookCallbackKey = "async_callback"
 )
 
 // HookData defines a wrapper for wasm execute message
@@ -19,3 +28,25 @@ type HookData struct {
 	// AsyncCallback is a contract address
 	AsyncCallback string `json:"async_callback,omitempty"`
 }
+
+// hookMemo defines the strict json schema of the `wasm` memo field,
+// which is converted to HookData after validation.
+type hookMemo struct {
+	Message       *hookMessage `json:"message,omitempty"`
+	AsyncCallback string       `json:"async_callback,omitempty"`
+}
+
+// hookMessage defines the strict json schema of the execute message.
+// The sender and funds are derived from the packet.
+type hookMessage struct {
+	Contract string          `json:"contract"`
+	Msg      json.RawMessage `json:"msg"`
+}
+
+// PacketHookData defines the hook data of the packets of wasm ports,
+// which is given by the `wasm_hook` field of a json packet or by
+// the HookPacketData protobuf envelope.
+type PacketHookData struct {
+	// AsyncCallback is a contract address
+	AsyncCallback string `json:"async_callback,omitempty"`
+}
@@ -16,6 +16,9 @@ import (
 
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+
+	sendrestrictiontypes "github.com/initia-labs/miniwasm/x/sendrestriction/types"
+	wasmhookstypes "github.com/initia-labs/miniwasm/x/wasmhooks/types"
 )
 
 func (h WasmHooks) onRecvIcs20Packet(
@@ -24,24 +27,26 @@ func (h WasmHooks) onRecvIcs20Packet(
 	packet channeltypes.Packet,
 	relayer sdk.AccAddress,
 	data transfertypes.FungibleTokenPacketData,
-) ibcexported.Acknowledgement {
+) (ibcexported.Acknowledgement, []byte) {
 	isWasmRouted, hookData, err := validateAndParseMemo(data.GetMemo())
-	if !isWasmRouted || hookData.Message == nil {
-		return im.App.OnRecvPacket(ctx, packet, relayer)
+	if !isWasmRouted {
+		return im.App.OnRecvPacket(ctx, packet, relayer), nil
 	} else if err != nil {
-		return newEmitErrorAcknowledgement(err)
+		return newEmitErrorAcknowledgement(ctx, err), nil
+	} else if hookData.Message == nil {
+		return im.App.OnRecvPacket(ctx, packet, relayer), nil
 	}
 
 	msg := hookData.Message
-	if allowed, err := h.checkACL(im, ctx, msg.Contract); err != nil {
-		return newEmitErrorAcknowledgement(err)
+	if allowed, err := h.checkReceiveACL(ctx, packet, msg.Contract, data.GetSender()); err != nil {
+		return newEmitErrorAcknowledgement(ctx, err), nil
 	} else if !allowed {
-		return newEmitErrorAcknowledgement(fmt.Errorf("contract `%s` is not allowed to be used in ibchooks", msg.Contract))
+		return newEmitErrorAcknowledgement(ctx, wasmhookstypes.ErrHookNotAllowed.Wrapf("contract: %s", msg.Contract)), nil
 	}
 
 	// Validate whether the receiver is correctly specified or not.
 	if err := validateReceiver(msg, data.Receiver); err != nil {
-		return newEmitErrorAcknowledgement(err)
+		return newEmitErrorAcknowledgement(ctx, err), nil
 	}
 
 	// Calculate the receiver / contract caller based on the packet's channel and sender
@@ -56,30 +61,34 @@ func (h WasmHooks) onRecvIcs20Packet(
 	data.Receiver = intermediateSender
 	bz, err := json.Marshal(data)
 	if err != nil {
-		return newEmitErrorAcknowledgement(err)
+		return newEmitErrorAcknowledgement(ctx, err), nil
 	}
 	packet.Data = bz
 
+	// The intermediary account only passes the funds to the contract, so it is exempt
+	// from the send restrictions; the contract is still restricted.
+	ctx = sendrestrictiontypes.WithExemptAddress(ctx, sdk.MustAccAddressFromBech32(intermediateSender))
+
 	ack := im.App.OnRecvPacket(ctx, packet, relayer)
 	if !ack.Success() {
-		return ack
+		return ack, nil
 	}
 
 	// Extract the denom and amount from the packet data
 	denom := MustExtractDenomFromPacketOnRecv(packet)
 	amount, ok := math.NewIntFromString(data.GetAmount())
 	if !ok {
-		return newEmitErrorAcknowledgement(fmt.Errorf("invalid amount: %s", data.GetAmount()))
+		return newEmitErrorAcknowledgement(ctx, fmt.Errorf("invalid amount: %s", data.GetAmount())), nil
 	}
 
 	msg.Sender = intermediateSender
 	msg.Funds = sdk.NewCoins(sdk.NewCoin(denom, amount))
-	_, err = h.execMsg(ctx, msg)
+	res, err := h.execMsg(ctx, msg)
 	if err != nil {
-		return newEmitErrorAcknowledgement(err)
+		return newEmitErrorAcknowledgement(ctx, newExecutionError(err)), nil
 	}
 
-	return ack
+	return ack, res.Data
 }
 
 func (h WasmHooks) onRecvIcs721Packet(
@@ -88,24 +97,26 @@ func (h WasmHooks) onRecvIcs721Packet(
 	packet channeltypes.Packet,
 	relayer sdk.AccAddress,
 	data nfttransfertypes.NonFungibleTokenPacketData,
-) ibcexported.Acknowledgement {
+) (ibcexported.Acknowledgement, []byte) {
 	isWasmRouted, hookData, err := validateAndParseMemo(data.GetMemo())
-	if !isWasmRouted || hookData.Message == nil {
-		return im.App.OnRecvPacket(ctx, packet, relayer)
+	if !isWasmRouted {
+		return im.App.OnRecvPacket(ctx, packet, relayer), nil
 	} else if err != nil {
-		return newEmitErrorAcknowledgement(err)
+		return newEmitErrorAcknowledgement(ctx, err), nil
+	} else if hookData.Message == nil {
+		return im.App.OnRecvPacket(ctx, packet, relayer), nil
 	}
 
 	msg := hookData.Message
-	if allowed, err := h.checkACL(im, ctx, msg.Contract); err != nil {
-		return newEmitErrorAcknowledgement(err)
+	if allowed, err := h.checkReceiveACL(ctx, packet, msg.Contract, data.GetSender()); err != nil {
+		return newEmitErrorAcknowledgement(ctx, err), nil
 	} else if !allowed {
-		return newEmitErrorAcknowledgement(fmt.Errorf("contract `%s` is not allowed to be used in ibchooks", msg.Contract))
+		return newEmitErrorAcknowledgement(ctx, wasmhookstypes.ErrHookNotAllowed.Wrapf("contract: %s", msg.Contract)), nil
 	}
 
 	// Validate whether the receiver is correctly specified or not.
 	if err := validateReceiver(msg, data.Receiver); err != nil {
-		return newEmitErrorAcknowledgement(err)
+		return newEmitErrorAcknowledgement(ctx, err), nil
 	}
 
 	// Calculate the receiver / contract caller based on the packet's channel and sender
@@ -120,23 +131,23 @@ func (h WasmHooks) onRecvIcs721Packet(
 	data.Receiver = intermediateSender
 	bz, err := json.Marshal(data)
 	if err != nil {
-		return newEmitErrorAcknowledgement(err)
+		return newEmitErrorAcknowledgement(ctx, err), nil
 	}
 	packet.Data = bz
 
 	ack := im.App.OnRecvPacket(ctx, packet, relayer)
 	if !ack.Success() {
-		return ack
+		return ack, nil
 	}
 
 	msg.Sender = intermediateSender
 	msg.Funds = sdk.NewCoins()
-	_, err = h.execMsg(ctx, msg)
+	res, err := h.execMsg(ctx, msg)
 	if err != nil {
-		return newEmitErrorAcknowledgement(err)
+		return newEmitErrorAcknowledgement(ctx, newExecutionError(err)), nil
 	}
 
-	return ack
+	return ack, res.Data
 }
 
 func (im WasmHooks) execMsg(ctx sdk.Context, msg *wasmtypes.MsgExecuteContract) (*wasmtypes.MsgExecuteContractResponse, error) {
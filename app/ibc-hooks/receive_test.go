@@ -6,6 +6,7 @@ import (
 	"os"
 	"testing"
 
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	"github.com/stretchr/testify/require"
 
@@ -13,8 +14,10 @@ import (
 	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 
+	ibchookstypes "github.com/initia-labs/initia/x/ibc-hooks/types"
 	nfttransfertypes "github.com/initia-labs/initia/x/ibc/nft-transfer/types"
 	ibchooks "github.com/initia-labs/miniwasm/app/ibc-hooks"
+	wasmhookstypes "github.com/initia-labs/miniwasm/x/wasmhooks/types"
 
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
@@ -220,3 +223,126 @@ func Test_onReceivePacket_memo_ICS721(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, "1", string(queryRes))
 }
+
+func Test_onReceivePacket_ErrorCodes(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	_, _, addr := keyPubAddr()
+	_, _, addr2 := keyPubAddr()
+
+	code, err := os.ReadFile("./contracts/artifacts/counter-aarch64.wasm")
+	require.NoError(t, err)
+
+	wasmMsgServer := wasmkeeper.NewMsgServerImpl(&input.WasmKeeper)
+	storeRes, err := wasmMsgServer.StoreCode(ctx, &wasmtypes.MsgStoreCode{
+		Sender:       addr.String(),
+		WASMByteCode: code,
+	})
+	require.NoError(t, err)
+
+	instantiateRes, err := wasmMsgServer.InstantiateContract(ctx, &wasmtypes.MsgInstantiateContract{
+		Sender: addr.String(),
+		Admin:  addr.String(),
+		CodeID: storeRes.CodeID,
+		Label:  "Counter",
+		Msg:    []byte("{}"),
+		Funds:  nil,
+	})
+	require.NoError(t, err)
+
+	contractAddrBech32 := instantiateRes.Address
+	contractAddr, err := sdk.AccAddressFromBech32(contractAddrBech32)
+	require.NoError(t, err)
+	require.NoError(t, input.IBCHooksKeeper.SetAllowed(ctx, contractAddr, true))
+
+	testCases := []struct {
+		name     string
+		receiver string
+		memo     string
+		err      *errorsmod.Error
+	}{
+		{
+			name:     "unknown field",
+			receiver: contractAddrBech32,
+			memo:     fmt.Sprintf(`{"wasm": {"message": {"sender": "%s", "contract": "%s", "msg": {"increase":{}}}}}`, addr, contractAddrBech32),
+			err:      wasmhookstypes.ErrInvalidMemo,
+		},
+		{
+			name:     "missing contract",
+			receiver: contractAddrBech32,
+			memo:     `{"wasm": {"message": {"msg": {"increase":{}}}}}`,
+			err:      wasmhookstypes.ErrMissingContract,
+		},
+		{
+			name:     "receiver mismatch",
+			receiver: addr2.String(),
+			memo:     fmt.Sprintf(`{"wasm": {"message": {"contract": "%s", "msg": {"increase":{}}}}}`, contractAddrBech32),
+			err:      wasmhookstypes.ErrReceiverMismatch,
+		},
+		{
+			name:     "acl denied",
+			receiver: addr2.String(),
+			memo:     fmt.Sprintf(`{"wasm": {"message": {"contract": "%s", "msg": {"increase":{}}}}}`, addr2),
+			err:      wasmhookstypes.ErrHookNotAllowed,
+		},
+		{
+			name:     "execution failed",
+			receiver: contractAddrBech32,
+			memo:     fmt.Sprintf(`{"wasm": {"message": {"contract": "%s", "msg": {"unknown":{}}}}}`, contractAddrBech32),
+			err:      wasmhookstypes.ErrExecutionFailed,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := ctx.WithEventManager(sdk.NewEventManager())
+
+			data := transfertypes.FungibleTokenPacketData{
+				Denom:    "foo",
+				Amount:   "10000",
+				Sender:   addr.String(),
+				Receiver: tc.receiver,
+				Memo:     tc.memo,
+			}
+
+			dataBz, err := json.Marshal(&data)
+			require.NoError(t, err)
+
+			packet := channeltypes.Packet{
+				Data:               dataBz,
+				DestinationPort:    "wasm",
+				DestinationChannel: "channel-0",
+			}
+
+			// funds foo coins to the intermediate sender
+			intermediateSender, err := sdk.AccAddressFromBech32(ibchooks.DeriveIntermediateSender("channel-0", data.GetSender()))
+			require.NoError(t, err)
+			denom := ibchooks.MustExtractDenomFromPacketOnRecv(packet)
+			input.Faucet.Fund(ctx, intermediateSender, sdk.NewCoin(denom, math.NewInt(10000)))
+
+			ack := input.IBCHooksMiddleware.OnRecvPacket(ctx, packet, addr)
+			require.False(t, ack.Success())
+			require.Contains(t, string(ack.Acknowledgement()), fmt.Sprintf("codespace: %s, code: %d", tc.err.Codespace(), tc.err.ABCICode()))
+
+			var found bool
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type != ibchookstypes.EventTypeHookFailed {
+					continue
+				}
+
+				found = true
+				codespace, ok := event.GetAttribute(wasmhookstypes.AttributeKeyCodespace)
+				require.True(t, ok)
+				require.Equal(t, tc.err.Codespace(), codespace.Value)
+				code, ok := event.GetAttribute(wasmhookstypes.AttributeKeyCode)
+				require.True(t, ok)
+				require.Equal(t, fmt.Sprint(tc.err.ABCICode()), code.Value)
+			}
+			require.True(t, found)
+		})
+	}
+
+	// the contract state should not be changed
+	queryRes, err := input.WasmKeeper.QuerySmart(ctx, contractAddr, []byte(`{"get":{}}`))
+	require.NoError(t, err)
+	require.Equal(t, "0", string(queryRes))
+}
@@ -0,0 +1,41 @@
+package wasm_hooks
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+
+	ibchooks "github.com/initia-labs/initia/x/ibc-hooks"
+
+	wasmhookstypes "github.com/initia-labs/miniwasm/x/wasmhooks/types"
+)
+
+var _ wasmhookstypes.PacketSimulator = PacketSimulator{}
+
+// PacketSimulator simulates the receive of packets on the wasm hooks middlewares.
+// The packets of wasm ports are routed to the wasm middleware and the others to
+// the transfer middleware.
+type PacketSimulator struct {
+	hooks              *WasmHooks
+	transferMiddleware ibchooks.IBCMiddleware
+	wasmMiddleware     ibchooks.IBCMiddleware
+}
+
+func NewPacketSimulator(hooks *WasmHooks, transferMiddleware, wasmMiddleware ibchooks.IBCMiddleware) PacketSimulator {
+	return PacketSimulator{
+		hooks:              hooks,
+		transferMiddleware: transferMiddleware,
+		wasmMiddleware:     wasmMiddleware,
+	}
+}
+
+// SimulateRecvPacket implements wasmhookstypes.PacketSimulator. The caller is
+// responsible for running it in a cached context.
+func (s PacketSimulator) SimulateRecvPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) (ibcexported.Acknowledgement, []byte) {
+	im := s.transferMiddleware
+	if isWasmPort(packet.GetDestPort()) {
+		im = s.wasmMiddleware
+	}
+
+	return s.hooks.onRecvPacket(ctx, im, packet, relayer)
+}
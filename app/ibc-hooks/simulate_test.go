@@ -0,0 +1,117 @@
+package wasm_hooks_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+
+	ibchooks "github.com/initia-labs/miniwasm/app/ibc-hooks"
+	wasmhookskeeper "github.com/initia-labs/miniwasm/x/wasmhooks/keeper"
+	wasmhookstypes "github.com/initia-labs/miniwasm/x/wasmhooks/types"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func Test_SimulateIBCHook(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	_, _, addr := keyPubAddr()
+
+	code, err := os.ReadFile("./contracts/artifacts/counter-aarch64.wasm")
+	require.NoError(t, err)
+
+	wasmMsgServer := wasmkeeper.NewMsgServerImpl(&input.WasmKeeper)
+	storeRes, err := wasmMsgServer.StoreCode(ctx, &wasmtypes.MsgStoreCode{
+		Sender:       addr.String(),
+		WASMByteCode: code,
+	})
+	require.NoError(t, err)
+
+	instantiateRes, err := wasmMsgServer.InstantiateContract(ctx, &wasmtypes.MsgInstantiateContract{
+		Sender: addr.String(),
+		Admin:  addr.String(),
+		CodeID: storeRes.CodeID,
+		Label:  "Counter",
+		Msg:    []byte("{}"),
+		Funds:  nil,
+	})
+	require.NoError(t, err)
+
+	contractAddrBech32 := instantiateRes.Address
+	contractAddr, err := sdk.AccAddressFromBech32(contractAddrBech32)
+	require.NoError(t, err)
+
+	req := &wasmhookstypes.QuerySimulateIBCHookRequest{
+		SourceChannel:      "channel-1",
+		DestinationChannel: "channel-0",
+		Denom:              "foo",
+		Amount:             "10000",
+		Sender:             addr.String(),
+		Receiver:           contractAddrBech32,
+		Memo: fmt.Sprintf(`{
+			"wasm": {
+				"message": {
+					"contract": "%s",
+					"msg": {"increase":{}}
+				}
+			}
+		}`, contractAddrBech32),
+	}
+
+	// funds foo coins to the intermediate sender
+	intermediateSender, err := sdk.AccAddressFromBech32(ibchooks.DeriveIntermediateSender("channel-0", addr.String()))
+	require.NoError(t, err)
+	denom := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(transfertypes.PortID, "channel-0", "foo")).IBCDenom()
+	input.Faucet.Fund(ctx, intermediateSender, sdk.NewCoin(denom, math.NewInt(10000)))
+
+	querier := wasmhookskeeper.Querier{Keeper: input.WasmHooksKeeper}
+
+	// not allowed by acl
+	res, err := querier.SimulateIBCHook(ctx, req)
+	require.NoError(t, err)
+	require.False(t, res.Success)
+	require.Contains(t, string(res.Acknowledgement), fmt.Sprintf("code: %d", wasmhookstypes.ErrHookNotAllowed.ABCICode()))
+
+	// set acl
+	require.NoError(t, input.IBCHooksKeeper.SetAllowed(ctx, contractAddr, true))
+
+	res, err = querier.SimulateIBCHook(ctx, req)
+	require.NoError(t, err)
+	require.True(t, res.Success)
+	require.NotZero(t, res.GasUsed)
+
+	var executed bool
+	for _, event := range res.Events {
+		if event.Type == wasmtypes.EventTypeExecute {
+			executed = true
+		}
+	}
+	require.True(t, executed)
+
+	// nothing is committed
+	queryRes, err := input.WasmKeeper.QuerySmart(ctx, contractAddr, []byte(`{"get":{}}`))
+	require.NoError(t, err)
+	require.Equal(t, "0", string(queryRes))
+
+	// the simulation is capped by the simulation gas limit, regardless of the
+	// infinite gas meter of the query context
+	input.WasmHooksKeeper.SetSimulationGasLimit(res.GasUsed - 1)
+	_, err = querier.SimulateIBCHook(ctx, req)
+	require.ErrorIs(t, err, wasmhookstypes.ErrSimulationOutOfGas)
+
+	input.WasmHooksKeeper.SetSimulationGasLimit(wasmhookstypes.DefaultSimulationGasLimit)
+	res, err = querier.SimulateIBCHook(ctx, req)
+	require.NoError(t, err)
+	require.True(t, res.Success)
+
+	// invalid request
+	req.DestinationChannel = ""
+	_, err = querier.SimulateIBCHook(ctx, req)
+	require.ErrorIs(t, err, wasmhookstypes.ErrInvalidSimulation)
+}
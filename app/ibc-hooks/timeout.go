@@ -8,8 +8,9 @@ import (
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 
 	ibchooks "github.com/initia-labs/initia/x/ibc-hooks"
-	"github.com/initia-labs/initia/x/ibc-hooks/types"
 	nfttransfertypes "github.com/initia-labs/initia/x/ibc/nft-transfer/types"
+
+	wasmhookstypes "github.com/initia-labs/miniwasm/x/wasmhooks/types"
 )
 
 func (h WasmHooks) onTimeoutIcs20Packet(
@@ -23,62 +24,41 @@ func (h WasmHooks) onTimeoutIcs20Packet(
 		return err
 	}
 
-	isWasmRouted, hookData, err := validateAndParseMemo(data.GetMemo())
-	if !isWasmRouted || hookData.AsyncCallback == "" {
+	isWasmRouted, callback, err := parseAsyncCallback(data.GetMemo())
+	if !isWasmRouted {
 		return nil
 	} else if err != nil {
 		h.wasmKeeper.Logger(ctx).Error("failed to parse memo", "error", err)
+		emitHookFailedEvent(ctx, "failed to parse memo", err)
+
+		return nil
+	} else if callback == "" {
 		return nil
 	}
 
-	// create a new cache context to ignore errors during
-	// the execution of the callback
-	cacheCtx, write := ctx.CacheContext()
-
-	callback := hookData.AsyncCallback
-	if allowed, err := h.checkACL(im, cacheCtx, callback); err != nil {
-		h.wasmKeeper.Logger(cacheCtx).Error("failed to check ACL", "error", err)
-		ctx.EventManager().EmitEvent(sdk.NewEvent(
-			types.EventTypeHookFailed,
-			sdk.NewAttribute(types.AttributeKeyReason, "failed to check ACL"),
-			sdk.NewAttribute(types.AttributeKeyError, err.Error()),
-		))
+	if allowed, err := h.checkCallbackACL(ctx, packet, callback); err != nil {
+		h.wasmKeeper.Logger(ctx).Error("failed to check ACL", "error", err)
+		emitHookFailedEvent(ctx, "failed to check ACL", err)
 
 		return nil
 	} else if !allowed {
-		h.wasmKeeper.Logger(cacheCtx).Error("failed to check ACL", "not allowed")
-		ctx.EventManager().EmitEvent(sdk.NewEvent(
-			types.EventTypeHookFailed,
-			sdk.NewAttribute(types.AttributeKeyReason, "failed to check ACL"),
-			sdk.NewAttribute(types.AttributeKeyError, "not allowed"),
-		))
+		err := wasmhookstypes.ErrCallbackNotAllowed.Wrapf("contract: %s", callback)
+		h.wasmKeeper.Logger(ctx).Error("failed to check ACL", "error", err)
+		emitHookFailedEvent(ctx, "failed to check ACL", err)
 
 		return nil
 	}
 
 	contractAddr, err := h.ac.StringToBytes(callback)
 	if err != nil {
-		h.wasmKeeper.Logger(cacheCtx).Error("invalid contract address", "error", err)
+		h.wasmKeeper.Logger(ctx).Error("invalid contract address", "error", err)
 		return nil
 	}
 
 	sudoMsg := []byte(fmt.Sprintf(
 		`{"ibc_lifecycle_complete": {"ibc_timeout": {"channel": "%s", "sequence": %d}}}`,
 		packet.SourceChannel, packet.Sequence))
-	_, err = h.wasmKeeper.Sudo(cacheCtx, contractAddr, sudoMsg)
-	if err != nil {
-		h.wasmKeeper.Logger(cacheCtx).Error("failed to execute callback", "error", err)
-		ctx.EventManager().EmitEvent(sdk.NewEvent(
-			types.EventTypeHookFailed,
-			sdk.NewAttribute(types.AttributeKeyReason, "failed to execute callback"),
-			sdk.NewAttribute(types.AttributeKeyError, err.Error()),
-		))
-
-		return nil
-	}
-
-	// write the cache context only if the callback execution was successful
-	write()
+	h.execCallback(ctx, packet, wasmhookstypes.CallbackTypeTimeout, contractAddr, sudoMsg)
 
 	return nil
 }
@@ -94,62 +74,41 @@ func (h WasmHooks) onTimeoutIcs721Packet(
 		return err
 	}
 
-	isWasmRouted, hookData, err := validateAndParseMemo(data.GetMemo())
-	if !isWasmRouted || hookData.AsyncCallback == "" {
+	isWasmRouted, callback, err := parseAsyncCallback(data.GetMemo())
+	if !isWasmRouted {
 		return nil
 	} else if err != nil {
 		h.wasmKeeper.Logger(ctx).Error("failed to parse memo", "error", err)
+		emitHookFailedEvent(ctx, "failed to parse memo", err)
+
+		return nil
+	} else if callback == "" {
 		return nil
 	}
 
-	// create a new cache context to ignore errors during
-	// the execution of the callback
-	cacheCtx, write := ctx.CacheContext()
-
-	callback := hookData.AsyncCallback
-	if allowed, err := h.checkACL(im, cacheCtx, callback); err != nil {
-		h.wasmKeeper.Logger(cacheCtx).Error("failed to check ACL", "error", err)
-		ctx.EventManager().EmitEvent(sdk.NewEvent(
-			types.EventTypeHookFailed,
-			sdk.NewAttribute(types.AttributeKeyReason, "failed to check ACL"),
-			sdk.NewAttribute(types.AttributeKeyError, err.Error()),
-		))
+	if allowed, err := h.checkCallbackACL(ctx, packet, callback); err != nil {
+		h.wasmKeeper.Logger(ctx).Error("failed to check ACL", "error", err)
+		emitHookFailedEvent(ctx, "failed to check ACL", err)
 
 		return nil
 	} else if !allowed {
-		h.wasmKeeper.Logger(cacheCtx).Error("failed to check ACL", "not allowed")
-		ctx.EventManager().EmitEvent(sdk.NewEvent(
-			types.EventTypeHookFailed,
-			sdk.NewAttribute(types.AttributeKeyReason, "failed to check ACL"),
-			sdk.NewAttribute(types.AttributeKeyError, "not allowed"),
-		))
+		err := wasmhookstypes.ErrCallbackNotAllowed.Wrapf("contract: %s", callback)
+		h.wasmKeeper.Logger(ctx).Error("failed to check ACL", "error", err)
+		emitHookFailedEvent(ctx, "failed to check ACL", err)
 
 		return nil
 	}
 
 	contractAddr, err := h.ac.StringToBytes(callback)
 	if err != nil {
-		h.wasmKeeper.Logger(cacheCtx).Error("invalid contract address", "error", err)
+		h.wasmKeeper.Logger(ctx).Error("invalid contract address", "error", err)
 		return nil
 	}
 
 	sudoMsg := []byte(fmt.Sprintf(
 		`{"ibc_lifecycle_complete": {"ibc_timeout": {"channel": "%s", "sequence": %d}}}`,
 		packet.SourceChannel, packet.Sequence))
-	_, err = h.wasmKeeper.Sudo(cacheCtx, contractAddr, sudoMsg)
-	if err != nil {
-		h.wasmKeeper.Logger(cacheCtx).Error("failed to execute callback", "error", err)
-		ctx.EventManager().EmitEvent(sdk.NewEvent(
-			types.EventTypeHookFailed,
-			sdk.NewAttribute(types.AttributeKeyReason, "failed to execute callback"),
-			sdk.NewAttribute(types.AttributeKeyError, err.Error()),
-		))
-
-		return nil
-	}
-
-	// write the cache context only if the callback execution was successful
-	write()
+	h.execCallback(ctx, packet, wasmhookstypes.CallbackTypeTimeout, contractAddr, sudoMsg)
 
 	return nil
 }
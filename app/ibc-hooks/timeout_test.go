@@ -106,6 +106,27 @@ func Test_OnTimeoutPacket_memo(t *testing.T) {
 	queryRes, err = input.WasmKeeper.QuerySmart(ctx, contractAddr, []byte(`{"get":{}}`))
 	require.NoError(t, err)
 	require.Equal(t, "99", string(queryRes))
+
+	// the message is addressed to the counterparty chain, so its unknown fields
+	// don't prevent the callback
+	data.Memo = fmt.Sprintf(`{
+		"wasm": {
+			"message": {"contract": "%s", "msg": {}, "funds": []},
+			"async_callback": "%s"
+		}
+	}`, contractAddrBech32, contractAddrBech32)
+	dataBz, err = json.Marshal(&data)
+	require.NoError(t, err)
+
+	err = input.IBCHooksMiddleware.OnTimeoutPacket(ctx, channeltypes.Packet{
+		Data:     dataBz,
+		Sequence: 1,
+	}, addr)
+	require.NoError(t, err)
+
+	queryRes, err = input.WasmKeeper.QuerySmart(ctx, contractAddr, []byte(`{"get":{}}`))
+	require.NoError(t, err)
+	require.Equal(t, "100", string(queryRes))
 }
 
 func Test_OnTimeoutPacket_ICS721(t *testing.T) {
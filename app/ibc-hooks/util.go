@@ -1,8 +1,10 @@
 package wasm_hooks
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"cosmossdk.io/errors"
@@ -14,9 +16,12 @@ import (
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
 
+	ibchookstypes "github.com/initia-labs/initia/x/ibc-hooks/types"
 	nfttransfertypes "github.com/initia-labs/initia/x/ibc/nft-transfer/types"
 
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+
+	wasmhookstypes "github.com/initia-labs/miniwasm/x/wasmhooks/types"
 )
 
 const senderPrefix = "ibc-wasm-hook-intermediary"
@@ -53,6 +58,8 @@ func isIcs721Packet(packetData []byte) (isIcs721 bool, ics721data nfttransfertyp
 	}
 }
 
+// validateAndParseMemo parses the `wasm` field of the memo strictly; unknown fields are
+// rejected and the execute message must have exactly `contract` and `msg`.
 func validateAndParseMemo(memo string) (
 	isWasmRouted bool,
 	hookData HookData,
@@ -63,18 +70,108 @@ func validateAndParseMemo(memo string) (
 		return
 	}
 
-	wasmHookRaw := metadata[wasmHookMemoKey]
+	var hookMemo hookMemo
+	if err = decodeStrictJSON(metadata[wasmHookMemoKey], &hookMemo); err != nil {
+		err = errors.Wrap(wasmhookstypes.ErrInvalidMemo, err.Error())
+		return
+	}
 
-	// parse wasm raw bytes to execute message
-	bz, err := json.Marshal(wasmHookRaw)
-	if err != nil {
-		err = errors.Wrap(channeltypes.ErrInvalidPacket, err.Error())
+	hookData.AsyncCallback = hookMemo.AsyncCallback
+	if hookMemo.Message == nil {
 		return
 	}
 
-	err = json.Unmarshal(bz, &hookData)
-	if err != nil {
-		err = errors.Wrap(channeltypes.ErrInvalidPacket, err.Error())
+	if hookMemo.Message.Contract == "" {
+		err = wasmhookstypes.ErrMissingContract
+		return
+	}
+
+	var msgObject map[string]json.RawMessage
+	if err = json.Unmarshal(hookMemo.Message.Msg, &msgObject); err != nil || msgObject == nil {
+		err = errors.Wrap(wasmhookstypes.ErrInvalidMemo, "msg must be a json object")
+		return
+	}
+
+	hookData.Message = &wasmtypes.MsgExecuteContract{
+		Contract: hookMemo.Message.Contract,
+		Msg:      wasmtypes.RawContractMessage(hookMemo.Message.Msg),
+	}
+
+	return
+}
+
+// parseAsyncCallback parses the async callback of the `wasm` field of the memo on the
+// ack and the timeout. The message is addressed to the hook implementation of the
+// counterparty chain, so only the async callback is decoded strictly.
+func parseAsyncCallback(memo string) (
+	isWasmRouted bool,
+	asyncCallback string,
+	err error,
+) {
+	isWasmRouted, metadata := jsonStringHasKey(memo, wasmHookMemoKey)
+	if !isWasmRouted {
+		return
+	}
+
+	var hookObject map[string]json.RawMessage
+	if err = json.Unmarshal(metadata[wasmHookMemoKey], &hookObject); err != nil {
+		err = errors.Wrap(wasmhookstypes.ErrInvalidMemo, err.Error())
+		return
+	}
+
+	callbackBz, ok := hookObject[wasmHookCallbackKey]
+	if !ok {
+		return
+	}
+
+	if err = decodeStrictJSON(callbackBz, &asyncCallback); err != nil {
+		err = errors.Wrap(wasmhookstypes.ErrInvalidMemo, err.Error())
+		return
+	}
+
+	return
+}
+
+// decodeStrictJSON decodes the json bytes into the value and rejects unknown fields.
+func decodeStrictJSON(bz []byte, v any) error {
+	decoder := json.NewDecoder(bytes.NewReader(bz))
+	decoder.DisallowUnknownFields()
+	return decoder.Decode(v)
+}
+
+func isWasmPort(portID string) bool {
+	return strings.HasPrefix(portID, wasmPortPrefix)
+}
+
+// parseWasmPacketHookData parses the hook data of a packet of wasm ports and returns
+// the packet data to be delivered to the contract. The HookPacketData envelope is
+// unwrapped, and the `wasm_hook` key is removed from a json packet.
+func parseWasmPacketHookData(packetData []byte) (
+	found bool,
+	hookData PacketHookData,
+	data []byte,
+	err error,
+) {
+	if envelope, ok := wasmhookstypes.UnwrapPacketData(packetData); ok {
+		return true, PacketHookData{AsyncCallback: envelope.AsyncCallback}, envelope.Data, nil
+	}
+
+	data = packetData
+	found, jsonObject := jsonStringHasKey(string(packetData), wasmHookPacketKey)
+	if !found {
+		return
+	}
+
+	hookDataBz := jsonObject[wasmHookPacketKey]
+	delete(jsonObject, wasmHookPacketKey)
+	if data, err = json.Marshal(jsonObject); err != nil {
+		data = packetData
+		err = errors.Wrap(wasmhookstypes.ErrInvalidMemo, err.Error())
+		return
+	}
+
+	if err = decodeStrictJSON(hookDataBz, &hookData); err != nil {
+		err = errors.Wrap(wasmhookstypes.ErrInvalidMemo, err.Error())
 		return
 	}
 
@@ -83,15 +180,15 @@ func validateAndParseMemo(memo string) (
 
 func validateReceiver(msg *wasmtypes.MsgExecuteContract, receiver string) error {
 	if receiver != msg.Contract {
-		return errors.Wrap(channeltypes.ErrInvalidPacket, "receiver is not properly set")
+		return errors.Wrapf(wasmhookstypes.ErrReceiverMismatch, "receiver: %s, contract: %s", receiver, msg.Contract)
 	}
 
 	return nil
 }
 
 // jsonStringHasKey parses the memo as a json object and checks if it contains the key.
-func jsonStringHasKey(memo, key string) (found bool, jsonObject map[string]interface{}) {
-	jsonObject = make(map[string]interface{})
+func jsonStringHasKey(memo, key string) (found bool, jsonObject map[string]json.RawMessage) {
+	jsonObject = make(map[string]json.RawMessage)
 
 	// If there is no memo, the packet was either sent with an earlier version of IBC, or the memo was
 	// intentionally left blank. Nothing to do here. Ignore the packet and pass it down the stack.
@@ -115,16 +212,58 @@ func jsonStringHasKey(memo, key string) (found bool, jsonObject map[string]inter
 	return true, jsonObject
 }
 
+// executionError is the error of a failed hook execution. It reports the abci code
+// of ErrExecutionFailed, so the acks and the events keep the documented code, and
+// keeps the execution error in the error chain.
+type executionError struct {
+	err error
+}
+
+func newExecutionError(err error) error {
+	return executionError{err: err}
+}
+
+func (e executionError) Error() string {
+	return fmt.Sprintf("%s: %s", wasmhookstypes.ErrExecutionFailed, e.err)
+}
+
+func (e executionError) ABCICode() uint32 {
+	return wasmhookstypes.ErrExecutionFailed.ABCICode()
+}
+
+func (e executionError) Codespace() string {
+	return wasmhookstypes.ErrExecutionFailed.Codespace()
+}
+
+func (e executionError) Unwrap() []error {
+	return []error{wasmhookstypes.ErrExecutionFailed, e.err}
+}
+
 // newEmitErrorAcknowledgement creates a new error acknowledgement after having emitted an event with the
-// details of the error.
-func newEmitErrorAcknowledgement(err error) channeltypes.Acknowledgement {
+// details of the error. The codespace and code of the error are included in both of them.
+func newEmitErrorAcknowledgement(ctx sdk.Context, err error) channeltypes.Acknowledgement {
+	emitHookFailedEvent(ctx, "failed to process packet", err)
+
+	codespace, code, _ := errors.ABCIInfo(err, false)
 	return channeltypes.Acknowledgement{
 		Response: &channeltypes.Acknowledgement_Error{
-			Error: fmt.Sprintf("ibc wasm hook error: %s", err.Error()),
+			Error: fmt.Sprintf("ibc wasm hook error: codespace: %s, code: %d: %s", codespace, code, err.Error()),
 		},
 	}
 }
 
+// emitHookFailedEvent emits a hook failed event with the codespace and code of the error.
+func emitHookFailedEvent(ctx sdk.Context, reason string, err error) {
+	codespace, code, _ := errors.ABCIInfo(err, false)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		ibchookstypes.EventTypeHookFailed,
+		sdk.NewAttribute(ibchookstypes.AttributeKeyReason, reason),
+		sdk.NewAttribute(ibchookstypes.AttributeKeyError, err.Error()),
+		sdk.NewAttribute(wasmhookstypes.AttributeKeyCodespace, codespace),
+		sdk.NewAttribute(wasmhookstypes.AttributeKeyCode, strconv.FormatUint(uint64(code), 10)),
+	))
+}
+
 // isAckError checks an IBC acknowledgement to see if it's an error.
 // This is a replacement for ack.Success() which is currently not working on some circumstances
 func isAckError(appCodec codec.Codec, acknowledgement []byte) bool {
@@ -2,17 +2,20 @@ package wasm_hooks
 
 import (
 	"encoding/json"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
-	"cosmossdk.io/math"
-	sdk "github.com/cosmos/cosmos-sdk/types"
+	errorsmod "cosmossdk.io/errors"
+
 	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 
 	nfttransfertypes "github.com/initia-labs/initia/x/ibc/nft-transfer/types"
 
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+
+	wasmhookstypes "github.com/initia-labs/miniwasm/x/wasmhooks/types"
 )
 
 func Test_isIcs20Packet(t *testing.T) {
@@ -48,10 +51,8 @@ func Test_validateAndParseMemo_without_callback(t *testing.T) {
 	memo := `{
 			"wasm" : {
 				"message": {
-					"sender": "init_addr",
 					"contract": "contract_addr",
-					"msg": {},
-					"funds": [{"denom":"foo","amount":"100"}]
+					"msg": {}
 				}
 			}
 	}`
@@ -60,13 +61,8 @@ func Test_validateAndParseMemo_without_callback(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, HookData{
 		Message: &wasmtypes.MsgExecuteContract{
-			Sender:   "init_addr",
 			Contract: "contract_addr",
 			Msg:      []byte("{}"),
-			Funds: sdk.Coins{{
-				Denom:  "foo",
-				Amount: math.NewInt(100),
-			}},
 		},
 		AsyncCallback: "",
 	}, hookData)
@@ -74,7 +70,7 @@ func Test_validateAndParseMemo_without_callback(t *testing.T) {
 
 	// invalid receiver
 	require.NoError(t, err)
-	require.Error(t, validateReceiver(hookData.Message, "invalid_addr"))
+	require.ErrorIs(t, validateReceiver(hookData.Message, "invalid_addr"), wasmhookstypes.ErrReceiverMismatch)
 
 	isWasmRouted, _, err = validateAndParseMemo("hihi")
 	require.False(t, isWasmRouted)
@@ -85,10 +81,8 @@ func Test_validateAndParseMemo_with_callback(t *testing.T) {
 	memo := `{
 			"wasm" : {
 				"message": {
-					"sender": "init_addr",
 					"contract": "contract_addr",
-					"msg": {},
-					"funds": [{"denom":"foo","amount":"100"}]
+					"msg": {}
 				},
 				"async_callback": "callback_addr"
 			}
@@ -98,15 +92,175 @@ func Test_validateAndParseMemo_with_callback(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, HookData{
 		Message: &wasmtypes.MsgExecuteContract{
-			Sender:   "init_addr",
 			Contract: "contract_addr",
 			Msg:      []byte("{}"),
-			Funds: sdk.Coins{{
-				Denom:  "foo",
-				Amount: math.NewInt(100),
-			}},
 		},
 		AsyncCallback: "callback_addr",
 	}, hookData)
 	require.NoError(t, validateReceiver(hookData.Message, "contract_addr"))
 }
+
+func Test_validateAndParseMemo_strict(t *testing.T) {
+	testCases := []struct {
+		name string
+		memo string
+		err  error
+	}{
+		{
+			name: "memo is not json",
+			memo: `{"wasm": {"message": }}`,
+			err:  nil,
+		},
+		{
+			name: "unknown field in wasm",
+			memo: `{"wasm": {"message": {"contract": "contract_addr", "msg": {}}, "foo": "bar"}}`,
+			err:  wasmhookstypes.ErrInvalidMemo,
+		},
+		{
+			name: "unknown field in message",
+			memo: `{"wasm": {"message": {"sender": "init_addr", "contract": "contract_addr", "msg": {}}}}`,
+			err:  wasmhookstypes.ErrInvalidMemo,
+		},
+		{
+			name: "wasm is not an object",
+			memo: `{"wasm": "contract_addr"}`,
+			err:  wasmhookstypes.ErrInvalidMemo,
+		},
+		{
+			name: "missing contract",
+			memo: `{"wasm": {"message": {"msg": {}}}}`,
+			err:  wasmhookstypes.ErrMissingContract,
+		},
+		{
+			name: "msg is not an object",
+			memo: `{"wasm": {"message": {"contract": "contract_addr", "msg": "increase"}}}`,
+			err:  wasmhookstypes.ErrInvalidMemo,
+		},
+		{
+			name: "missing msg",
+			memo: `{"wasm": {"message": {"contract": "contract_addr"}}}`,
+			err:  wasmhookstypes.ErrInvalidMemo,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			isWasmRouted, _, err := validateAndParseMemo(tc.memo)
+			if tc.err == nil {
+				// not a json object, so not routed to the wasm hooks
+				require.False(t, isWasmRouted)
+				require.NoError(t, err)
+				return
+			}
+
+			require.True(t, isWasmRouted)
+			require.ErrorIs(t, err, tc.err)
+		})
+	}
+}
+
+func Test_parseAsyncCallback(t *testing.T) {
+	testCases := []struct {
+		name     string
+		memo     string
+		routed   bool
+		callback string
+		err      error
+	}{
+		{
+			name: "not routed",
+			memo: `{"foo": "bar"}`,
+		},
+		{
+			name:   "without callback",
+			memo:   `{"wasm": {"message": {"contract": "contract_addr", "msg": {}}}}`,
+			routed: true,
+		},
+		{
+			name:     "unknown field in message",
+			memo:     `{"wasm": {"message": {"contract": "contract_addr", "msg": {}, "funds": []}, "async_callback": "callback_addr"}}`,
+			routed:   true,
+			callback: "callback_addr",
+		},
+		{
+			name:   "callback is not a string",
+			memo:   `{"wasm": {"async_callback": 1}}`,
+			routed: true,
+			err:    wasmhookstypes.ErrInvalidMemo,
+		},
+		{
+			name:   "wasm is not an object",
+			memo:   `{"wasm": "callback_addr"}`,
+			routed: true,
+			err:    wasmhookstypes.ErrInvalidMemo,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			isWasmRouted, callback, err := parseAsyncCallback(tc.memo)
+			require.Equal(t, tc.routed, isWasmRouted)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.callback, callback)
+		})
+	}
+}
+
+func Test_parseWasmPacketHookData(t *testing.T) {
+	// json packet, delivered without the hook data
+	packetData := []byte(`{"foo":"bar","wasm_hook":{"async_callback":"callback_addr"}}`)
+	found, hookData, data, err := parseWasmPacketHookData(packetData)
+	require.True(t, found)
+	require.NoError(t, err)
+	require.Equal(t, PacketHookData{AsyncCallback: "callback_addr"}, hookData)
+	require.Equal(t, []byte(`{"foo":"bar"}`), data)
+
+	// protobuf envelope
+	packetData, err = wasmhookstypes.WrapPacketData([]byte("raw data"), "callback_addr")
+	require.NoError(t, err)
+	found, hookData, data, err = parseWasmPacketHookData(packetData)
+	require.True(t, found)
+	require.NoError(t, err)
+	require.Equal(t, PacketHookData{AsyncCallback: "callback_addr"}, hookData)
+	require.Equal(t, []byte("raw data"), data)
+
+	// invalid hook data
+	packetData = []byte(`{"foo":"bar","wasm_hook":{"async_callback":1}}`)
+	found, _, data, err = parseWasmPacketHookData(packetData)
+	require.True(t, found)
+	require.ErrorIs(t, err, wasmhookstypes.ErrInvalidMemo)
+	require.Equal(t, []byte(`{"foo":"bar"}`), data)
+
+	// unknown field in hook data
+	packetData = []byte(`{"wasm_hook":{"async_callback":"callback_addr","foo":"bar"}}`)
+	found, _, _, err = parseWasmPacketHookData(packetData)
+	require.True(t, found)
+	require.ErrorIs(t, err, wasmhookstypes.ErrInvalidMemo)
+
+	// not routed
+	packetData = []byte("raw data")
+	found, _, data, err = parseWasmPacketHookData(packetData)
+	require.False(t, found)
+	require.NoError(t, err)
+	require.Equal(t, packetData, data)
+}
+
+func Test_newExecutionError(t *testing.T) {
+	execErr := wasmtypes.ErrExecuteFailed.Wrap("contract error")
+	err := newExecutionError(execErr)
+
+	// the execution error is kept in the error chain
+	require.ErrorIs(t, err, wasmhookstypes.ErrExecutionFailed)
+	require.ErrorIs(t, err, wasmtypes.ErrExecuteFailed)
+	require.Equal(t, fmt.Sprintf("%s: %s", wasmhookstypes.ErrExecutionFailed, execErr), err.Error())
+
+	// the abci code is the code of ErrExecutionFailed
+	codespace, code, _ := errorsmod.ABCIInfo(err, false)
+	require.Equal(t, wasmhookstypes.ErrExecutionFailed.Codespace(), codespace)
+	require.Equal(t, wasmhookstypes.ErrExecutionFailed.ABCICode(), code)
+}
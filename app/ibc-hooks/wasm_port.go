@@ -0,0 +1,150 @@
+package wasm_hooks
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+
+	ibchooks "github.com/initia-labs/initia/x/ibc-hooks"
+
+	wasmhookstypes "github.com/initia-labs/miniwasm/x/wasmhooks/types"
+)
+
+// onRecvWasmPacket removes the hook data from a packet of wasm ports, so the
+// receiving contract gets the packet data without it. The HookPacketData envelope
+// is unwrapped, and the `wasm_hook` key is removed from a json packet, which is
+// re-encoded with the keys sorted. The sender contract gets the packet as it was
+// sent, including the hook data, on the ack and the timeout.
+func (h WasmHooks) onRecvWasmPacket(
+	ctx sdk.Context,
+	im ibchooks.IBCMiddleware,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	// the hook data is only used by the sender chain, so ignore the parse error
+	// and deliver the packet as it is.
+	_, _, data, _ := parseWasmPacketHookData(packet.GetData())
+	packet.Data = data
+
+	return im.App.OnRecvPacket(ctx, packet, relayer)
+}
+
+func (h WasmHooks) onAckWasmPacket(
+	ctx sdk.Context,
+	im ibchooks.IBCMiddleware,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	// the sender contract gets the packet as it was sent; the hook data is only
+	// unwrapped for the callback.
+	if err := im.App.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer); err != nil {
+		return err
+	}
+
+	isWasmRouted, hookData, _, err := parseWasmPacketHookData(packet.GetData())
+	if !isWasmRouted {
+		return nil
+	} else if err != nil {
+		h.wasmKeeper.Logger(ctx).Error("failed to parse packet hook data", "error", err)
+		emitHookFailedEvent(ctx, "failed to parse packet hook data", err)
+
+		return nil
+	} else if hookData.AsyncCallback == "" {
+		return nil
+	}
+
+	callback := hookData.AsyncCallback
+	if allowed, err := h.checkCallbackACL(ctx, packet, callback); err != nil {
+		h.wasmKeeper.Logger(ctx).Error("failed to check ACL", "error", err)
+		emitHookFailedEvent(ctx, "failed to check ACL", err)
+
+		return nil
+	} else if !allowed {
+		err := wasmhookstypes.ErrCallbackNotAllowed.Wrapf("contract: %s", callback)
+		h.wasmKeeper.Logger(ctx).Error("failed to check ACL", "error", err)
+		emitHookFailedEvent(ctx, "failed to check ACL", err)
+
+		return nil
+	}
+
+	contractAddr, err := h.ac.StringToBytes(callback)
+	if err != nil {
+		h.wasmKeeper.Logger(ctx).Error("invalid contract address", "error", err)
+		return nil
+	}
+
+	success := "false"
+	if !isAckError(h.codec, acknowledgement) {
+		success = "true"
+	}
+
+	// Notify the sender that the ack has been received
+	ackAsJson, err := json.Marshal(acknowledgement)
+	if err != nil {
+		h.wasmKeeper.Logger(ctx).Error("ack is not json object", "error", err)
+		return nil
+	}
+
+	sudoMsg := []byte(fmt.Sprintf(
+		`{"ibc_lifecycle_complete": {"ibc_ack": {"channel": "%s", "sequence": %d, "ack": %s, "success": %s}}}`,
+		packet.SourceChannel, packet.Sequence, ackAsJson, success))
+	h.execCallback(ctx, packet, wasmhookstypes.CallbackTypeAck, contractAddr, sudoMsg)
+
+	return nil
+}
+
+func (h WasmHooks) onTimeoutWasmPacket(
+	ctx sdk.Context,
+	im ibchooks.IBCMiddleware,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) error {
+	// the sender contract gets the packet as it was sent; the hook data is only
+	// unwrapped for the callback.
+	if err := im.App.OnTimeoutPacket(ctx, packet, relayer); err != nil {
+		return err
+	}
+
+	isWasmRouted, hookData, _, err := parseWasmPacketHookData(packet.GetData())
+	if !isWasmRouted {
+		return nil
+	} else if err != nil {
+		h.wasmKeeper.Logger(ctx).Error("failed to parse packet hook data", "error", err)
+		emitHookFailedEvent(ctx, "failed to parse packet hook data", err)
+
+		return nil
+	} else if hookData.AsyncCallback == "" {
+		return nil
+	}
+
+	callback := hookData.AsyncCallback
+	if allowed, err := h.checkCallbackACL(ctx, packet, callback); err != nil {
+		h.wasmKeeper.Logger(ctx).Error("failed to check ACL", "error", err)
+		emitHookFailedEvent(ctx, "failed to check ACL", err)
+
+		return nil
+	} else if !allowed {
+		err := wasmhookstypes.ErrCallbackNotAllowed.Wrapf("contract: %s", callback)
+		h.wasmKeeper.Logger(ctx).Error("failed to check ACL", "error", err)
+		emitHookFailedEvent(ctx, "failed to check ACL", err)
+
+		return nil
+	}
+
+	contractAddr, err := h.ac.StringToBytes(callback)
+	if err != nil {
+		h.wasmKeeper.Logger(ctx).Error("invalid contract address", "error", err)
+		return nil
+	}
+
+	sudoMsg := []byte(fmt.Sprintf(
+		`{"ibc_lifecycle_complete": {"ibc_timeout": {"channel": "%s", "sequence": %d}}}`,
+		packet.SourceChannel, packet.Sequence))
+	h.execCallback(ctx, packet, wasmhookstypes.CallbackTypeTimeout, contractAddr, sudoMsg)
+
+	return nil
+}
@@ -0,0 +1,195 @@
+package wasm_hooks_test
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	wasmhookstypes "github.com/initia-labs/miniwasm/x/wasmhooks/types"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func Test_OnRecvPacket_WasmPort(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	_, _, addr := keyPubAddr()
+
+	// json packet; the hook data is removed
+	ack := input.IBCHooksMiddleware.OnRecvPacket(ctx, channeltypes.Packet{
+		Data:            []byte(`{"custom":"data","wasm_hook":{"async_callback":"callback_addr"}}`),
+		DestinationPort: "wasm.contract_addr",
+	}, addr)
+	require.True(t, ack.Success())
+
+	// protobuf envelope; the envelope is unwrapped
+	protoData, err := wasmhookstypes.WrapPacketData([]byte("custom data"), "callback_addr")
+	require.NoError(t, err)
+	ack = input.IBCHooksMiddleware.OnRecvPacket(ctx, channeltypes.Packet{
+		Data:            protoData,
+		DestinationPort: "wasm.contract_addr",
+	}, addr)
+	require.True(t, ack.Success())
+
+	// without hook data; delivered as it is
+	ack = input.IBCHooksMiddleware.OnRecvPacket(ctx, channeltypes.Packet{
+		Data:            []byte(`{"custom":"data"}`),
+		DestinationPort: "wasm.contract_addr",
+	}, addr)
+	require.True(t, ack.Success())
+
+	delivered := *input.DeliveredPackets
+	require.Len(t, delivered, 3)
+	require.Equal(t, []byte(`{"custom":"data"}`), delivered[0].Data)
+	require.Equal(t, []byte("custom data"), delivered[1].Data)
+	require.Equal(t, []byte(`{"custom":"data"}`), delivered[2].Data)
+}
+
+func Test_OnAckPacket_WasmPort(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	_, _, addr := keyPubAddr()
+
+	code, err := os.ReadFile("./contracts/artifacts/counter-aarch64.wasm")
+	require.NoError(t, err)
+
+	wasmMsgServer := wasmkeeper.NewMsgServerImpl(&input.WasmKeeper)
+	storeRes, err := wasmMsgServer.StoreCode(ctx, &wasmtypes.MsgStoreCode{
+		Sender:       addr.String(),
+		WASMByteCode: code,
+	})
+	require.NoError(t, err)
+
+	instantiateRes, err := wasmMsgServer.InstantiateContract(ctx, &wasmtypes.MsgInstantiateContract{
+		Sender: addr.String(),
+		Admin:  addr.String(),
+		CodeID: storeRes.CodeID,
+		Label:  "Counter",
+		Msg:    []byte("{}"),
+		Funds:  nil,
+	})
+	require.NoError(t, err)
+
+	contractAddrBech32 := instantiateRes.Address
+	contractAddr, err := sdk.AccAddressFromBech32(contractAddrBech32)
+	require.NoError(t, err)
+
+	// set acl
+	require.NoError(t, input.IBCHooksKeeper.SetAllowed(ctx, contractAddr, true))
+
+	successAckBz := channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement()
+	failedAckBz := channeltypes.NewErrorAcknowledgement(errors.New("failed")).Acknowledgement()
+
+	// json packet
+	jsonData := []byte(fmt.Sprintf(`{"custom":"data","wasm_hook":{"async_callback":"%s"}}`, contractAddrBech32))
+	err = input.IBCHooksMiddleware.OnAcknowledgementPacket(ctx, channeltypes.Packet{
+		Data:       jsonData,
+		SourcePort: "wasm." + contractAddrBech32,
+		Sequence:   99,
+	}, successAckBz, addr)
+	require.NoError(t, err)
+
+	queryRes, err := input.WasmKeeper.QuerySmart(ctx, contractAddr, []byte(`{"get":{}}`))
+	require.NoError(t, err)
+	require.Equal(t, "99", string(queryRes))
+
+	// protobuf envelope
+	protoData, err := wasmhookstypes.WrapPacketData([]byte("custom data"), contractAddrBech32)
+	require.NoError(t, err)
+	err = input.IBCHooksMiddleware.OnAcknowledgementPacket(ctx, channeltypes.Packet{
+		Data:       protoData,
+		SourcePort: "wasm." + contractAddrBech32,
+		Sequence:   99,
+	}, failedAckBz, addr)
+	require.NoError(t, err)
+
+	queryRes, err = input.WasmKeeper.QuerySmart(ctx, contractAddr, []byte(`{"get":{}}`))
+	require.NoError(t, err)
+	require.Equal(t, "100", string(queryRes))
+
+	// the sender contract gets the packet data as it was sent
+	delivered := *input.DeliveredPackets
+	require.Len(t, delivered, 2)
+	require.Equal(t, jsonData, delivered[0].Data)
+	require.Equal(t, protoData, delivered[1].Data)
+
+	// not a wasm port; no callback
+	err = input.IBCHooksMiddleware.OnAcknowledgementPacket(ctx, channeltypes.Packet{
+		Data:       protoData,
+		SourcePort: "custom",
+		Sequence:   99,
+	}, failedAckBz, addr)
+	require.NoError(t, err)
+
+	queryRes, err = input.WasmKeeper.QuerySmart(ctx, contractAddr, []byte(`{"get":{}}`))
+	require.NoError(t, err)
+	require.Equal(t, "100", string(queryRes))
+}
+
+func Test_OnTimeoutPacket_WasmPort(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	_, _, addr := keyPubAddr()
+
+	code, err := os.ReadFile("./contracts/artifacts/counter-aarch64.wasm")
+	require.NoError(t, err)
+
+	wasmMsgServer := wasmkeeper.NewMsgServerImpl(&input.WasmKeeper)
+	storeRes, err := wasmMsgServer.StoreCode(ctx, &wasmtypes.MsgStoreCode{
+		Sender:       addr.String(),
+		WASMByteCode: code,
+	})
+	require.NoError(t, err)
+
+	instantiateRes, err := wasmMsgServer.InstantiateContract(ctx, &wasmtypes.MsgInstantiateContract{
+		Sender: addr.String(),
+		Admin:  addr.String(),
+		CodeID: storeRes.CodeID,
+		Label:  "Counter",
+		Msg:    []byte("{}"),
+		Funds:  nil,
+	})
+	require.NoError(t, err)
+
+	contractAddrBech32 := instantiateRes.Address
+	contractAddr, err := sdk.AccAddressFromBech32(contractAddrBech32)
+	require.NoError(t, err)
+
+	protoData, err := wasmhookstypes.WrapPacketData([]byte("custom data"), contractAddrBech32)
+	require.NoError(t, err)
+
+	// hook should not be called to due to acl
+	err = input.IBCHooksMiddleware.OnTimeoutPacket(ctx, channeltypes.Packet{
+		Data:       protoData,
+		SourcePort: "wasm." + contractAddrBech32,
+		Sequence:   99,
+	}, addr)
+	require.NoError(t, err)
+
+	queryRes, err := input.WasmKeeper.QuerySmart(ctx, contractAddr, []byte(`{"get":{}}`))
+	require.NoError(t, err)
+	require.Equal(t, "0", string(queryRes))
+
+	// set acl
+	require.NoError(t, input.IBCHooksKeeper.SetAllowed(ctx, contractAddr, true))
+
+	err = input.IBCHooksMiddleware.OnTimeoutPacket(ctx, channeltypes.Packet{
+		Data:       protoData,
+		SourcePort: "wasm." + contractAddrBech32,
+		Sequence:   99,
+	}, addr)
+	require.NoError(t, err)
+
+	queryRes, err = input.WasmKeeper.QuerySmart(ctx, contractAddr, []byte(`{"get":{}}`))
+	require.NoError(t, err)
+	require.Equal(t, "99", string(queryRes))
+
+	// the sender contract gets the packet data as it was sent
+	for _, packet := range *input.DeliveredPackets {
+		require.Equal(t, protoData, packet.Data)
+	}
+}
@@ -5,6 +5,9 @@ import (
 	"path/filepath"
 	"slices"
 
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/spf13/cast"
+
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/log"
 	storetypes "cosmossdk.io/store/types"
@@ -92,8 +95,16 @@ import (
 	"github.com/initia-labs/miniwasm/app/ante"
 	ibcwasmhooks "github.com/initia-labs/miniwasm/app/ibc-hooks"
 	bankkeeper "github.com/initia-labs/miniwasm/x/bank/keeper"
+	feeabskeeper "github.com/initia-labs/miniwasm/x/feeabs/keeper"
+	feeabstypes "github.com/initia-labs/miniwasm/x/feeabs/types"
+	lanepolicykeeper "github.com/initia-labs/miniwasm/x/lanepolicy/keeper"
+	lanepolicytypes "github.com/initia-labs/miniwasm/x/lanepolicy/types"
+	sendrestrictionkeeper "github.com/initia-labs/miniwasm/x/sendrestriction/keeper"
+	sendrestrictiontypes "github.com/initia-labs/miniwasm/x/sendrestriction/types"
 	tokenfactorykeeper "github.com/initia-labs/miniwasm/x/tokenfactory/keeper"
 	tokenfactorytypes "github.com/initia-labs/miniwasm/x/tokenfactory/types"
+	wasmhookskeeper "github.com/initia-labs/miniwasm/x/wasmhooks/keeper"
+	wasmhookstypes "github.com/initia-labs/miniwasm/x/wasmhooks/types"
 
 	// noble forwarding keeper
 	forwarding "github.com/noble-assets/forwarding/v2/x/forwarding"
@@ -132,7 +143,11 @@ type AppKeepers struct {
 	MarketMapKeeper       *marketmapkeeper.Keeper
 	TokenFactoryKeeper    *tokenfactorykeeper.Keeper
 	IBCHooksKeeper        *ibchookskeeper.Keeper
+	WasmHooksKeeper       *wasmhookskeeper.Keeper
 	ForwardingKeeper      *forwardingkeeper.Keeper
+	SendRestrictionKeeper *sendrestrictionkeeper.Keeper
+	FeeAbsKeeper          *feeabskeeper.Keeper
+	LanePolicyKeeper      *lanepolicykeeper.Keeper
 	RatelimitKeeper       *ratelimitkeeper.Keeper
 
 	// make scoped keepers public for test purposes
@@ -220,6 +235,14 @@ func NewAppKeeper(
 		logger,
 	)
 	appKeepers.BankKeeper = &bankKeeper
+	if cast.ToBool(appOpts.Get("telemetry.enabled")) {
+		metricsConfig, err := bankkeeper.NewMetricsConfig(appOpts)
+		if err != nil {
+			panic(err)
+		}
+
+		appKeepers.BankKeeper.SetMetrics(bankkeeper.NewMetrics(prometheus.DefaultRegisterer, bApp.ChainID(), metricsConfig.Denoms))
+	}
 
 	communityPoolKeeper := NewCommunityPoolKeeper(appKeepers.BankKeeper, authtypes.FeeCollectorName)
 
@@ -247,6 +270,21 @@ func NewAppKeeper(
 	// and propogated to the oracle keeper.
 	appKeepers.MarketMapKeeper.SetHooks(appKeepers.OracleKeeper.Hooks())
 
+	appKeepers.FeeAbsKeeper = feeabskeeper.NewKeeper(
+		ac,
+		appCodec,
+		runtime.NewKVStoreService(appKeepers.keys[feeabstypes.StoreKey]),
+		appKeepers.OracleKeeper,
+		authorityAddr,
+	)
+
+	appKeepers.LanePolicyKeeper = lanepolicykeeper.NewKeeper(
+		ac,
+		appCodec,
+		runtime.NewKVStoreService(appKeepers.keys[lanepolicytypes.StoreKey]),
+		authorityAddr,
+	)
+
 	appKeepers.OPChildKeeper = opchildkeeper.NewKeeper(
 		appCodec,
 		runtime.NewKVStoreService(appKeepers.keys[opchildtypes.StoreKey]),
@@ -338,6 +376,15 @@ func NewAppKeeper(
 		ac,
 	)
 
+	appKeepers.WasmHooksKeeper = wasmhookskeeper.NewKeeper(
+		ac,
+		appCodec,
+		runtime.NewKVStoreService(appKeepers.keys[wasmhookstypes.StoreKey]),
+		appKeepers.WasmKeeper,
+		appKeepers.IBCHooksKeeper,
+		authorityAddr,
+	)
+
 	appKeepers.ForwardingKeeper = forwardingkeeper.NewKeeper(
 		appCodec,
 		logger,
@@ -353,13 +400,29 @@ func NewAppKeeper(
 	)
 	appKeepers.BankKeeper.AppendSendRestriction(appKeepers.ForwardingKeeper.SendRestrictionFn)
 
+	// the account restrictions run after the forwarding restriction, so they
+	// check the final recipient of the transfer.
+	appKeepers.SendRestrictionKeeper = sendrestrictionkeeper.NewKeeper(
+		ac,
+		appCodec,
+		runtime.NewKVStoreService(appKeepers.keys[sendrestrictiontypes.StoreKey]),
+		appKeepers.AccountKeeper,
+		authorityAddr,
+	)
+	appKeepers.SendRestrictionKeeper.AppendExemption(newTransferEscrowExemption(appKeepers.IBCKeeper.ChannelKeeper))
+	appKeepers.BankKeeper.AppendSendRestriction(appKeepers.SendRestrictionKeeper.SendRestrictionFn)
+
 	////////////////////////////
 	// Transfer configuration //
 	////////////////////////////
 	// Send   : transfer -> packet forward -> rate limit -> fee        -> channel
 	// Receive: channel  -> fee            -> wasm       -> rate limit -> packet forward -> forwarding -> transfer
 
+	// wasm hooks are shared by the transfer and wasm ibc stacks
+	wasmHooks := ibcwasmhooks.NewWasmHooks(appCodec, ac, appKeepers.WasmKeeper, appKeepers.WasmHooksKeeper)
+
 	var transferStack porttypes.IBCModule
+	var transferHookMiddleware ibchooks.IBCMiddleware
 	{
 		packetForwardKeeper := &packetforwardkeeper.Keeper{}
 		rateLimitKeeper := &ratelimitkeeper.Keeper{}
@@ -432,15 +495,16 @@ func NewAppKeeper(
 		)
 
 		// create wasm middleware for transfer
-		transferStack = ibchooks.NewIBCMiddleware(
+		transferHookMiddleware = ibchooks.NewIBCMiddleware(
 			// receive: wasm -> rate limit -> packet forward -> forwarding -> transfer
 			transferStack,
 			ibchooks.NewICS4Middleware(
 				nil, /* ics4wrapper: not used */
-				ibcwasmhooks.NewWasmHooks(appCodec, ac, appKeepers.WasmKeeper),
+				wasmHooks,
 			),
 			appKeepers.IBCHooksKeeper,
 		)
+		transferStack = transferHookMiddleware
 
 		// create ibcfee middleware for transfer
 		transferStack = ibcfee.NewIBCMiddleware(
@@ -518,11 +582,21 @@ func NewAppKeeper(
 			wasmIBCModule,
 			ibchooks.NewICS4Middleware(
 				nil, /* ics4wrapper: not used */
-				ibcwasmhooks.NewWasmHooks(appCodec, ac, appKeepers.WasmKeeper),
+				wasmHooks,
 			),
 			appKeepers.IBCHooksKeeper,
 		)
 
+		// allow the SimulateIBCHook query to run the receive path of both hook middlewares
+		appKeepers.WasmHooksKeeper.SetPacketSimulator(
+			ibcwasmhooks.NewPacketSimulator(wasmHooks, transferHookMiddleware, hookMiddleware),
+		)
+
+		// cap the gas of the SimulateIBCHook query with the wasm simulation gas limit
+		if wasmConfig.SimulationGasLimit != nil && *wasmConfig.SimulationGasLimit != 0 {
+			appKeepers.WasmHooksKeeper.SetSimulationGasLimit(*wasmConfig.SimulationGasLimit)
+		}
+
 		wasmIBCStack = ibcfee.NewIBCMiddleware(
 			// receive: fee -> hook -> wasm
 			hookMiddleware,
@@ -612,8 +686,14 @@ func NewAppKeeper(
 	)
 	appKeepers.TokenFactoryKeeper = &tokenfactoryKeeper
 	appKeepers.TokenFactoryKeeper.SetContractKeeper(contractKeeper)
+	if bankMetrics := appKeepers.BankKeeper.Metrics(); bankMetrics != nil {
+		appKeepers.TokenFactoryKeeper.SetHookMetrics(bankMetrics)
+	}
 
-	appKeepers.BankKeeper.SetHooks(appKeepers.TokenFactoryKeeper.Hooks())
+	// register the bank hooks; the listeners are called in the given order
+	appKeepers.BankKeeper.SetHooks(bankkeeper.NewMultiBankHooks(
+		appKeepers.TokenFactoryKeeper.Hooks(),
+	))
 
 	return appKeepers
 }
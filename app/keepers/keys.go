@@ -8,6 +8,7 @@ import (
 
 	// cosmos imports
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	consensusparamtypes "github.com/cosmos/cosmos-sdk/x/consensus/types"
@@ -20,6 +21,7 @@ import (
 	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
 	icacontrollertypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller/types"
 	icahosttypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
 	ibcfeetypes "github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
 	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
@@ -40,7 +42,11 @@ import (
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 
 	// local imports
+	feeabstypes "github.com/initia-labs/miniwasm/x/feeabs/types"
+	lanepolicytypes "github.com/initia-labs/miniwasm/x/lanepolicy/types"
+	sendrestrictiontypes "github.com/initia-labs/miniwasm/x/sendrestriction/types"
 	tokenfactorytypes "github.com/initia-labs/miniwasm/x/tokenfactory/types"
+	wasmhookstypes "github.com/initia-labs/miniwasm/x/wasmhooks/types"
 
 	// noble forwarding keeper
 	forwardingtypes "github.com/noble-assets/forwarding/v2/x/forwarding/types"
@@ -57,7 +63,8 @@ func (appKeepers *AppKeepers) GenerateKeys() {
 		ibcfeetypes.StoreKey, wasmtypes.StoreKey, opchildtypes.StoreKey,
 		auctiontypes.StoreKey, packetforwardtypes.StoreKey, oracletypes.StoreKey,
 		tokenfactorytypes.StoreKey, ibchookstypes.StoreKey, forwardingtypes.StoreKey,
-		marketmaptypes.StoreKey, ratelimittypes.StoreKey,
+		marketmaptypes.StoreKey, ratelimittypes.StoreKey, wasmhookstypes.StoreKey,
+		sendrestrictiontypes.StoreKey, feeabstypes.StoreKey, lanepolicytypes.StoreKey,
 	)
 
 	// Define transient store keys
@@ -67,6 +74,41 @@ func (appKeepers *AppKeepers) GenerateKeys() {
 	appKeepers.memKeys = storetypes.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
 }
 
+// ModuleStoreKeys are the kv store keys of the modules by the module names,
+// which are taken from the types of each module, as the store key of a module
+// is not always its name, e.g. auth uses "acc", and a module can have more than
+// one store. Every kv store key generated above must belong to a module.
+var ModuleStoreKeys = map[string][]string{
+	authtypes.ModuleName:            {authtypes.StoreKey},
+	banktypes.ModuleName:            {banktypes.StoreKey},
+	group.ModuleName:                {group.StoreKey},
+	consensusparamtypes.ModuleName:  {consensusparamtypes.StoreKey},
+	crisistypes.ModuleName:          {crisistypes.StoreKey},
+	ibcexported.ModuleName:          {ibcexported.StoreKey},
+	upgradetypes.ModuleName:         {upgradetypes.StoreKey},
+	ibctransfertypes.ModuleName:     {ibctransfertypes.StoreKey},
+	capabilitytypes.ModuleName:      {capabilitytypes.StoreKey},
+	authz.ModuleName:                {authzkeeper.StoreKey},
+	feegrant.ModuleName:             {feegrant.StoreKey},
+	icatypes.ModuleName:             {icahosttypes.StoreKey, icacontrollertypes.StoreKey},
+	icaauthtypes.ModuleName:         {icaauthtypes.StoreKey},
+	ibcfeetypes.ModuleName:          {ibcfeetypes.StoreKey},
+	wasmtypes.ModuleName:            {wasmtypes.StoreKey},
+	opchildtypes.ModuleName:         {opchildtypes.StoreKey},
+	auctiontypes.ModuleName:         {auctiontypes.StoreKey},
+	packetforwardtypes.ModuleName:   {packetforwardtypes.StoreKey},
+	oracletypes.ModuleName:          {oracletypes.StoreKey},
+	tokenfactorytypes.ModuleName:    {tokenfactorytypes.StoreKey},
+	ibchookstypes.ModuleName:        {ibchookstypes.StoreKey},
+	forwardingtypes.ModuleName:      {forwardingtypes.StoreKey},
+	marketmaptypes.ModuleName:       {marketmaptypes.StoreKey},
+	ratelimittypes.ModuleName:       {ratelimittypes.StoreKey},
+	wasmhookstypes.ModuleName:       {wasmhookstypes.StoreKey},
+	sendrestrictiontypes.ModuleName: {sendrestrictiontypes.StoreKey},
+	feeabstypes.ModuleName:          {feeabstypes.StoreKey},
+	lanepolicytypes.ModuleName:      {lanepolicytypes.StoreKey},
+}
+
 func (appKeepers *AppKeepers) GetKVStoreKey() map[string]*storetypes.KVStoreKey {
 	return appKeepers.keys
 }
@@ -0,0 +1,36 @@
+package keepers
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	sendrestrictionkeeper "github.com/initia-labs/miniwasm/x/sendrestriction/keeper"
+)
+
+type channelKeeperForSendRestriction interface {
+	GetAllChannelsWithPortPrefix(ctx sdk.Context, portPrefix string) []channeltypes.IdentifiedChannel
+}
+
+// newTransferEscrowExemption returns the send restriction exemption of the ics20
+// escrow addresses, which are not module accounts but hold the coins sent out of
+// the chain. The channels are only iterated for the restricted addresses.
+func newTransferEscrowExemption(channelKeeper channelKeeperForSendRestriction) sendrestrictionkeeper.ExemptionFn {
+	return func(ctx context.Context, addr sdk.AccAddress) (bool, error) {
+		channels := channelKeeper.GetAllChannelsWithPortPrefix(sdk.UnwrapSDKContext(ctx), ibctransfertypes.PortID)
+		for _, channel := range channels {
+			if channel.PortId != ibctransfertypes.PortID {
+				continue
+			}
+
+			if addr.Equals(ibctransfertypes.GetEscrowAddress(channel.PortId, channel.ChannelId)) {
+				return true, nil
+			}
+		}
+
+		return false, nil
+	}
+}
@@ -0,0 +1,220 @@
+package lanes
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cast"
+)
+
+const (
+	flagLanesPrefix = "lanes"
+
+	flagEnable        = "enable"
+	flagMaxBlockSpace = "max-block-space"
+	flagMaxTxs        = "max-txs"
+)
+
+// LanesConfig defines the block-sdk lane layout of the mempool.
+//
+// The lane layout is checked in ProcessProposal, so all the validators must
+// use the same layout.
+type LanesConfig struct {
+	System  LaneConfig `mapstructure:"system"`
+	Relayer LaneConfig `mapstructure:"relayer"`
+	MEV     LaneConfig `mapstructure:"mev"`
+	Free    LaneConfig `mapstructure:"free"`
+	Default LaneConfig `mapstructure:"default"`
+}
+
+// LaneConfig defines the size of a lane.
+type LaneConfig struct {
+	// Enable defines whether the lane is enabled. The system and the default
+	// lanes are always enabled.
+	Enable bool `mapstructure:"enable"`
+
+	// MaxBlockSpace defines the ratio of the block space the lane can use.
+	MaxBlockSpace math.LegacyDec `mapstructure:"max-block-space"`
+
+	// MaxTxs defines the max number of txs the lane can hold in the mempool;
+	// zero means no limit. The default lane uses the mempool max txs.
+	MaxTxs int `mapstructure:"max-txs"`
+}
+
+// DefaultConfig returns the default lane layout.
+func DefaultConfig() LanesConfig {
+	return LanesConfig{
+		System: LaneConfig{
+			Enable:        true,
+			MaxBlockSpace: math.LegacyMustNewDecFromStr("0.01"),
+			MaxTxs:        1,
+		},
+		Relayer: LaneConfig{
+			Enable:        true,
+			MaxBlockSpace: math.LegacyMustNewDecFromStr("0.1"),
+			MaxTxs:        100,
+		},
+		MEV: LaneConfig{
+			Enable:        true,
+			MaxBlockSpace: math.LegacyMustNewDecFromStr("0.09"),
+			MaxTxs:        100,
+		},
+		Free: LaneConfig{
+			Enable:        true,
+			MaxBlockSpace: math.LegacyMustNewDecFromStr("0.1"),
+			MaxTxs:        100,
+		},
+		Default: LaneConfig{
+			Enable:        true,
+			MaxBlockSpace: math.LegacyMustNewDecFromStr("0.7"),
+		},
+	}
+}
+
+// NewConfig reads the lane layout from the app options. The missing options
+// fall back to the default layout.
+func NewConfig(appOpts servertypes.AppOptions) (LanesConfig, error) {
+	cfg := DefaultConfig()
+
+	var err error
+	if cfg.System, err = readLaneConfig(appOpts, "system", cfg.System); err != nil {
+		return LanesConfig{}, err
+	}
+	if cfg.Relayer, err = readLaneConfig(appOpts, "relayer", cfg.Relayer); err != nil {
+		return LanesConfig{}, err
+	}
+	if cfg.MEV, err = readLaneConfig(appOpts, "mev", cfg.MEV); err != nil {
+		return LanesConfig{}, err
+	}
+	if cfg.Free, err = readLaneConfig(appOpts, "free", cfg.Free); err != nil {
+		return LanesConfig{}, err
+	}
+	if cfg.Default, err = readLaneConfig(appOpts, "default", cfg.Default); err != nil {
+		return LanesConfig{}, err
+	}
+
+	// the system and the default lanes can't be disabled
+	cfg.System.Enable = true
+	cfg.Default.Enable = true
+
+	return cfg, cfg.Validate()
+}
+
+func readLaneConfig(appOpts servertypes.AppOptions, lane string, cfg LaneConfig) (LaneConfig, error) {
+	key := func(flag string) string {
+		return fmt.Sprintf("%s.%s.%s", flagLanesPrefix, lane, flag)
+	}
+
+	if v := appOpts.Get(key(flagEnable)); v != nil {
+		enable, err := cast.ToBoolE(v)
+		if err != nil {
+			return LaneConfig{}, fmt.Errorf("invalid %s: %w", key(flagEnable), err)
+		}
+		cfg.Enable = enable
+	}
+
+	if v := appOpts.Get(key(flagMaxBlockSpace)); v != nil {
+		maxBlockSpace, err := math.LegacyNewDecFromStr(cast.ToString(v))
+		if err != nil {
+			return LaneConfig{}, fmt.Errorf("invalid %s: %w", key(flagMaxBlockSpace), err)
+		}
+		cfg.MaxBlockSpace = maxBlockSpace
+	}
+
+	if v := appOpts.Get(key(flagMaxTxs)); v != nil {
+		maxTxs, err := cast.ToIntE(v)
+		if err != nil {
+			return LaneConfig{}, fmt.Errorf("invalid %s: %w", key(flagMaxTxs), err)
+		}
+		cfg.MaxTxs = maxTxs
+	}
+
+	return cfg, nil
+}
+
+// Validate checks the lane sizes and that the block space ratios of the
+// enabled lanes sum to at most 1.
+func (c LanesConfig) Validate() error {
+	total := math.LegacyZeroDec()
+	for _, lane := range []struct {
+		name string
+		cfg  LaneConfig
+	}{
+		{"system", c.System},
+		{"relayer", c.Relayer},
+		{"mev", c.MEV},
+		{"free", c.Free},
+		{"default", c.Default},
+	} {
+		if err := lane.cfg.Validate(); err != nil {
+			return fmt.Errorf("invalid %s lane config: %w", lane.name, err)
+		}
+
+		if lane.cfg.Enable {
+			total = total.Add(lane.cfg.MaxBlockSpace)
+		}
+	}
+
+	if total.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("sum of the max block space of the lanes must be at most 1: %s", total)
+	}
+
+	return nil
+}
+
+// Validate checks the lane size.
+func (c LaneConfig) Validate() error {
+	if c.MaxBlockSpace.IsNil() || c.MaxBlockSpace.IsNegative() || c.MaxBlockSpace.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("max block space must be in [0, 1]: %s", c.MaxBlockSpace)
+	}
+
+	if c.MaxTxs < 0 {
+		return fmt.Errorf("max txs must not be negative: %d", c.MaxTxs)
+	}
+
+	return nil
+}
+
+const DefaultConfigTemplate = `
+###############################################################################
+###                              Lanes                                      ###
+###############################################################################
+
+# The lanes define the block-sdk lane layout of the mempool. The layout is
+# checked when processing the proposals, so all the validators must use the
+# same layout. The sum of the max block space of the enabled lanes must be at
+# most 1.
+#
+# max-block-space is the ratio of the block space the lane can use, and
+# max-txs is the max number of txs the lane can hold in the mempool (0 means
+# no limit). The system and the default lanes can't be disabled, and the
+# default lane uses the mempool max-txs.
+#
+# The relayer lane holds the ibc relay txs of the relayers registered in the
+# lanepolicy module. The auction bid txs are rejected if the mev lane is
+# disabled.
+
+[lanes.system]
+max-block-space = "{{ .LanesConfig.System.MaxBlockSpace }}"
+max-txs = {{ .LanesConfig.System.MaxTxs }}
+
+[lanes.relayer]
+enable = {{ .LanesConfig.Relayer.Enable }}
+max-block-space = "{{ .LanesConfig.Relayer.MaxBlockSpace }}"
+max-txs = {{ .LanesConfig.Relayer.MaxTxs }}
+
+[lanes.mev]
+enable = {{ .LanesConfig.MEV.Enable }}
+max-block-space = "{{ .LanesConfig.MEV.MaxBlockSpace }}"
+max-txs = {{ .LanesConfig.MEV.MaxTxs }}
+
+[lanes.free]
+enable = {{ .LanesConfig.Free.Enable }}
+max-block-space = "{{ .LanesConfig.Free.MaxBlockSpace }}"
+max-txs = {{ .LanesConfig.Free.MaxTxs }}
+
+[lanes.default]
+max-block-space = "{{ .LanesConfig.Default.MaxBlockSpace }}"
+`
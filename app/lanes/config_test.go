@@ -0,0 +1,64 @@
+package lanes_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	"github.com/initia-labs/miniwasm/app/lanes"
+)
+
+type mapAppOptions map[string]any
+
+func (m mapAppOptions) Get(key string) any {
+	return m[key]
+}
+
+func Test_NewConfig(t *testing.T) {
+	// missing options fall back to the defaults
+	cfg, err := lanes.NewConfig(mapAppOptions{})
+	require.NoError(t, err)
+	require.Equal(t, lanes.DefaultConfig(), cfg)
+
+	cfg, err = lanes.NewConfig(mapAppOptions{
+		"lanes.mev.enable":              false,
+		"lanes.free.max-block-space":    "0.3",
+		"lanes.free.max-txs":            "500",
+		"lanes.default.max-block-space": "0.59",
+		"lanes.default.enable":          false,
+	})
+	require.NoError(t, err)
+	require.False(t, cfg.MEV.Enable)
+	require.Equal(t, math.LegacyMustNewDecFromStr("0.3"), cfg.Free.MaxBlockSpace)
+	require.Equal(t, 500, cfg.Free.MaxTxs)
+	require.Equal(t, math.LegacyMustNewDecFromStr("0.59"), cfg.Default.MaxBlockSpace)
+
+	// the default lane can't be disabled
+	require.True(t, cfg.Default.Enable)
+
+	// invalid values
+	_, err = lanes.NewConfig(mapAppOptions{"lanes.free.max-block-space": "abc"})
+	require.Error(t, err)
+	_, err = lanes.NewConfig(mapAppOptions{"lanes.free.max-txs": -1})
+	require.Error(t, err)
+}
+
+func Test_Validate(t *testing.T) {
+	cfg := lanes.DefaultConfig()
+	require.NoError(t, cfg.Validate())
+
+	// the ratios sum to more than 1
+	cfg.Free.MaxBlockSpace = math.LegacyMustNewDecFromStr("0.15")
+	require.Error(t, cfg.Validate())
+
+	// the disabled lanes are not counted
+	cfg.MEV.Enable = false
+	require.NoError(t, cfg.Validate())
+
+	// ratio out of range
+	cfg = lanes.DefaultConfig()
+	cfg.MEV.MaxBlockSpace = math.LegacyNewDec(-1)
+	require.Error(t, cfg.Validate())
+}
@@ -0,0 +1,30 @@
+package lanes
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	blockbase "github.com/skip-mev/block-sdk/v2/block/base"
+)
+
+// FreeContractKeeper defines the expected keeper which knows the free
+// contract executions.
+type FreeContractKeeper interface {
+	IsFreeContractTx(ctx context.Context, tx sdk.Tx) (bool, error)
+}
+
+// FreeLaneMatchHandler returns the match handler for the free lane. It extends
+// the given match handler, e.g. the opchild fee whitelist, with the executions
+// of the free contracts. The match doesn't read the per account rate limit, so
+// it is stateless; the rate limit is enforced by the ante handler.
+func FreeLaneMatchHandler(matchFn blockbase.MatchHandler, fk FreeContractKeeper) blockbase.MatchHandler {
+	return func(ctx sdk.Context, tx sdk.Tx) bool {
+		if matchFn(ctx, tx) {
+			return true
+		}
+
+		isFree, err := fk.IsFreeContractTx(ctx, tx)
+		return err == nil && isFree
+	}
+}
@@ -0,0 +1,30 @@
+package lanes_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/miniwasm/app/lanes"
+)
+
+type mockFreeContractKeeper struct {
+	free bool
+}
+
+func (k mockFreeContractKeeper) IsFreeContractTx(context.Context, sdk.Tx) (bool, error) {
+	return k.free, nil
+}
+
+func Test_FreeLaneMatchHandler(t *testing.T) {
+	ctx := sdk.Context{}
+	matchAll := func(sdk.Context, sdk.Tx) bool { return true }
+	matchNone := func(sdk.Context, sdk.Tx) bool { return false }
+
+	require.True(t, lanes.FreeLaneMatchHandler(matchAll, mockFreeContractKeeper{})(ctx, mockTx{}))
+	require.True(t, lanes.FreeLaneMatchHandler(matchNone, mockFreeContractKeeper{free: true})(ctx, mockTx{}))
+	require.False(t, lanes.FreeLaneMatchHandler(matchNone, mockFreeContractKeeper{})(ctx, mockTx{}))
+}
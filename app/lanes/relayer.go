@@ -0,0 +1,82 @@
+package lanes
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	initialanes "github.com/initia-labs/initia/app/lanes"
+
+	"github.com/skip-mev/block-sdk/v2/block"
+	blockbase "github.com/skip-mev/block-sdk/v2/block/base"
+)
+
+const (
+	// RelayerLaneName defines the name of the relayer lane.
+	RelayerLaneName = "relayer"
+)
+
+// RelayerKeeper defines the expected keeper which knows the registered
+// relayers.
+type RelayerKeeper interface {
+	IsRelayer(ctx context.Context, addr []byte) (bool, error)
+}
+
+// RelayerLaneMatchHandler returns the match handler for the relayer lane. It
+// matches the txs of the registered relayers which only have ibc core relay
+// messages, so the packet relays, e.g. the bridge deposits and the hooks,
+// don't compete with the user txs in the default lane.
+func RelayerLaneMatchHandler(rk RelayerKeeper) blockbase.MatchHandler {
+	return func(ctx sdk.Context, tx sdk.Tx) bool {
+		if len(tx.GetMsgs()) == 0 {
+			return false
+		}
+
+		for _, msg := range tx.GetMsgs() {
+			switch msg.(type) {
+			case *clienttypes.MsgUpdateClient:
+			case *channeltypes.MsgRecvPacket:
+			case *channeltypes.MsgAcknowledgement:
+			case *channeltypes.MsgTimeout:
+			case *channeltypes.MsgTimeoutOnClose:
+			default:
+				return false
+			}
+		}
+
+		feeTx, ok := tx.(sdk.FeeTx)
+		if !ok {
+			return false
+		}
+
+		isRelayer, err := rk.IsRelayer(ctx, feeTx.FeePayer())
+		return err == nil && isRelayer
+	}
+}
+
+// NewRelayerLane returns a new relayer lane.
+func NewRelayerLane(
+	cfg blockbase.LaneConfig,
+	matchFn blockbase.MatchHandler,
+) block.Lane {
+	lane := &blockbase.BaseLane{}
+	proposalHandler := initialanes.NewDefaultProposalHandler(lane)
+
+	_lane, err := blockbase.NewBaseLane(
+		cfg,
+		RelayerLaneName,
+		blockbase.WithMatchHandler(matchFn),
+		blockbase.WithMempool(initialanes.NewMempool(blockbase.NewDefaultTxPriority(), cfg.SignerExtractor, cfg.MaxTxs)),
+		blockbase.WithPrepareLaneHandler(proposalHandler.PrepareLaneHandler()),
+		blockbase.WithProcessLaneHandler(proposalHandler.ProcessLaneHandler()),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	*lane = *_lane
+	return lane
+}
@@ -0,0 +1,63 @@
+package lanes_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	"github.com/initia-labs/miniwasm/app/lanes"
+)
+
+var (
+	relayerAddr = sdk.AccAddress([]byte("relayer_____________"))
+	userAddr    = sdk.AccAddress([]byte("user________________"))
+)
+
+type mockTx struct {
+	sdk.FeeTx
+
+	msgs  []sdk.Msg
+	payer sdk.AccAddress
+}
+
+func (tx mockTx) GetMsgs() []sdk.Msg { return tx.msgs }
+func (tx mockTx) FeePayer() []byte   { return tx.payer }
+
+type mockRelayerKeeper struct{}
+
+func (mockRelayerKeeper) IsRelayer(_ context.Context, addr []byte) (bool, error) {
+	return bytes.Equal(addr, relayerAddr), nil
+}
+
+func Test_RelayerLaneMatchHandler(t *testing.T) {
+	match := lanes.RelayerLaneMatchHandler(mockRelayerKeeper{})
+	ctx := sdk.Context{}
+
+	relayMsgs := []sdk.Msg{
+		&clienttypes.MsgUpdateClient{},
+		&channeltypes.MsgRecvPacket{},
+		&channeltypes.MsgAcknowledgement{},
+		&channeltypes.MsgTimeout{},
+		&channeltypes.MsgTimeoutOnClose{},
+	}
+
+	// relay txs of a registered relayer
+	require.True(t, match(ctx, mockTx{msgs: relayMsgs, payer: relayerAddr}))
+
+	// relay txs of an unregistered relayer
+	require.False(t, match(ctx, mockTx{msgs: relayMsgs, payer: userAddr}))
+
+	// non relay msgs
+	require.False(t, match(ctx, mockTx{msgs: append(relayMsgs, &banktypes.MsgSend{}), payer: relayerAddr}))
+
+	// empty tx
+	require.False(t, match(ctx, mockTx{payer: relayerAddr}))
+}
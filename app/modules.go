@@ -61,8 +61,16 @@ import (
 
 	// local imports
 	"github.com/initia-labs/miniwasm/x/bank"
+	"github.com/initia-labs/miniwasm/x/feeabs"
+	feeabstypes "github.com/initia-labs/miniwasm/x/feeabs/types"
+	"github.com/initia-labs/miniwasm/x/lanepolicy"
+	lanepolicytypes "github.com/initia-labs/miniwasm/x/lanepolicy/types"
+	"github.com/initia-labs/miniwasm/x/sendrestriction"
+	sendrestrictiontypes "github.com/initia-labs/miniwasm/x/sendrestriction/types"
 	"github.com/initia-labs/miniwasm/x/tokenfactory"
 	tokenfactorytypes "github.com/initia-labs/miniwasm/x/tokenfactory/types"
+	"github.com/initia-labs/miniwasm/x/wasmhooks"
+	wasmhookstypes "github.com/initia-labs/miniwasm/x/wasmhooks/types"
 
 	// noble forwarding keeper
 	forwarding "github.com/noble-assets/forwarding/v2/x/forwarding"
@@ -116,7 +124,11 @@ func appModules(
 		solomachine.NewAppModule(),
 		packetforward.NewAppModule(app.PacketForwardKeeper, nil),
 		ibchooks.NewAppModule(app.appCodec, *app.IBCHooksKeeper),
+		wasmhooks.NewAppModule(app.appCodec, app.WasmHooksKeeper),
 		forwarding.NewAppModule(app.ForwardingKeeper),
+		sendrestriction.NewAppModule(app.appCodec, app.SendRestrictionKeeper),
+		feeabs.NewAppModule(app.appCodec, app.FeeAbsKeeper),
+		lanepolicy.NewAppModule(app.appCodec, app.LanePolicyKeeper),
 		// connect modules
 		oracle.NewAppModule(app.appCodec, *app.OracleKeeper),
 		marketmap.NewAppModule(app.appCodec, app.MarketMapKeeper),
@@ -176,6 +188,7 @@ func orderEndBlockers() []string {
 		oracletypes.ModuleName,
 		marketmaptypes.ModuleName,
 		forwardingtypes.ModuleName,
+		wasmhookstypes.ModuleName,
 	}
 }
 
@@ -196,6 +209,7 @@ func orderInitBlockers() []string {
 		icaauthtypes.ModuleName, ibcfeetypes.ModuleName, auctiontypes.ModuleName,
 		wasmtypes.ModuleName, oracletypes.ModuleName, marketmaptypes.ModuleName,
 		packetforwardtypes.ModuleName, tokenfactorytypes.ModuleName,
-		ibchookstypes.ModuleName, forwardingtypes.ModuleName,
+		ibchookstypes.ModuleName, wasmhookstypes.ModuleName, forwardingtypes.ModuleName,
+		sendrestrictiontypes.ModuleName, feeabstypes.ModuleName, lanepolicytypes.ModuleName,
 	}
 }
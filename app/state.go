@@ -0,0 +1,240 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	"cosmossdk.io/store/iavl"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/initia-labs/miniwasm/app/keepers"
+)
+
+// ModuleStoreKeys returns the kv store keys of the module.
+func (app *MinitiaApp) ModuleStoreKeys(moduleName string) ([]*storetypes.KVStoreKey, error) {
+	storeKeys, ok := keepers.ModuleStoreKeys[moduleName]
+	if !ok {
+		return nil, fmt.Errorf("module %s has no kv store", moduleName)
+	}
+
+	keys := make([]*storetypes.KVStoreKey, 0, len(storeKeys))
+	for _, storeKey := range storeKeys {
+		key := app.GetKey(storeKey)
+		if key == nil {
+			return nil, fmt.Errorf("store %s of module %s not found", storeKey, moduleName)
+		}
+
+		keys = append(keys, key)
+	}
+
+	return keys, nil
+}
+
+// ExportModuleState exports the genesis state of the module at the height. The
+// latest height is used if the height is 0.
+func (app *MinitiaApp) ExportModuleState(moduleName string, height int64) (json.RawMessage, error) {
+	if _, ok := app.ModuleManager.Modules[moduleName]; !ok {
+		return nil, fmt.Errorf("module %s not found", moduleName)
+	}
+
+	ctx, err := app.CreateQueryContext(height, false)
+	if err != nil {
+		return nil, err
+	}
+
+	genState, err := app.ModuleManager.ExportGenesisForModules(ctx, app.appCodec, []string{moduleName})
+	if err != nil {
+		return nil, err
+	}
+
+	moduleState, ok := genState[moduleName]
+	if !ok {
+		return nil, fmt.Errorf("module %s has no genesis state", moduleName)
+	}
+
+	return moduleState, nil
+}
+
+// ImportModuleState rewrites the module stores at the latest version with the
+// genesis state, without creating a new version. The other stores are kept
+// as they are, and the commit info of the latest version is rebuilt with the
+// new module store hashes.
+//
+// The writes to the other stores made by the module's InitGenesis are
+// discarded, and the names of those stores are returned.
+//
+// NOTE: It must be run against the multistore of a stopped node. The app hash
+// of the latest height changes, so the app hash of the CometBFT state must be
+// updated to the returned one before the node is started again.
+func (app *MinitiaApp) ImportModuleState(moduleName string, moduleState json.RawMessage) (storetypes.CommitID, []string, error) {
+	mod, ok := app.ModuleManager.Modules[moduleName]
+	if !ok {
+		return storetypes.CommitID{}, nil, fmt.Errorf("module %s not found", moduleName)
+	}
+
+	keys, err := app.ModuleStoreKeys(moduleName)
+	if err != nil {
+		return storetypes.CommitID{}, nil, err
+	}
+
+	rms, ok := app.CommitMultiStore().(*rootmulti.Store)
+	if !ok {
+		return storetypes.CommitID{}, nil, errors.New("unexpected multistore type")
+	}
+
+	height := rms.LatestVersion()
+	if height <= 1 {
+		return storetypes.CommitID{}, nil, fmt.Errorf("latest height must be greater than 1; got %d", height)
+	}
+
+	commitInfo, err := rms.GetCommitInfo(height)
+	if err != nil {
+		return storetypes.CommitID{}, nil, err
+	}
+
+	// run the module's InitGenesis on the cleared module stores in the cache
+	cacheMS := newWriteTrackingMultiStore(rms.CacheMultiStore(), keys)
+	for _, key := range keys {
+		clearStore(cacheMS.GetKVStore(key))
+	}
+
+	ctx := sdk.NewContext(cacheMS, cmtproto.Header{
+		ChainID: app.ChainID(),
+		Height:  height,
+		Time:    commitInfo.Timestamp,
+	}, false, app.Logger())
+	if err := initModuleGenesis(ctx, app, mod, moduleState); err != nil {
+		return storetypes.CommitID{}, nil, errors.Wrapf(err, "failed to init genesis of %s", moduleName)
+	}
+
+	// rewrite the latest version of the module stores
+	for _, key := range keys {
+		pairs := storePairs(cacheMS.GetKVStore(key))
+
+		store, ok := rms.GetCommitKVStore(key).(*iavl.Store)
+		if !ok {
+			return storetypes.CommitID{}, nil, fmt.Errorf("store %s of module %s is not an iavl store", key.Name(), moduleName)
+		}
+		if err := store.LoadVersionForOverwriting(height - 1); err != nil {
+			return storetypes.CommitID{}, nil, errors.Wrapf(err, "failed to load version %d of store %s; the version may be pruned", height-1, key.Name())
+		}
+		clearStore(store)
+		for _, pair := range pairs {
+			store.Set(pair.Key, pair.Value)
+		}
+		if commitID := store.Commit(); commitID.Version != height {
+			return storetypes.CommitID{}, nil, fmt.Errorf("store %s committed at unexpected version %d", key.Name(), commitID.Version)
+		}
+	}
+
+	// rebuild the commit info of the latest version with the new store hashes
+	if err := rms.RollbackToVersion(height); err != nil {
+		return storetypes.CommitID{}, nil, err
+	}
+
+	return rms.LastCommitID(), cacheMS.discardedStores(), nil
+}
+
+func initModuleGenesis(ctx sdk.Context, app *MinitiaApp, mod interface{}, moduleState json.RawMessage) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+
+	switch mod := mod.(type) {
+	case module.HasGenesis:
+		mod.InitGenesis(ctx, app.appCodec, moduleState)
+	case module.HasABCIGenesis:
+		mod.InitGenesis(ctx, app.appCodec, moduleState)
+	default:
+		return errors.New("module has no genesis")
+	}
+
+	return nil
+}
+
+func storePairs(store storetypes.KVStore) []kv.Pair {
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	var pairs []kv.Pair
+	for ; iter.Valid(); iter.Next() {
+		pairs = append(pairs, kv.Pair{Key: iter.Key(), Value: iter.Value()})
+	}
+
+	return pairs
+}
+
+func clearStore(store storetypes.KVStore) {
+	for _, pair := range storePairs(store) {
+		store.Delete(pair.Key)
+	}
+}
+
+// writeTrackingMultiStore records the stores other than the module stores
+// written through it.
+type writeTrackingMultiStore struct {
+	storetypes.MultiStore
+
+	moduleKeys map[storetypes.StoreKey]bool
+	written    map[string]bool
+}
+
+func newWriteTrackingMultiStore(ms storetypes.MultiStore, moduleKeys []*storetypes.KVStoreKey) *writeTrackingMultiStore {
+	keys := make(map[storetypes.StoreKey]bool, len(moduleKeys))
+	for _, key := range moduleKeys {
+		keys[key] = true
+	}
+
+	return &writeTrackingMultiStore{
+		MultiStore: ms,
+		moduleKeys: keys,
+		written:    make(map[string]bool),
+	}
+}
+
+func (ms *writeTrackingMultiStore) GetKVStore(key storetypes.StoreKey) storetypes.KVStore {
+	store := ms.MultiStore.GetKVStore(key)
+	if ms.moduleKeys[key] {
+		return store
+	}
+
+	return writeTrackingStore{KVStore: store, onWrite: func() { ms.written[key.Name()] = true }}
+}
+
+func (ms *writeTrackingMultiStore) discardedStores() []string {
+	names := make([]string, 0, len(ms.written))
+	for name := range ms.written {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+	return names
+}
+
+type writeTrackingStore struct {
+	storetypes.KVStore
+
+	onWrite func()
+}
+
+func (s writeTrackingStore) Set(key, value []byte) {
+	s.onWrite()
+	s.KVStore.Set(key, value)
+}
+
+func (s writeTrackingStore) Delete(key []byte) {
+	s.onWrite()
+	s.KVStore.Delete(key)
+}
@@ -0,0 +1,116 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/initia-labs/miniwasm/app/keepers"
+
+	tokenfactorytypes "github.com/initia-labs/miniwasm/x/tokenfactory/types"
+)
+
+func TestExportImportModuleState(t *testing.T) {
+	app := SetupWithGenesisAccounts(t.TempDir(), nil, nil)
+
+	_, err := app.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 2})
+	require.NoError(t, err)
+	_, err = app.Commit()
+	require.NoError(t, err)
+
+	lastCommitID := app.CommitMultiStore().LastCommitID()
+	bankCommitID := app.CommitMultiStore().GetCommitKVStore(app.GetKey("bank")).LastCommitID()
+
+	// export the tokenfactory state
+	moduleState, err := app.ExportModuleState(tokenfactorytypes.ModuleName, 0)
+	require.NoError(t, err)
+
+	var genState tokenfactorytypes.GenesisState
+	app.AppCodec().MustUnmarshalJSON(moduleState, &genState)
+	require.Equal(t, *tokenfactorytypes.DefaultGenesis(), genState)
+
+	// import the tokenfactory state with the updated params
+	genState.Params.DenomCreationFee = sdk.NewCoins(sdk.NewInt64Coin("uinit", 100))
+	commitID, _, err := app.ImportModuleState(tokenfactorytypes.ModuleName, app.AppCodec().MustMarshalJSON(&genState))
+	require.NoError(t, err)
+	require.Equal(t, lastCommitID.Version, commitID.Version)
+	require.NotEqual(t, lastCommitID.Hash, commitID.Hash)
+
+	// the other stores are not changed
+	require.Equal(t, bankCommitID, app.CommitMultiStore().GetCommitKVStore(app.GetKey("bank")).LastCommitID())
+
+	moduleState, err = app.ExportModuleState(tokenfactorytypes.ModuleName, 0)
+	require.NoError(t, err)
+
+	var importedGenState tokenfactorytypes.GenesisState
+	app.AppCodec().MustUnmarshalJSON(moduleState, &importedGenState)
+	require.Equal(t, genState.Params, importedGenState.Params)
+
+	// the previous height keeps the old state
+	moduleState, err = app.ExportModuleState(tokenfactorytypes.ModuleName, 1)
+	require.NoError(t, err)
+	app.AppCodec().MustUnmarshalJSON(moduleState, &importedGenState)
+	require.Equal(t, *tokenfactorytypes.DefaultGenesis(), importedGenState)
+}
+
+func TestImportModuleState_UnknownModule(t *testing.T) {
+	app := SetupWithGenesisAccounts(t.TempDir(), nil, nil)
+
+	_, _, err := app.ImportModuleState("unknown", []byte(`{}`))
+	require.Error(t, err)
+
+	_, err = app.ExportModuleState("unknown", 0)
+	require.Error(t, err)
+}
+
+func TestModuleStoreKeys(t *testing.T) {
+	app := SetupWithGenesisAccounts(t.TempDir(), nil, nil)
+
+	for moduleName, storeKeys := range map[string][]string{
+		"tokenfactory":       {"tokenfactory"},
+		"auth":               {"acc"},
+		"wasmhooks":          {"hooks-for-wasm"},
+		"ibchooks":           {"hooks-for-ibc"},
+		"interchainaccounts": {"icahost", "icacontroller"},
+	} {
+		keys, err := app.ModuleStoreKeys(moduleName)
+		require.NoError(t, err, moduleName)
+
+		names := make([]string, 0, len(keys))
+		for _, key := range keys {
+			names = append(names, key.Name())
+		}
+		require.Equal(t, storeKeys, names, moduleName)
+	}
+
+	// every kv store belongs to exactly one module
+	owners := make(map[string]string)
+	for moduleName, storeKeys := range keepers.ModuleStoreKeys {
+		for _, storeKey := range storeKeys {
+			require.Empty(t, owners[storeKey], "store %s belongs to %s and %s", storeKey, owners[storeKey], moduleName)
+			owners[storeKey] = moduleName
+		}
+	}
+	for storeKey := range app.GetKVStoreKey() {
+		require.NotEmpty(t, owners[storeKey], "store %s belongs to no module", storeKey)
+	}
+
+	// every module with a genesis state and a store is mapped
+	for moduleName, mod := range app.ModuleManager.Modules {
+		if _, ok := keepers.ModuleStoreKeys[moduleName]; ok {
+			continue
+		}
+
+		_, hasGenesis := mod.(module.HasGenesis)
+		_, hasABCIGenesis := mod.(module.HasABCIGenesis)
+		require.False(t, hasGenesis || hasABCIGenesis, "module %s has no store keys", moduleName)
+	}
+
+	_, err := app.ModuleStoreKeys("unknown")
+	require.Error(t, err)
+}
@@ -16,6 +16,7 @@ import (
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -53,7 +54,7 @@ func getOrCreateMemDB(db *dbm.DB) dbm.DB {
 	return dbm.NewMemDB()
 }
 
-func setup(homeDir string, db *dbm.DB, withGenesis bool) (*MinitiaApp, GenesisState) {
+func setup(appOpts servertypes.AppOptions, db *dbm.DB, withGenesis bool) (*MinitiaApp, GenesisState) {
 	encCdc := MakeEncodingConfig()
 	app := NewMinitiaApp(
 		log.NewNopLogger(),
@@ -62,7 +63,7 @@ func setup(homeDir string, db *dbm.DB, withGenesis bool) (*MinitiaApp, GenesisSt
 		nil,
 		true,
 		[]wasmkeeper.Option{},
-		EmptyAppOptions{homeDir: homeDir},
+		appOpts,
 	)
 
 	if withGenesis {
@@ -79,7 +80,16 @@ func SetupWithGenesisAccounts(
 	genAccs []authtypes.GenesisAccount,
 	balances ...banktypes.Balance,
 ) *MinitiaApp {
-	app, genesisState := setup(homeDir, nil, true)
+	return setupWithGenesisAccounts(EmptyAppOptions{homeDir: homeDir}, valSet, genAccs, balances...)
+}
+
+func setupWithGenesisAccounts(
+	appOpts servertypes.AppOptions,
+	valSet *tmtypes.ValidatorSet,
+	genAccs []authtypes.GenesisAccount,
+	balances ...banktypes.Balance,
+) *MinitiaApp {
+	app, genesisState := setup(appOpts, nil, true)
 
 	if len(genAccs) == 0 {
 		privAcc := secp256k1.GenPrivKey()
@@ -2,18 +2,114 @@ package app
 
 import (
 	"context"
+	"fmt"
 
+	storetypes "cosmossdk.io/store/types"
 	upgradetypes "cosmossdk.io/x/upgrade/types"
+
 	"github.com/cosmos/cosmos-sdk/types/module"
+
+	feeabstypes "github.com/initia-labs/miniwasm/x/feeabs/types"
+	lanepolicytypes "github.com/initia-labs/miniwasm/x/lanepolicy/types"
+	sendrestrictiontypes "github.com/initia-labs/miniwasm/x/sendrestriction/types"
+	wasmhookstypes "github.com/initia-labs/miniwasm/x/wasmhooks/types"
 )
 
-const upgradeName = "0.2.4"
+// Upgrade defines a software upgrade, which is applied at the height of the
+// upgrade plan of the same name.
+type Upgrade struct {
+	// Name is the name of the upgrade plan.
+	Name string
+
+	// StoreUpgrades are the stores added, renamed or deleted by the upgrade.
+	// Every store mounted by the new binary, but not by the previous one, must
+	// be added here, or the node fails to load the store on restart.
+	StoreUpgrades storetypes.StoreUpgrades
+
+	// RunModuleMigrations runs the registered migrations of the modules whose
+	// consensus version is bumped, and initializes the default genesis of the
+	// modules added by the upgrade.
+	RunModuleMigrations bool
+
+	// StateMigrations are the custom state migrations, which run in order after
+	// the module migrations.
+	StateMigrations []StateMigration
+}
+
+// StateMigration is a custom state migration of an upgrade.
+type StateMigration func(ctx context.Context, app *MinitiaApp) error
 
-// RegisterUpgradeHandlers returns upgrade handlers
-func (app *MinitiaApp) RegisterUpgradeHandlers(cfg module.Configurator) {
-	app.UpgradeKeeper.SetUpgradeHandler(upgradeName,
-		func(ctx context.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
-			return fromVM, nil
+// Upgrades are the registered upgrades, in the order of their heights. The
+// latest upgrade must have the genesis exported by the previous binary and the
+// store names of the previous binary in testdata/upgrades/<name>, which the
+// upgrade test runs the upgrade against.
+var Upgrades = []Upgrade{
+	{
+		Name: "0.2.4",
+	},
+	{
+		// the tokenfactory and ibchooks states are kept as they are; the wasmhooks
+		// acls fall back to the ibchooks acls in the default restricted acl mode.
+		Name: "0.3.0",
+		StoreUpgrades: storetypes.StoreUpgrades{
+			Added: []string{
+				wasmhookstypes.StoreKey,
+				sendrestrictiontypes.StoreKey,
+				feeabstypes.StoreKey,
+				lanepolicytypes.StoreKey,
+			},
 		},
-	)
+		RunModuleMigrations: true,
+	},
+}
+
+// RegisterUpgradeHandlers registers the upgrade handlers of the upgrades, and
+// sets the store loader of the upgrade to be applied at the next height.
+func (app *MinitiaApp) RegisterUpgradeHandlers(cfg module.Configurator) error {
+	return app.registerUpgrades(cfg, Upgrades)
+}
+
+func (app *MinitiaApp) registerUpgrades(cfg module.Configurator, upgrades []Upgrade) error {
+	upgradeInfo, err := app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
+	if err != nil {
+		return err
+	}
+
+	names := make(map[string]bool, len(upgrades))
+	for _, upgrade := range upgrades {
+		if names[upgrade.Name] {
+			return fmt.Errorf("duplicate upgrade: %s", upgrade.Name)
+		}
+		names[upgrade.Name] = true
+
+		app.UpgradeKeeper.SetUpgradeHandler(upgrade.Name, app.upgradeHandler(cfg, upgrade))
+
+		if upgradeInfo.Name == upgrade.Name && !app.UpgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
+			storeUpgrades := upgrade.StoreUpgrades
+			app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &storeUpgrades))
+		}
+	}
+
+	return nil
+}
+
+func (app *MinitiaApp) upgradeHandler(cfg module.Configurator, upgrade Upgrade) upgradetypes.UpgradeHandler {
+	return func(ctx context.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		toVM := fromVM
+		if upgrade.RunModuleMigrations {
+			var err error
+			toVM, err = app.ModuleManager.RunMigrations(ctx, cfg, fromVM)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		for i, migrate := range upgrade.StateMigrations {
+			if err := migrate(ctx, app); err != nil {
+				return nil, fmt.Errorf("failed to run state migration %d of %s: %w", i, upgrade.Name, err)
+			}
+		}
+
+		return toVM, nil
+	}
 }
@@ -0,0 +1,292 @@
+package app
+
+import (
+	"encoding/json"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+
+	ibchookstypes "github.com/initia-labs/initia/x/ibc-hooks/types"
+
+	tokenfactorytypes "github.com/initia-labs/miniwasm/x/tokenfactory/types"
+)
+
+// upgradeFixture returns the path of the genesis exported by the binary before
+// the upgrade.
+func upgradeFixture(name string) string {
+	return filepath.Join("testdata", "upgrades", name, "genesis.json")
+}
+
+// upgradeFixtureStores returns the names of the kv stores mounted by the binary
+// before the upgrade.
+func upgradeFixtureStores(t *testing.T, name string) []string {
+	bz, err := os.ReadFile(filepath.Join("testdata", "upgrades", name, "stores.json"))
+	require.NoError(t, err)
+
+	var stores []string
+	require.NoError(t, json.Unmarshal(bz, &stores))
+
+	return stores
+}
+
+func TestUpgrades(t *testing.T) {
+	require.NotEmpty(t, Upgrades)
+
+	// the latest upgrade must be tested against the state of the previous binary
+	latest := Upgrades[len(Upgrades)-1]
+	require.FileExists(t, upgradeFixture(latest.Name), "missing genesis fixture of upgrade %s", latest.Name)
+
+	for _, upgrade := range Upgrades {
+		if _, err := os.Stat(upgradeFixture(upgrade.Name)); os.IsNotExist(err) {
+			continue
+		}
+
+		t.Run(upgrade.Name, func(t *testing.T) {
+			testUpgrade(t, upgrade)
+		})
+	}
+}
+
+func TestRegisterUpgrades_Duplicate(t *testing.T) {
+	app := SetupWithGenesisAccounts(t.TempDir(), nil, nil)
+
+	err := app.registerUpgrades(app.configurator, []Upgrade{{Name: "v1"}, {Name: "v1"}})
+	require.Error(t, err)
+}
+
+// testUpgrade runs the upgrade against the genesis fixture exported by the
+// previous binary, and restarts the node after the upgrade.
+func testUpgrade(t *testing.T, upgrade Upgrade) {
+	appGenesis, err := genutiltypes.AppGenesisFromFile(upgradeFixture(upgrade.Name))
+	require.NoError(t, err)
+
+	// the fixture is exported with the address prefix of the chain
+	setAddressPrefixes(t, AccountAddressPrefix)
+
+	db := dbm.NewMemDB()
+	height := appGenesis.InitialHeight
+	blockTime := appGenesis.GenesisTime
+
+	// init the chain with the fixture
+	home := t.TempDir()
+	app := loadUpgradeTestApp(t, home, db, appGenesis.ChainID, nil)
+
+	consensusParams := appGenesis.Consensus.Params.ToProto()
+	_, err = app.InitChain(&abci.RequestInitChain{
+		Time:            blockTime,
+		ChainId:         appGenesis.ChainID,
+		ConsensusParams: &consensusParams,
+		AppStateBytes:   appGenesis.AppState,
+		InitialHeight:   height,
+	})
+	require.NoError(t, err)
+	finalizeAndCommit(t, app, height, blockTime)
+
+	// restore the store layout and the module versions of the previous binary
+	// by deleting the stores, which are not mounted by the previous binary, and
+	// the versions of their modules.
+	prevStores := upgradeFixtureStores(t, upgrade.Name)
+	var newStores []string
+	for storeName := range app.GetKVStoreKey() {
+		if !slices.Contains(prevStores, storeName) {
+			newStores = append(newStores, storeName)
+		}
+	}
+
+	home = copyUpgradeTestHome(t, home)
+	app = loadUpgradeTestApp(t, home, db, appGenesis.ChainID, func(ms storetypes.CommitMultiStore) error {
+		return ms.LoadLatestVersionAndUpgrade(&storetypes.StoreUpgrades{Deleted: newStores})
+	})
+
+	ctx := app.NewUncachedContext(false, tmproto.Header{ChainID: appGenesis.ChainID, Height: height})
+	versionStore := prefix.NewStore(ctx.KVStore(app.GetKey(upgradetypes.StoreKey)), []byte{upgradetypes.VersionMapByte})
+	var newModules []string
+	for moduleName := range app.ModuleManager.Modules {
+		keys, err := app.ModuleStoreKeys(moduleName)
+		if err == nil && slices.ContainsFunc(keys, func(key *storetypes.KVStoreKey) bool { return slices.Contains(newStores, key.Name()) }) {
+			versionStore.Delete([]byte(moduleName))
+			newModules = append(newModules, moduleName)
+		}
+	}
+
+	height++
+	blockTime = blockTime.Add(time.Second)
+	finalizeAndCommit(t, app, height, blockTime)
+
+	for _, storeName := range newStores {
+		deletePrefix(t, db, []byte("s/k:"+storeName+"/"))
+	}
+
+	// the previous binary halts at the upgrade height and writes the upgrade
+	// info, which the new binary reads to load the store upgrades; the new
+	// stores missing from the store upgrades fail the loading
+	plan := upgradetypes.Plan{Name: upgrade.Name, Height: height + 1}
+	home = copyUpgradeTestHome(t, home)
+	bz, err := json.Marshal(plan)
+	require.NoError(t, err)
+	require.NoError(t, os.MkdirAll(filepath.Join(home, "data"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(home, "data", upgradetypes.UpgradeInfoFilename), bz, 0o600))
+
+	app = loadUpgradeTestApp(t, home, db, appGenesis.ChainID, nil)
+
+	ctx = app.NewUncachedContext(false, tmproto.Header{ChainID: appGenesis.ChainID, Height: height})
+	require.NoError(t, app.UpgradeKeeper.ScheduleUpgrade(ctx, plan))
+
+	height++
+	blockTime = blockTime.Add(time.Second)
+	finalizeAndCommit(t, app, height, blockTime)
+
+	ctx = app.NewUncachedContext(false, tmproto.Header{ChainID: appGenesis.ChainID, Height: height})
+	doneHeight, err := app.UpgradeKeeper.GetDoneHeight(ctx, upgrade.Name)
+	require.NoError(t, err)
+	require.Equal(t, height, doneHeight)
+
+	// restart the node after the upgrade
+	home = copyUpgradeTestHome(t, home)
+	app = loadUpgradeTestApp(t, home, db, appGenesis.ChainID, nil)
+
+	height++
+	blockTime = blockTime.Add(time.Second)
+	finalizeAndCommit(t, app, height, blockTime)
+
+	exported, err := app.ExportAppStateAndValidators(false, nil, nil)
+	require.NoError(t, err)
+
+	var fixtureState, exportedState GenesisState
+	require.NoError(t, json.Unmarshal(appGenesis.AppState, &fixtureState))
+	require.NoError(t, json.Unmarshal(exported.AppState, &exportedState))
+
+	// the modules added by the upgrade are initialized
+	ctx = app.NewUncachedContext(false, tmproto.Header{ChainID: appGenesis.ChainID, Height: height})
+	versionMap, err := app.UpgradeKeeper.GetModuleVersionMap(ctx)
+	require.NoError(t, err)
+	require.Equal(t, app.ModuleManager.GetVersionMap(), versionMap)
+
+	for _, moduleName := range newModules {
+		require.Contains(t, exportedState, moduleName)
+	}
+
+	// the tokenfactory denoms and the ibchooks acls are kept
+	requireModuleStateEqual(t, app.AppCodec(), &tokenfactorytypes.GenesisState{}, &tokenfactorytypes.GenesisState{},
+		fixtureState[tokenfactorytypes.ModuleName], exportedState[tokenfactorytypes.ModuleName])
+	requireModuleStateEqual(t, app.AppCodec(), &ibchookstypes.GenesisState{}, &ibchookstypes.GenesisState{},
+		fixtureState[ibchookstypes.ModuleName], exportedState[ibchookstypes.ModuleName])
+}
+
+// setAddressPrefixes sets the bech32 prefixes of the sdk config during the test.
+func setAddressPrefixes(t *testing.T, accountAddressPrefix string) {
+	config := sdk.GetConfig()
+	accountAddrPrefix, accountPubKeyPrefix := config.GetBech32AccountAddrPrefix(), config.GetBech32AccountPubPrefix()
+	validatorAddrPrefix, validatorPubKeyPrefix := config.GetBech32ValidatorAddrPrefix(), config.GetBech32ValidatorPubPrefix()
+	consensusAddrPrefix, consensusPubKeyPrefix := config.GetBech32ConsensusAddrPrefix(), config.GetBech32ConsensusPubPrefix()
+	t.Cleanup(func() {
+		config.SetBech32PrefixForAccount(accountAddrPrefix, accountPubKeyPrefix)
+		config.SetBech32PrefixForValidator(validatorAddrPrefix, validatorPubKeyPrefix)
+		config.SetBech32PrefixForConsensusNode(consensusAddrPrefix, consensusPubKeyPrefix)
+	})
+
+	config.SetBech32PrefixForAccount(accountAddressPrefix, accountAddressPrefix+"pub")
+	config.SetBech32PrefixForValidator(accountAddressPrefix+"valoper", accountAddressPrefix+"valoperpub")
+	config.SetBech32PrefixForConsensusNode(accountAddressPrefix+"valcons", accountAddressPrefix+"valconspub")
+}
+
+// loadUpgradeTestApp loads the app from the db with the given home, as the wasm
+// vm of an app locks its home until the process exits.
+func loadUpgradeTestApp(t *testing.T, home string, db dbm.DB, chainID string, storeLoader baseapp.StoreLoader) *MinitiaApp {
+	app := NewMinitiaApp(
+		log.NewNopLogger(),
+		db,
+		dbm.NewMemDB(),
+		nil,
+		false,
+		[]wasmkeeper.Option{},
+		EmptyAppOptions{homeDir: home},
+		baseapp.SetChainID(chainID),
+	)
+	if storeLoader != nil {
+		app.SetStoreLoader(storeLoader)
+	}
+	require.NoError(t, app.LoadLatestVersion())
+
+	return app
+}
+
+// copyUpgradeTestHome returns a new home with the wasm codes of the home.
+func copyUpgradeTestHome(t *testing.T, home string) string {
+	newHome := t.TempDir()
+
+	src := filepath.Join(home, "wasm")
+	err := filepath.WalkDir(src, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		dst := filepath.Join(newHome, "wasm", rel)
+
+		if d.IsDir() {
+			return os.MkdirAll(dst, 0o755)
+		}
+
+		bz, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		return os.WriteFile(dst, bz, 0o600)
+	})
+	require.NoError(t, err)
+
+	return newHome
+}
+
+func finalizeAndCommit(t *testing.T, app *MinitiaApp, height int64, blockTime time.Time) {
+	_, err := app.FinalizeBlock(&abci.RequestFinalizeBlock{Height: height, Time: blockTime})
+	require.NoError(t, err)
+	_, err = app.Commit()
+	require.NoError(t, err)
+}
+
+func deletePrefix(t *testing.T, db dbm.DB, prefix []byte) {
+	iter, err := dbm.IteratePrefix(db, prefix)
+	require.NoError(t, err)
+
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	require.NoError(t, iter.Close())
+
+	for _, key := range keys {
+		require.NoError(t, db.Delete(key))
+	}
+}
+
+func requireModuleStateEqual(t *testing.T, cdc codec.Codec, expected, actual codec.ProtoMarshaler, expectedBz, actualBz json.RawMessage) {
+	require.NoError(t, cdc.UnmarshalJSON(expectedBz, expected))
+	require.NoError(t, cdc.UnmarshalJSON(actualBz, actual))
+	require.Equal(t, expected, actual)
+}
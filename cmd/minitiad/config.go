@@ -9,7 +9,10 @@ import (
 	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
 	indexerconfig "github.com/initia-labs/kvindexer/config"
 
+	minitiaapp "github.com/initia-labs/miniwasm/app"
+	applanes "github.com/initia-labs/miniwasm/app/lanes"
 	"github.com/initia-labs/miniwasm/types"
+	bankkeeper "github.com/initia-labs/miniwasm/x/bank/keeper"
 )
 
 // minitiaAppConfig initia specify app config
@@ -17,6 +20,11 @@ type minitiaAppConfig struct {
 	serverconfig.Config
 	WasmConfig    wasmtypes.WasmConfig        `mapstructure:"wasm"`
 	IndexerConfig indexerconfig.IndexerConfig `mapstructure:"indexer"`
+	LanesConfig   applanes.LanesConfig        `mapstructure:"lanes"`
+
+	BankMetricsConfig bankkeeper.MetricsConfig `mapstructure:"bank-metrics"`
+
+	ExecutorChangeConfig minitiaapp.ExecutorChangeConfig `mapstructure:"executor-change"`
 }
 
 // initAppConfig helps to override default appConfig template and configs.
@@ -55,10 +63,18 @@ func initAppConfig() (string, interface{}) {
 		Config:        *srvCfg,
 		WasmConfig:    wasmtypes.DefaultWasmConfig(),
 		IndexerConfig: indexerconfig.DefaultConfig(),
+		LanesConfig:   applanes.DefaultConfig(),
+
+		// the base denom is recorded with its own label in the bank metrics
+		BankMetricsConfig: bankkeeper.MetricsConfig{Denoms: []string{types.BaseDenom}},
+
+		ExecutorChangeConfig: minitiaapp.DefaultExecutorChangeConfig(),
 	}
 
 	minitiaAppTemplate := serverconfig.DefaultConfigTemplate +
-		wasmtypes.DefaultConfigTemplate() + indexerconfig.DefaultConfigTemplate
+		wasmtypes.DefaultConfigTemplate() + indexerconfig.DefaultConfigTemplate +
+		applanes.DefaultConfigTemplate + bankkeeper.MetricsConfigTemplate +
+		minitiaapp.ExecutorChangeConfigTemplate
 
 	return minitiaAppTemplate, minitiaAppConfig
 }
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+
+	"github.com/initia-labs/initia/app/params"
+
+	minitiaapp "github.com/initia-labs/miniwasm/app"
+)
+
+const flagBridgeID = "bridge-id"
+
+// ExecutorPlansCommand returns the executor change plan subcommands.
+func ExecutorPlansCommand(encodingConfig params.EncodingConfig) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "executor-plans",
+		Short:                      "Executor change plan subcommands",
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		ValidateExecutorPlansCmd(encodingConfig),
+	)
+
+	return cmd
+}
+
+// ValidateExecutorPlansCmd returns a command to check the executor change
+// plans offline.
+func ValidateExecutorPlansCmd(encodingConfig params.EncodingConfig) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validate [plans-file]",
+		Short: "Check the executor change plans offline",
+		Long: `Check the executor change plans of the json or toml file, which has the plans
+under the "plans" key. The plans of the node, configured in app.toml, are checked if the
+file is not given.
+
+Example:
+$ minitiad executor-plans validate executor_change_plans.json --bridge-id 1
+`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			bridgeID, err := cmd.Flags().GetUint64(flagBridgeID)
+			if err != nil {
+				return err
+			}
+
+			var plans []minitiaapp.ExecutorChangePlan
+			if len(args) == 1 {
+				plans, err = minitiaapp.ReadExecutorChangePlansFile(args[0])
+			} else {
+				plans, err = minitiaapp.LoadExecutorChangePlans(server.GetServerContextFromCmd(cmd).Viper)
+			}
+			if err != nil {
+				return err
+			}
+
+			signingContext := encodingConfig.TxConfig.SigningContext()
+			err = minitiaapp.ValidateExecutorChangePlans(plans, encodingConfig.Codec, signingContext.AddressCodec(), signingContext.ValidatorAddressCodec())
+			if err != nil {
+				return err
+			}
+
+			for _, plan := range plans {
+				if bridgeID != 0 && plan.BridgeID != bridgeID {
+					return fmt.Errorf("executor change plan at height %d is for bridge %d, not %d", plan.Height, plan.BridgeID, bridgeID)
+				}
+
+				fmt.Fprintf(cmd.OutOrStdout(), "height %d: bridge %d, proposal %d, next validator %s (%s), next executors %v\n",
+					plan.Height, plan.BridgeID, plan.ProposalID, plan.NextValidator, plan.Moniker, plan.NextExecutors)
+			}
+
+			_, err = fmt.Fprintf(cmd.OutOrStdout(), "%d executor change plans are valid\n", len(plans))
+			return err
+		},
+	}
+
+	cmd.Flags().Uint64(flagBridgeID, 0, "Check the plans are for the given bridge")
+
+	return cmd
+}
@@ -0,0 +1,334 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/core/address"
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+
+	minitiaapp "github.com/initia-labs/miniwasm/app"
+	wasmhookstypes "github.com/initia-labs/miniwasm/x/wasmhooks/types"
+)
+
+const (
+	flagCreator                 = "creator"
+	flagAdmin                   = "admin"
+	flagPin                     = "pin"
+	flagInstantiateAnyOfAddress = "instantiate-anyof-addresses"
+	flagInstantiateNobody       = "instantiate-nobody"
+	flagSalt                    = "salt"
+	flagFixMsg                  = "fix-msg"
+	flagHook                    = "hook"
+	flagName                    = "name"
+	flagSymbol                  = "symbol"
+	flagDescription             = "description"
+	flagDisplay                 = "display"
+	flagDecimals                = "decimals"
+	flagURI                     = "uri"
+	flagInitialBalances         = "initial-balances"
+	flagAllowReceive            = "allow-receive"
+	flagAllowCallback           = "allow-callback"
+	flagChannels                = "channels"
+	flagSenders                 = "senders"
+)
+
+// AddWasmCodeCmd returns a command to add a wasm code to the genesis.
+func AddWasmCodeCmd(defaultNodeHome string, ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-wasm-code [wasm-file]",
+		Short: "Add a wasm code to genesis.json",
+		Long: `Add a wasm code to the wasm genesis state of genesis.json. The code id is assigned
+with the next code sequence, and the genesis is validated by a dry InitChain.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			creator, _ := cmd.Flags().GetString(flagCreator)
+			pinned, _ := cmd.Flags().GetBool(flagPin)
+			nobody, _ := cmd.Flags().GetBool(flagInstantiateNobody)
+			anyOfAddrs, _ := cmd.Flags().GetStringSlice(flagInstantiateAnyOfAddress)
+
+			instantiateConfig := wasmtypes.AllowEverybody
+			if nobody {
+				instantiateConfig = wasmtypes.AllowNobody
+			} else if len(anyOfAddrs) > 0 {
+				addrs := make([]sdk.AccAddress, len(anyOfAddrs))
+				for i, addr := range anyOfAddrs {
+					bz, err := ac.StringToBytes(addr)
+					if err != nil {
+						return err
+					}
+
+					addrs[i] = bz
+				}
+
+				instantiateConfig = wasmtypes.AccessTypeAnyOfAddresses.With(addrs...)
+			}
+
+			wasmCode, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			if _, err := ac.StringToBytes(creator); err != nil {
+				return fmt.Errorf("invalid creator: %w", err)
+			}
+
+			return updateGenesis(cmd, func(clientCtx client.Context, appGenesis *genutiltypes.AppGenesis) error {
+				return updateAppState(clientCtx, appGenesis, func(genState minitiaapp.GenesisState) error {
+					codeID, err := genState.AddWasmCode(clientCtx.Codec, creator, wasmCode, instantiateConfig, pinned)
+					if err != nil {
+						return err
+					}
+
+					cmd.Printf("code id: %d\n", codeID)
+					return nil
+				})
+			})
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().String(flagCreator, "", "The creator address of the code")
+	cmd.Flags().Bool(flagPin, false, "Pin the code in the wasm vm cache")
+	cmd.Flags().Bool(flagInstantiateNobody, false, "Nobody except the governance process can instantiate a contract from the code")
+	cmd.Flags().StringSlice(flagInstantiateAnyOfAddress, []string{}, "Any of the addresses can instantiate a contract from the code")
+	_ = cmd.MarkFlagRequired(flagCreator)
+
+	return cmd
+}
+
+// AddContractCmd returns a command to instantiate a contract in the genesis.
+func AddContractCmd(defaultNodeHome string, ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-contract [code-id] [label] [init-msg]",
+		Short: "Instantiate a contract in genesis.json",
+		Long: `Instantiate a contract from a genesis wasm code, and write the contract state to
+genesis.json. The contract is instantiated on top of the genesis with a dry InitChain,
+so the init message is executed as it would be after the launch.
+
+The contract address is derived from the instance sequence, or from the salt with
+instantiate2 if --salt is given. The instantiation must not change the state of
+the other modules, e.g. by sending tokens.
+`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var codeID uint64
+			if _, err := fmt.Sscan(args[0], &codeID); err != nil {
+				return fmt.Errorf("invalid code id: %w", err)
+			}
+
+			creator, _ := cmd.Flags().GetString(flagCreator)
+			admin, _ := cmd.Flags().GetString(flagAdmin)
+			salt, _ := cmd.Flags().GetString(flagSalt)
+			fixMsg, _ := cmd.Flags().GetBool(flagFixMsg)
+
+			if _, err := ac.StringToBytes(creator); err != nil {
+				return fmt.Errorf("invalid creator: %w", err)
+			}
+			if admin != "" {
+				if _, err := ac.StringToBytes(admin); err != nil {
+					return fmt.Errorf("invalid admin: %w", err)
+				}
+			}
+
+			msg := []byte(args[2])
+			if !json.Valid(msg) {
+				return fmt.Errorf("init msg must be a valid json")
+			}
+
+			return updateGenesis(cmd, func(_ client.Context, appGenesis *genutiltypes.AppGenesis) error {
+				contractAddr, err := minitiaapp.InstantiateGenesisContract(appGenesis, minitiaapp.GenesisContract{
+					CodeID:  codeID,
+					Creator: creator,
+					Admin:   admin,
+					Label:   args[1],
+					Msg:     msg,
+					Salt:    []byte(salt),
+					FixMsg:  fixMsg,
+				})
+				if err != nil {
+					return err
+				}
+
+				cmd.Printf("contract address: %s\n", contractAddr)
+				return nil
+			})
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().String(flagCreator, "", "The creator address of the contract")
+	cmd.Flags().String(flagAdmin, "", "The admin address of the contract, no admin if empty")
+	cmd.Flags().String(flagSalt, "", "The salt to derive the contract address with instantiate2")
+	cmd.Flags().Bool(flagFixMsg, false, "Include the init msg in the contract address derivation of instantiate2")
+	_ = cmd.MarkFlagRequired(flagCreator)
+
+	return cmd
+}
+
+// AddFactoryDenomCmd returns a command to add a tokenfactory denom to the genesis.
+func AddFactoryDenomCmd(defaultNodeHome string, ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-factory-denom [creator] [subdenom]",
+		Short: "Add a tokenfactory denom to genesis.json",
+		Long: `Add a tokenfactory denom with its bank metadata, before send hook and initial
+balances to genesis.json. The denom creation fee is not charged at genesis.
+
+Example:
+$ minitiad genesis add-factory-denom init1... token --symbol TOKEN --display token --decimals 6 \
+    --initial-balances init1...=1000000,init1...=2000000
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			factoryDenom := minitiaapp.GenesisFactoryDenom{
+				Creator:  args[0],
+				Subdenom: args[1],
+			}
+
+			factoryDenom.Admin, _ = cmd.Flags().GetString(flagAdmin)
+			factoryDenom.HookAddress, _ = cmd.Flags().GetString(flagHook)
+			factoryDenom.Name, _ = cmd.Flags().GetString(flagName)
+			factoryDenom.Symbol, _ = cmd.Flags().GetString(flagSymbol)
+			factoryDenom.Description, _ = cmd.Flags().GetString(flagDescription)
+			factoryDenom.Display, _ = cmd.Flags().GetString(flagDisplay)
+			factoryDenom.Decimals, _ = cmd.Flags().GetUint32(flagDecimals)
+			factoryDenom.URI, _ = cmd.Flags().GetString(flagURI)
+
+			balances, _ := cmd.Flags().GetStringSlice(flagInitialBalances)
+			for _, balance := range balances {
+				addr, amountStr, ok := strings.Cut(balance, "=")
+				if !ok {
+					return fmt.Errorf("invalid initial balance %s; expected [address]=[amount]", balance)
+				}
+
+				amount, ok := sdkmath.NewIntFromString(amountStr)
+				if !ok {
+					return fmt.Errorf("invalid initial balance amount %s", amountStr)
+				}
+
+				factoryDenom.InitialBalances = append(factoryDenom.InitialBalances, minitiaapp.GenesisDenomBalance{
+					Address: addr,
+					Amount:  amount,
+				})
+			}
+
+			return updateGenesis(cmd, func(clientCtx client.Context, appGenesis *genutiltypes.AppGenesis) error {
+				return updateAppState(clientCtx, appGenesis, func(genState minitiaapp.GenesisState) error {
+					denom, err := genState.AddFactoryDenom(clientCtx.Codec, ac, factoryDenom)
+					if err != nil {
+						return err
+					}
+
+					cmd.Printf("denom: %s\n", denom)
+					return nil
+				})
+			})
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().String(flagAdmin, "", "The admin address of the denom, defaults to the creator")
+	cmd.Flags().String(flagHook, "", "The before send hook contract address of the denom")
+	cmd.Flags().String(flagName, "", "The name of the denom")
+	cmd.Flags().String(flagSymbol, "", "The symbol of the denom")
+	cmd.Flags().String(flagDescription, "", "The description of the denom")
+	cmd.Flags().String(flagDisplay, "", "The display unit of the denom")
+	cmd.Flags().Uint32(flagDecimals, 0, "The decimals of the display unit")
+	cmd.Flags().String(flagURI, "", "The uri of the denom metadata")
+	cmd.Flags().StringSlice(flagInitialBalances, []string{}, "The initial balances of the denom in [address]=[amount] format")
+
+	return cmd
+}
+
+// SetIBCHookACLCmd returns a command to set the ibc hook acl of a contract in
+// the genesis.
+func SetIBCHookACLCmd(defaultNodeHome string, ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-ibc-hook-acl [contract]",
+		Short: "Set the ibc hook acl of a contract in genesis.json",
+		Long: `Set the ibc hook acl of a contract in the wasmhooks genesis state of genesis.json,
+replacing the existing acl of the contract.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			acl := wasmhookstypes.ContractACL{Contract: args[0]}
+			acl.AllowReceive, _ = cmd.Flags().GetBool(flagAllowReceive)
+			acl.AllowCallback, _ = cmd.Flags().GetBool(flagAllowCallback)
+			acl.Channels, _ = cmd.Flags().GetStringSlice(flagChannels)
+			acl.Senders, _ = cmd.Flags().GetStringSlice(flagSenders)
+
+			return updateGenesis(cmd, func(clientCtx client.Context, appGenesis *genutiltypes.AppGenesis) error {
+				return updateAppState(clientCtx, appGenesis, func(genState minitiaapp.GenesisState) error {
+					return genState.SetIBCHookACL(clientCtx.Codec, ac, acl)
+				})
+			})
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().Bool(flagAllowReceive, false, "Allow the contract to be executed by the received packets")
+	cmd.Flags().Bool(flagAllowCallback, false, "Allow the contract to receive the ack and timeout callbacks")
+	cmd.Flags().StringSlice(flagChannels, []string{}, "The local channels the contract is allowed on, all if empty")
+	cmd.Flags().StringSlice(flagSenders, []string{}, "The counterparty senders allowed to execute the contract, all if empty")
+
+	return cmd
+}
+
+// updateGenesis reads genesis.json, applies the update, validates the result
+// with a dry InitChain and writes it back.
+func updateGenesis(cmd *cobra.Command, update func(clientCtx client.Context, appGenesis *genutiltypes.AppGenesis) error) error {
+	clientCtx := client.GetClientContextFromCmd(cmd)
+	serverCtx := server.GetServerContextFromCmd(cmd)
+	config := serverCtx.Config
+	config.SetRoot(clientCtx.HomeDir)
+
+	genFile := config.GenesisFile()
+	appGenesis, err := genutiltypes.AppGenesisFromFile(genFile)
+	if err != nil {
+		return fmt.Errorf("failed to read genesis file: %w", err)
+	}
+
+	if err := update(clientCtx, appGenesis); err != nil {
+		return err
+	}
+
+	if err := minitiaapp.DryInitChain(appGenesis, nil); err != nil {
+		return err
+	}
+
+	return genutil.ExportGenesisFile(appGenesis, genFile)
+}
+
+// updateAppState applies the update to the app state of the genesis.
+func updateAppState(clientCtx client.Context, appGenesis *genutiltypes.AppGenesis, update func(genState minitiaapp.GenesisState) error) error {
+	var genState minitiaapp.GenesisState
+	if err := json.Unmarshal(appGenesis.AppState, &genState); err != nil {
+		return fmt.Errorf("failed to unmarshal genesis state: %w", err)
+	}
+
+	if err := update(genState); err != nil {
+		return err
+	}
+
+	appState, err := json.Marshal(genState)
+	if err != nil {
+		return fmt.Errorf("failed to marshal application genesis state: %w", err)
+	}
+
+	appGenesis.AppState = appState
+	return nil
+}
@@ -1,130 +1,379 @@
 package main
 
 import (
+	"bytes"
 	"encoding/json"
+	"math"
 	"os"
+	"path/filepath"
+	"strconv"
 
-	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+
+	wasmvm "github.com/CosmWasm/wasmvm/v2"
+
+	"github.com/CosmWasm/wasmd/x/wasm/ioutils"
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/initia-labs/OPinit/contrib/launchtools"
 	"github.com/initia-labs/OPinit/contrib/launchtools/steps"
+	"github.com/initia-labs/OPinit/contrib/launchtools/utils"
 	"github.com/initia-labs/initia/app/params"
 	minitiaapp "github.com/initia-labs/miniwasm/app"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 )
 
-// DefaultLaunchStepFactories is a list of default launch step factories.
-var DefaultLaunchStepFactories = []launchtools.LauncherStepFuncFactory[*launchtools.Config]{
-	steps.InitializeConfig,
-	steps.InitializeRPCHelpers,
+// DefaultLaunchStepFactories returns the default launch step factories. The
+// steps which must run only once are recorded in the launch state, and skipped
+// on resume.
+func DefaultLaunchStepFactories(wasmConfig *WasmLaunchConfig, launchState *LaunchState) []launchtools.LauncherStepFuncFactory[*launchtools.Config] {
+	state := newWasmLaunchState()
+
+	return []launchtools.LauncherStepFuncFactory[*launchtools.Config]{
+		// Record the finalized config, which is reused on resume
+		RecordLaunchConfig(launchState),
 
-	// Initialize genesis
-	steps.InitializeGenesis,
+		steps.InitializeConfig,
+		steps.InitializeRPCHelpers,
 
-	// Add system keys to the keyring
-	steps.InitializeKeyring,
+		// Initialize genesis
+		ResumableStep("initialize_genesis", launchState, steps.InitializeGenesis),
 
-	// Run the app
-	steps.RunApp,
+		// Add system keys to the keyring
+		ResumableStep("initialize_keyring", launchState, steps.InitializeKeyring),
 
-	// MINIWASM: Store/Instantiate cw721 and ics721 contracts
-	StoreAndInstantiateNFTContracts,
+		// Run the app
+		steps.RunApp,
 
-	// Establish IBC channels for fungible and NFT transfer
-	// MINIWASM: Use wasm contract addresses for srcPort, dstPort, channelVersion
-	steps.EstablishIBCChannelsWithNFTTransfer(func() (string, string, string) {
-		return "wasm." + wasmkeeper.BuildContractAddressClassic(2, 1).String(),
-			"nft-transfer",
-			"ics721-1"
-	}),
+		// MINIWASM: Store/Instantiate the wasm artifacts of the launch config
+		// The step is idempotent, as it reuses the codes and contracts already on chain.
+		StoreAndInstantiateContracts(wasmConfig, state),
 
-	// Create OP Bridge, using open channel states
-	steps.InitializeOpBridge,
+		// Establish IBC channels for fungible and NFT transfer
+		// MINIWASM: Use the instantiated nft transfer contract address for srcPort
+		ResumableStep("establish_ibc_channels", launchState, EstablishIBCChannelsWithNFTTransfer(wasmConfig, state, launchState)),
 
-	// Set bridge info and update clients
-	steps.SetBridgeInfo,
+		// Create OP Bridge, using open channel states
+		ResumableStep("initialize_op_bridge", launchState, steps.InitializeOpBridge),
 
-	// Get the L1 and L2 heights
-	steps.GetL1Height,
-	steps.GetL2Height,
+		// Set bridge info and update clients
+		ResumableStep("set_bridge_info", launchState, steps.SetBridgeInfo),
 
-	// Cleanup
-	steps.StopApp,
+		// Get the L1 and L2 heights
+		ResumableStep("get_l1_height", launchState, steps.GetL1Height),
+		ResumableStep("get_l2_height", launchState, steps.GetL2Height),
+
+		// Cleanup
+		steps.StopApp,
+	}
 }
 
 func LaunchCommand(ac *appCreator, enc params.EncodingConfig, mbm module.BasicManager) *cobra.Command {
-	return launchtools.LaunchCmd(
+	wasmConfig := DefaultWasmLaunchConfig()
+	launchState := NewLaunchState("")
+
+	cmd := launchtools.LaunchCmd(
 		ac,
 		func(denom string) map[string]json.RawMessage {
 			return minitiaapp.NewDefaultGenesisState(enc.Codec, mbm, denom)
 		},
-		DefaultLaunchStepFactories,
+		DefaultLaunchStepFactories(wasmConfig, launchState),
 	)
-}
 
-// StoreAndInstantiateNFTContracts stores and instantiates cw721 and ics721 contracts
-func StoreAndInstantiateNFTContracts(input *launchtools.Config) launchtools.LauncherStepFunc {
-	return func(ctx launchtools.Launcher) error {
-		ctx.Logger().Info("Storing and instantiating cw721 and ics721 contracts")
+	// read the wasm config and the launch state before the steps are built
+	runE := cmd.RunE
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		configPath, err := cmd.Flags().GetString(flagWithConfig)
+		if err != nil {
+			return errors.Wrap(err, "failed to get config flag")
+		}
+
+		config, err := NewWasmLaunchConfig(configPath)
+		if err != nil {
+			return errors.Wrap(err, "failed to read wasm config")
+		}
+
+		artifactsDir, err := cmd.Flags().GetString(flagArtifactsDir)
+		if err != nil {
+			return errors.Wrap(err, "failed to get artifacts flag")
+		}
 
-		cw721, err := os.ReadFile("contrib/wasm/cw721_base.wasm")
+		resume, err := cmd.Flags().GetBool(flagResume)
 		if err != nil {
-			return errors.Wrapf(err, "failed to read cw721_base.wasm")
+			return errors.Wrap(err, "failed to get resume flag")
 		}
 
-		ics721, err := os.ReadFile("contrib/wasm/ics721_base.wasm")
+		clientCtx := client.GetClientContextFromCmd(cmd)
+		state, err := LoadLaunchState(filepath.Join(clientCtx.HomeDir, artifactsDir, LaunchStateFileName))
 		if err != nil {
-			return errors.Wrapf(err, "failed to read ics721_base.wasm")
+			return err
 		}
+		if !resume && (len(state.Steps) != 0 || state.Config != nil) {
+			return errors.Errorf("launch state already exists at %s; use --%s to resume the launch", state.path, flagResume)
+		}
+
+		// the resumed launch is finalized from the recorded config, so the
+		// generated system keys and chain id match the genesis on disk
+		if resume && state.Config != nil {
+			resumeConfigPath, err := state.WriteConfig()
+			if err != nil {
+				return err
+			}
+			defer os.Remove(resumeConfigPath)
 
-		msgs := []sdk.Msg{
-			&wasmtypes.MsgStoreCode{
-				Sender:                input.SystemKeys.Validator.L2Address,
-				WASMByteCode:          cw721,
-				InstantiatePermission: nil,
-			},
-			&wasmtypes.MsgStoreCode{
-				Sender:                input.SystemKeys.Validator.L2Address,
-				WASMByteCode:          ics721,
-				InstantiatePermission: nil,
-			},
-			&wasmtypes.MsgInstantiateContract{
-				Sender: input.SystemKeys.Validator.L2Address,
-				Admin:  input.SystemKeys.Validator.L2Address,
-				CodeID: 2,
-				Label:  "ics721",
-				Msg:    []byte(`{"cw721_base_code_id":1}`),
-				Funds:  nil,
-			},
+			if err := cmd.Flags().Set(flagWithConfig, resumeConfigPath); err != nil {
+				return err
+			}
+		} else if resume && len(state.Steps) != 0 {
+			return errors.Errorf("launch config is not recorded in %s; the launch can not be resumed", state.path)
 		}
 
-		for i, msg := range msgs {
-			ctx.Logger().Info(
-				"Broadcasting tx to store and instantiate cw721 and ics721 contracts",
-				"step", i+1,
-			)
+		*wasmConfig = *config
+		*launchState = *state
+		return runE(cmd, args)
+	}
+
+	cmd.Flags().Bool(flagResume, false, "Resume the launch from the launch state, skipping the completed steps")
 
-			res, err := ctx.GetRPCHelperL2().BroadcastTxAndWait(
-				input.SystemKeys.Validator.L2Address,
-				input.SystemKeys.Validator.Mnemonic,
-				10000000,
-				sdk.NewCoins(),
-				msg,
-			)
+	return cmd
+}
 
+// wasmLaunchState holds the code ids and the contract addresses of the wasm
+// artifacts stored at the launch.
+type wasmLaunchState struct {
+	CodeIDs   map[string]uint64 `json:"code_ids"`
+	Contracts map[string]string `json:"contracts"`
+}
+
+func newWasmLaunchState() *wasmLaunchState {
+	return &wasmLaunchState{
+		CodeIDs:   make(map[string]uint64),
+		Contracts: make(map[string]string),
+	}
+}
+
+// StoreAndInstantiateContracts stores the wasm artifacts and instantiates the
+// contracts of the launch config. The codes and the contracts which already
+// exist on chain are reused, so the step can be run again on resume.
+func StoreAndInstantiateContracts(wasmConfig *WasmLaunchConfig, state *wasmLaunchState) launchtools.LauncherStepFuncFactory[*launchtools.Config] {
+	return func(input *launchtools.Config) launchtools.LauncherStepFunc {
+		return func(ctx launchtools.Launcher) error {
+			sender := input.SystemKeys.Validator.L2Address
+
+			for _, artifact := range wasmConfig.Artifacts {
+				wasmCode, err := wasmConfig.WasmCode(artifact)
+				if err != nil {
+					return err
+				}
+
+				codeID, found, err := findStoredCode(ctx, sender, wasmCode)
+				if err != nil {
+					return err
+				}
+
+				if found {
+					ctx.Logger().Info("Wasm artifact already stored", "name", artifact.Name, "code_id", codeID)
+				} else {
+					ctx.Logger().Info("Storing wasm artifact", "name", artifact.Name)
+
+					codeID, err = storeCode(ctx, input, sender, wasmCode)
+					if err != nil {
+						return errors.Wrapf(err, "failed to store %s", artifact.Name)
+					}
+
+					ctx.Logger().Info("Successfully stored wasm artifact", "name", artifact.Name, "code_id", codeID)
+				}
+
+				state.CodeIDs[artifact.Name] = codeID
+				if artifact.Instantiate == nil {
+					continue
+				}
+
+				contractAddr, found, err := findInstantiatedContract(ctx, sender, codeID, artifact.Instantiate.Label)
+				if err != nil {
+					return err
+				}
+
+				if found {
+					ctx.Logger().Info("Contract already instantiated", "name", artifact.Name, "address", contractAddr)
+				} else {
+					contractAddr, err = instantiateContract(ctx, input, sender, codeID, artifact.Instantiate, state.CodeIDs)
+					if err != nil {
+						return errors.Wrapf(err, "failed to instantiate %s", artifact.Name)
+					}
+
+					ctx.Logger().Info("Successfully instantiated contract", "name", artifact.Name, "address", contractAddr)
+				}
+
+				if expected := artifact.Instantiate.ExpectedAddress; expected != "" && expected != contractAddr {
+					return errors.Errorf("unexpected contract address of %s; expected %s, got %s", artifact.Name, expected, contractAddr)
+				}
+
+				state.Contracts[artifact.Name] = contractAddr
+			}
+
+			bz, err := json.Marshal(state)
 			if err != nil {
-				return errors.Wrapf(err, "failed to store and instantiate nft contracts")
+				return err
 			}
 
-			ctx.Logger().Info(
-				"Successfully stored and instantiated cw721 and ics721 contracts",
-				"tx_hash", res.Hash,
-			)
+			return ctx.WriteOutput(WasmArtifactName, string(bz))
 		}
+	}
+}
+
+// findStoredCode returns the id of the code stored by the sender with the same
+// checksum of the wasm code.
+func findStoredCode(ctx launchtools.Launcher, sender string, wasmCode []byte) (uint64, bool, error) {
+	if ioutils.IsGzip(wasmCode) {
+		var err error
+		wasmCode, err = ioutils.Uncompress(wasmCode, math.MaxInt64)
+		if err != nil {
+			return 0, false, err
+		}
+	}
 
-		return nil
+	checksum, err := wasmvm.CreateChecksum(wasmCode)
+	if err != nil {
+		return 0, false, err
 	}
+
+	app, queryCtx, err := launchQueryContext(ctx)
+	if err != nil {
+		return 0, false, err
+	}
+
+	var codeID uint64
+	app.WasmKeeper.IterateCodeInfos(queryCtx, func(id uint64, info wasmtypes.CodeInfo) bool {
+		if info.Creator == sender && bytes.Equal(info.CodeHash, checksum) {
+			codeID = id
+			return true
+		}
+
+		return false
+	})
+
+	return codeID, codeID != 0, nil
+}
+
+// findInstantiatedContract returns the address of the contract instantiated
+// by the sender from the code with the label.
+func findInstantiatedContract(ctx launchtools.Launcher, sender string, codeID uint64, label string) (string, bool, error) {
+	app, queryCtx, err := launchQueryContext(ctx)
+	if err != nil {
+		return "", false, err
+	}
+
+	var contractAddr sdk.AccAddress
+	app.WasmKeeper.IterateContractsByCode(queryCtx, codeID, func(addr sdk.AccAddress) bool {
+		info := app.WasmKeeper.GetContractInfo(queryCtx, addr)
+		if info != nil && info.Creator == sender && info.Label == label {
+			contractAddr = addr
+			return true
+		}
+
+		return false
+	})
+
+	if contractAddr.Empty() {
+		return "", false, nil
+	}
+
+	return contractAddr.String(), true, nil
+}
+
+func launchQueryContext(ctx launchtools.Launcher) (*minitiaapp.MinitiaApp, sdk.Context, error) {
+	app, ok := ctx.App().(*minitiaapp.MinitiaApp)
+	if !ok {
+		return nil, sdk.Context{}, errors.New("unexpected app type")
+	}
+
+	queryCtx, err := app.CreateQueryContext(0, false)
+	if err != nil {
+		return nil, sdk.Context{}, errors.Wrap(err, "failed to create query context")
+	}
+
+	return app, queryCtx, nil
+}
+
+func storeCode(ctx launchtools.Launcher, input *launchtools.Config, sender string, wasmCode []byte) (uint64, error) {
+	res, err := broadcastWasmMsg(ctx, input, &wasmtypes.MsgStoreCode{
+		Sender:       sender,
+		WASMByteCode: wasmCode,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	codeIDStr, found := utils.FindTxEventsByKey(wasmtypes.AttributeKeyCodeID, res.TxResult.Events)
+	if !found {
+		return 0, errors.New("code id not found in the store code events")
+	}
+
+	codeID, err := strconv.ParseUint(codeIDStr, 10, 64)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to parse code id %s", codeIDStr)
+	}
+
+	return codeID, nil
+}
+
+func instantiateContract(
+	ctx launchtools.Launcher,
+	input *launchtools.Config,
+	sender string,
+	codeID uint64,
+	instantiate *WasmInstantiate,
+	codeIDs map[string]uint64,
+) (string, error) {
+	msg, err := instantiate.InstantiateMsg(codeIDs)
+	if err != nil {
+		return "", err
+	}
+
+	admin := instantiate.Admin
+	if admin == "" {
+		admin = sender
+	}
+
+	res, err := broadcastWasmMsg(ctx, input, &wasmtypes.MsgInstantiateContract{
+		Sender: sender,
+		Admin:  admin,
+		CodeID: codeID,
+		Label:  instantiate.Label,
+		Msg:    msg,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	contractAddr, found := utils.FindTxEventsByKey(wasmtypes.AttributeKeyContractAddr, res.TxResult.Events)
+	if !found {
+		return "", errors.New("contract address not found in the instantiate events")
+	}
+
+	return contractAddr, nil
+}
+
+func broadcastWasmMsg(ctx launchtools.Launcher, input *launchtools.Config, msg sdk.Msg) (*coretypes.ResultTx, error) {
+	res, err := ctx.GetRPCHelperL2().BroadcastTxAndWait(
+		input.SystemKeys.Validator.L2Address,
+		input.SystemKeys.Validator.Mnemonic,
+		10000000,
+		sdk.NewCoins(),
+		msg,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.TxResult.Code != 0 {
+		return nil, errors.Errorf("tx failed with code %d, log: %s", res.TxResult.Code, res.TxResult.Log)
+	}
+
+	ctx.Logger().Info("Broadcasted wasm tx", "tx_hash", res.Hash)
+	return res, nil
 }
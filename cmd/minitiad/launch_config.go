@@ -0,0 +1,214 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+
+	"github.com/initia-labs/miniwasm/contrib/wasm"
+)
+
+const (
+	// flagWithConfig is the launch config flag of the launch command.
+	flagWithConfig = "with-config"
+
+	// flagArtifactsDir is the artifacts directory flag of the launch command.
+	flagArtifactsDir = "artifacts-dir"
+
+	// WasmArtifactName is the name of the launch output of the wasm artifacts.
+	WasmArtifactName = "wasm_artifacts"
+)
+
+const (
+	// builtin artifact names, which are stored from the embedded wasm files
+	// when the artifact path is empty.
+	cw721BaseArtifact  = "cw721_base"
+	ics721BaseArtifact = "ics721_base"
+)
+
+var builtinArtifacts = map[string][]byte{
+	cw721BaseArtifact:  wasm.CW721Base,
+	ics721BaseArtifact: wasm.ICS721Base,
+}
+
+// WasmLaunchConfig is the `wasm_config` section of the launch config, which
+// defines the wasm artifacts stored and instantiated at the launch.
+type WasmLaunchConfig struct {
+	// Artifacts are stored in order, and the contracts are instantiated
+	// right after their codes are stored.
+	Artifacts []WasmArtifact `json:"artifacts"`
+
+	// NFTTransferContract is the name of the artifact whose contract is bound
+	// to the nft-transfer channel.
+	NFTTransferContract string `json:"nft_transfer_contract,omitempty"`
+
+	// baseDir is the directory of the launch config, which the artifact paths
+	// are relative to.
+	baseDir string
+}
+
+// WasmArtifact defines a wasm code to store at the launch.
+type WasmArtifact struct {
+	Name string `json:"name"`
+
+	// Path is the path of the wasm file, relative to the launch config. The
+	// embedded wasm file is used for the builtin artifacts if it is empty.
+	Path string `json:"path,omitempty"`
+
+	// Instantiate instantiates a contract from the code if it is not nil.
+	Instantiate *WasmInstantiate `json:"instantiate,omitempty"`
+}
+
+// WasmInstantiate defines a contract instantiation at the launch.
+type WasmInstantiate struct {
+	Label string          `json:"label"`
+	Msg   json.RawMessage `json:"msg"`
+
+	// CodeIDArgs sets the top level fields of the msg to the code ids of the
+	// artifacts, e.g. {"cw721_base_code_id": "cw721_base"}.
+	CodeIDArgs map[string]string `json:"code_id_args,omitempty"`
+
+	// Admin is the admin of the contract, defaults to the validator.
+	Admin string `json:"admin,omitempty"`
+
+	// ExpectedAddress fails the launch if the instantiated contract address
+	// is different from it.
+	ExpectedAddress string `json:"expected_address,omitempty"`
+}
+
+// DefaultWasmLaunchConfig returns the default wasm launch config, which stores
+// cw721 and ics721, and opens the nft-transfer channel with the ics721.
+func DefaultWasmLaunchConfig() *WasmLaunchConfig {
+	return &WasmLaunchConfig{
+		Artifacts: []WasmArtifact{
+			{Name: cw721BaseArtifact},
+			{
+				Name: ics721BaseArtifact,
+				Instantiate: &WasmInstantiate{
+					Label:      "ics721",
+					Msg:        json.RawMessage(`{}`),
+					CodeIDArgs: map[string]string{"cw721_base_code_id": cw721BaseArtifact},
+				},
+			},
+		},
+		NFTTransferContract: ics721BaseArtifact,
+	}
+}
+
+// NewWasmLaunchConfig reads the `wasm_config` section of the launch config,
+// and returns the default config if the section does not exist.
+func NewWasmLaunchConfig(path string) (*WasmLaunchConfig, error) {
+	if path == "" {
+		return DefaultWasmLaunchConfig(), nil
+	}
+
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read file: %s", path)
+	}
+
+	var config struct {
+		WasmConfig *WasmLaunchConfig `json:"wasm_config,omitempty"`
+	}
+	if err := json.Unmarshal(bz, &config); err != nil {
+		return nil, err
+	}
+
+	if config.WasmConfig == nil {
+		return DefaultWasmLaunchConfig(), nil
+	}
+
+	config.WasmConfig.baseDir = filepath.Dir(path)
+	if err := config.WasmConfig.Validate(); err != nil {
+		return nil, err
+	}
+
+	return config.WasmConfig, nil
+}
+
+// Validate checks the artifact names are unique, and the code id args and the
+// nft transfer contract refer to the known artifacts.
+func (c WasmLaunchConfig) Validate() error {
+	stored := make(map[string]bool, len(c.Artifacts))
+	instantiated := make(map[string]bool, len(c.Artifacts))
+	for _, artifact := range c.Artifacts {
+		if artifact.Name == "" {
+			return errors.New("empty artifact name")
+		}
+		if stored[artifact.Name] {
+			return fmt.Errorf("duplicate artifact: %s", artifact.Name)
+		}
+		if _, ok := builtinArtifacts[artifact.Name]; !ok && artifact.Path == "" {
+			return fmt.Errorf("empty path of artifact: %s", artifact.Name)
+		}
+		stored[artifact.Name] = true
+
+		if artifact.Instantiate == nil {
+			continue
+		}
+
+		if !json.Valid(artifact.Instantiate.Msg) {
+			return fmt.Errorf("invalid instantiate msg of artifact: %s", artifact.Name)
+		}
+		for _, name := range artifact.Instantiate.CodeIDArgs {
+			if !stored[name] {
+				return fmt.Errorf("code id arg of %s refers to the artifact stored later or unknown: %s", artifact.Name, name)
+			}
+		}
+		instantiated[artifact.Name] = true
+	}
+
+	if !instantiated[c.NFTTransferContract] {
+		return fmt.Errorf("nft transfer contract is not instantiated: %s", c.NFTTransferContract)
+	}
+
+	return nil
+}
+
+// WasmCode returns the wasm code of the artifact.
+func (c WasmLaunchConfig) WasmCode(artifact WasmArtifact) ([]byte, error) {
+	if artifact.Path == "" {
+		return builtinArtifacts[artifact.Name], nil
+	}
+
+	path := artifact.Path
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(c.baseDir, path)
+	}
+
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read %s", path)
+	}
+
+	return bz, nil
+}
+
+// InstantiateMsg returns the instantiate msg with the code id args set.
+func (i WasmInstantiate) InstantiateMsg(codeIDs map[string]uint64) ([]byte, error) {
+	if len(i.CodeIDArgs) == 0 {
+		return i.Msg, nil
+	}
+
+	var msg map[string]json.RawMessage
+	if err := json.Unmarshal(i.Msg, &msg); err != nil {
+		return nil, errors.Wrap(err, "instantiate msg with code id args must be a json object")
+	}
+	if msg == nil {
+		msg = make(map[string]json.RawMessage)
+	}
+
+	for field, name := range i.CodeIDArgs {
+		codeID, ok := codeIDs[name]
+		if !ok {
+			return nil, fmt.Errorf("code id of %s not found", name)
+		}
+
+		msg[field] = json.RawMessage(fmt.Sprintf("%d", codeID))
+	}
+
+	return json.Marshal(msg)
+}
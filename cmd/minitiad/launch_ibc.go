@@ -0,0 +1,164 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	relayercmd "github.com/cosmos/relayer/v2/cmd"
+	relayertypes "github.com/cosmos/relayer/v2/relayer"
+	relayerconfig "github.com/cosmos/relayer/v2/relayer/chains/cosmos"
+	"github.com/initia-labs/OPinit/contrib/launchtools"
+	"github.com/initia-labs/OPinit/contrib/launchtools/steps"
+	"github.com/pkg/errors"
+)
+
+// relayerHomeRecorder is implemented by the launcher of a resumable step, which
+// records the relayer home to restore the relayer when the step is skipped on
+// resume.
+type relayerHomeRecorder interface {
+	SetRelayerHome(home string)
+}
+
+// EstablishIBCChannelsWithNFTTransfer establishes the transfer channel, and the
+// nft-transfer channel with the port of the instantiated nft transfer contract.
+//
+// It follows steps.EstablishIBCChannelsWithNFTTransfer, but the relayer home is
+// the relayer directory next to the launch state instead of a temp dir, so the
+// relayer can be restored when the step is skipped on resume.
+func EstablishIBCChannelsWithNFTTransfer(wasmConfig *WasmLaunchConfig, state *wasmLaunchState, launchState *LaunchState) launchtools.LauncherStepFuncFactory[*launchtools.Config] {
+	return func(input *launchtools.Config) launchtools.LauncherStepFunc {
+		return func(ctx launchtools.Launcher) error {
+			if !ctx.IsAppInitialized() {
+				return errors.New("app is not initialized")
+			}
+
+			contractAddr, found := state.Contracts[wasmConfig.NFTTransferContract]
+			if !found {
+				return errors.Errorf("nft transfer contract %s is not instantiated", wasmConfig.NFTTransferContract)
+			}
+
+			// the relayer changes the bech32 prefix of the accounts, so it is
+			// reset after the relayer setup is done
+			originPrefix := sdk.GetConfig().GetBech32AccountAddrPrefix()
+			originPubPrefix := sdk.GetConfig().GetBech32AccountPubPrefix()
+			defer sdk.GetConfig().SetBech32PrefixForAccount(originPrefix, originPubPrefix)
+
+			home := filepath.Join(filepath.Dir(launchState.path), relayerHomeDirName)
+			if err := linkLaunchChains(ctx.Context(), home, input, "wasm."+contractAddr); err != nil {
+				return err
+			}
+
+			if recorder, ok := ctx.(relayerHomeRecorder); ok {
+				recorder.SetRelayerHome(home)
+			}
+
+			ctx.SetRelayer(steps.NewRelayer(ctx.Context(), home, ctx.Logger()))
+			return nil
+		}
+	}
+}
+
+// linkLaunchChains initializes the relayer in the home, and creates the
+// transfer channel and the nft-transfer channel between the L2 and the L1.
+func linkLaunchChains(ctx context.Context, home string, input *launchtools.Config, nftTransferPort string) error {
+	// the relayer is initialized again if the previous link was interrupted
+	if err := os.RemoveAll(home); err != nil {
+		return err
+	}
+	if err := runLaunchRelayer(ctx, home, "config", "init"); err != nil {
+		return err
+	}
+
+	chains := []struct {
+		chainID   string
+		rpcAddr   string
+		prefix    string
+		gasPrices string
+	}{
+		{input.L1Config.ChainID, input.L1Config.RPC_URL, "init", input.L1Config.GasPrices},
+		{input.L2Config.ChainID, "http://localhost:26657", sdk.GetConfig().GetBech32AccountAddrPrefix(), ""},
+	}
+	for _, chain := range chains {
+		chainConfig := struct {
+			Type  string                             `json:"type"`
+			Value relayerconfig.CosmosProviderConfig `json:"value"`
+		}{
+			Type: "cosmos",
+			Value: relayerconfig.CosmosProviderConfig{
+				Key:            steps.RelayerKeyName,
+				ChainID:        chain.chainID,
+				RPCAddr:        chain.rpcAddr,
+				AccountPrefix:  chain.prefix,
+				KeyringBackend: steps.KeyringBackend,
+				GasAdjustment:  1.5,
+				GasPrices:      chain.gasPrices,
+				Debug:          true,
+				Timeout:        "160s",
+				OutputFormat:   "json",
+			},
+		}
+
+		chainFile := filepath.Join(home, fmt.Sprintf("%s.json", chain.chainID))
+		if err := writeLaunchRelayerJSON(chainFile, chainConfig); err != nil {
+			return err
+		}
+
+		if err := runLaunchRelayer(ctx, home, "chains", "add", "--file", chainFile, chain.chainID); err != nil {
+			return err
+		}
+
+		// the relayer key is the bridge executor, which is funded on both chains
+		if err := runLaunchRelayer(ctx, home, "keys", "restore", chain.chainID, steps.RelayerKeyName, input.SystemKeys.BridgeExecutor.Mnemonic); err != nil {
+			return err
+		}
+	}
+
+	pathFile := filepath.Join(home, "paths.json")
+	if err := writeLaunchRelayerJSON(pathFile, relayertypes.Path{
+		Src: &relayertypes.PathEnd{ChainID: input.L2Config.ChainID},
+		Dst: &relayertypes.PathEnd{ChainID: input.L1Config.ChainID},
+	}); err != nil {
+		return err
+	}
+
+	if err := runLaunchRelayer(ctx, home, "paths", "add", input.L2Config.ChainID, input.L1Config.ChainID, steps.RelayerPathName, "--file", pathFile); err != nil {
+		return err
+	}
+
+	if err := runLaunchRelayer(ctx, home, "tx", "link", steps.RelayerPathName); err != nil {
+		return errors.Wrap(err, "failed to link the transfer channel")
+	}
+
+	if err := runLaunchRelayer(ctx, home,
+		"tx", "link", steps.RelayerPathName,
+		"--src-port", nftTransferPort,
+		"--dst-port", "nft-transfer",
+		"--version", "ics721-1",
+	); err != nil {
+		return errors.Wrap(err, "failed to link the nft-transfer channel")
+	}
+
+	return nil
+}
+
+// runLaunchRelayer runs the relayer command in-process.
+func runLaunchRelayer(ctx context.Context, home string, args ...string) error {
+	cmd := relayercmd.NewRootCmd(nil)
+	cmd.SilenceUsage = true
+	cmd.SetArgs(append(args, "--home", home))
+
+	return cmd.ExecuteContext(ctx)
+}
+
+func writeLaunchRelayerJSON(path string, v any) error {
+	bz, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, bz, 0600)
+}
@@ -0,0 +1,221 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	"github.com/initia-labs/OPinit/contrib/launchtools"
+	"github.com/initia-labs/OPinit/contrib/launchtools/steps"
+	"github.com/pkg/errors"
+)
+
+const (
+	// flagResume is the flag of the launch command to resume the launch from
+	// the launch state file.
+	flagResume = "resume"
+
+	// LaunchStateFileName is the name of the launch state file, which is
+	// written to the artifacts directory.
+	LaunchStateFileName = "launch_state.json"
+
+	// relayerHomeDirName is the directory in the artifacts directory, where the
+	// relayer homes of the launch are created.
+	relayerHomeDirName = "relayer"
+
+	// launchConfigFileName is the name of the launch config written from the
+	// launch state on resume.
+	launchConfigFileName = "launch_config.json"
+)
+
+// LaunchState records the completed launch steps and their outputs, so the
+// launch can be resumed from the failed step.
+type LaunchState struct {
+	// Config is the finalized launch config. The launch config is finalized
+	// again on every run, which generates new system keys and a new chain id
+	// if they are not pinned, so the resumed launch must use the recorded one
+	// to match the genesis and the keyring on disk.
+	Config *launchtools.Config `json:"config,omitempty"`
+
+	Steps map[string]*LaunchStepState `json:"steps"`
+
+	// path is the path of the launch state file.
+	path string
+}
+
+// LaunchStepState is the state of a completed launch step.
+type LaunchStepState struct {
+	// Outputs are the launch outputs written by the step.
+	Outputs map[string]string `json:"outputs,omitempty"`
+
+	// BridgeID is the bridge id set by the step.
+	BridgeID *uint64 `json:"bridge_id,omitempty"`
+
+	// RelayerHome is the home of the relayer set by the step.
+	RelayerHome string `json:"relayer_home,omitempty"`
+}
+
+// NewLaunchState returns an empty launch state, which is saved to the path.
+func NewLaunchState(path string) *LaunchState {
+	return &LaunchState{
+		Steps: make(map[string]*LaunchStepState),
+		path:  path,
+	}
+}
+
+// LoadLaunchState reads the launch state file, and returns an empty launch
+// state if the file does not exist.
+func LoadLaunchState(path string) (*LaunchState, error) {
+	state := NewLaunchState(path)
+
+	bz, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return state, nil
+	} else if err != nil {
+		return nil, errors.Wrapf(err, "failed to read launch state: %s", path)
+	}
+
+	if err := json.Unmarshal(bz, state); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse launch state: %s", path)
+	}
+	if state.Steps == nil {
+		state.Steps = make(map[string]*LaunchStepState)
+	}
+
+	return state, nil
+}
+
+// Save writes the launch state file.
+func (s *LaunchState) Save() error {
+	bz, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(s.path), os.ModePerm); err != nil {
+		return err
+	}
+
+	return os.WriteFile(s.path, bz, 0600)
+}
+
+// WriteConfig writes the recorded launch config next to the launch state, and
+// returns its path. The launch config is passed to the resumed launch, whose
+// finalization keeps all the recorded fields.
+func (s *LaunchState) WriteConfig() (string, error) {
+	if s.Config == nil {
+		return "", errors.Errorf("launch config is not recorded in %s", s.path)
+	}
+
+	bz, err := json.MarshalIndent(s.Config, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	path := filepath.Join(filepath.Dir(s.path), launchConfigFileName)
+	if err := os.WriteFile(path, bz, 0600); err != nil {
+		return "", err
+	}
+
+	return path, nil
+}
+
+// IsCompleted returns true if the step is recorded as completed.
+func (s *LaunchState) IsCompleted(name string) bool {
+	_, ok := s.Steps[name]
+	return ok
+}
+
+// RecordLaunchConfig records the finalized launch config to the launch state.
+// It must be the first step, so the config is recorded before the genesis and
+// the keyring are created from it.
+func RecordLaunchConfig(state *LaunchState) launchtools.LauncherStepFuncFactory[*launchtools.Config] {
+	return func(input *launchtools.Config) launchtools.LauncherStepFunc {
+		return func(ctx launchtools.Launcher) error {
+			if state.Config != nil {
+				return nil
+			}
+
+			state.Config = input
+			return state.Save()
+		}
+	}
+}
+
+// ResumableStep records the completion of the step to the launch state, and
+// skips the step on resume if it is already completed. The outputs, bridge id
+// and relayer of the skipped step are restored to the launcher.
+func ResumableStep(name string, state *LaunchState, factory launchtools.LauncherStepFuncFactory[*launchtools.Config]) launchtools.LauncherStepFuncFactory[*launchtools.Config] {
+	return func(input *launchtools.Config) launchtools.LauncherStepFunc {
+		stepFn := factory(input)
+
+		return func(ctx launchtools.Launcher) error {
+			if stepState, ok := state.Steps[name]; ok {
+				ctx.Logger().Info("Skipping completed launch step", "step", name)
+				return restoreLaunchStep(ctx, stepState)
+			}
+
+			recorder := &launchStepRecorder{
+				Launcher: ctx,
+				state:    &LaunchStepState{Outputs: make(map[string]string)},
+			}
+			if err := stepFn(recorder); err != nil {
+				return err
+			}
+
+			state.Steps[name] = recorder.state
+			if err := state.Save(); err != nil {
+				return errors.Wrapf(err, "failed to save launch state after %s", name)
+			}
+
+			return nil
+		}
+	}
+}
+
+func restoreLaunchStep(ctx launchtools.Launcher, stepState *LaunchStepState) error {
+	for name, data := range stepState.Outputs {
+		if err := ctx.WriteOutput(name, data); err != nil {
+			return err
+		}
+	}
+
+	if stepState.BridgeID != nil {
+		ctx.SetBridgeId(*stepState.BridgeID)
+	}
+
+	if stepState.RelayerHome != "" {
+		if _, err := os.Stat(stepState.RelayerHome); err != nil {
+			return errors.Wrapf(err, "failed to find relayer home: %s", stepState.RelayerHome)
+		}
+
+		ctx.SetRelayer(steps.NewRelayer(ctx.Context(), stepState.RelayerHome, ctx.Logger()))
+	}
+
+	return nil
+}
+
+var _ relayerHomeRecorder = (*launchStepRecorder)(nil)
+
+// launchStepRecorder records the outputs and the bridge id set by a step.
+type launchStepRecorder struct {
+	launchtools.Launcher
+
+	state *LaunchStepState
+}
+
+func (r *launchStepRecorder) WriteOutput(name string, data string) error {
+	r.state.Outputs[name] = data
+	return r.Launcher.WriteOutput(name, data)
+}
+
+func (r *launchStepRecorder) SetBridgeId(id uint64) {
+	r.state.BridgeID = &id
+	r.Launcher.SetBridgeId(id)
+}
+
+// SetRelayerHome records the relayer home, which is restored to the launcher
+// when the step is skipped on resume.
+func (r *launchStepRecorder) SetRelayerHome(home string) {
+	r.state.RelayerHome = home
+}
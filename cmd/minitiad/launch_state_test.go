@@ -0,0 +1,76 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/go-bip39"
+
+	"github.com/initia-labs/OPinit/contrib/launchtools"
+)
+
+func Test_ResumeLaunchConfig(t *testing.T) {
+	dir := t.TempDir()
+
+	// the config pins only the L1 config, so the L2 chain id and the system
+	// keys are generated on every finalization
+	configPath := filepath.Join(dir, "config.json")
+	require.NoError(t, os.WriteFile(configPath, []byte(`{"l1_config":{"chain_id":"initiation-1","rpc_url":"http://localhost:26657"}}`), 0600))
+
+	entropy, err := bip39.NewEntropy(256)
+	require.NoError(t, err)
+	mnemonic, err := bip39.NewMnemonic(entropy)
+	require.NoError(t, err)
+
+	// answers to the da layer, oracle and bridge executor prompts
+	prompts := "n\nn\n" + mnemonic + "\n"
+	finalize := func(path, prompts string) *launchtools.Config {
+		config, err := launchtools.NewConfig(path)
+		require.NoError(t, err)
+		require.NoError(t, config.Finalize(bufio.NewReader(strings.NewReader(prompts))))
+		return config
+	}
+
+	// the first launch records the finalized config before any other step
+	config := finalize(configPath, prompts)
+	statePath := filepath.Join(dir, LaunchStateFileName)
+	state := NewLaunchState(statePath)
+	require.NoError(t, RecordLaunchConfig(state)(config)(nil))
+	state.Steps["initialize_genesis"] = &LaunchStepState{}
+	require.NoError(t, state.Save())
+
+	// finalizing the unpinned config again generates other keys and chain id
+	other := finalize(configPath, prompts)
+	require.NotEqual(t, config.L2Config.ChainID, other.L2Config.ChainID)
+	require.NotEqual(t, config.SystemKeys.Validator.Mnemonic, other.SystemKeys.Validator.Mnemonic)
+
+	// the resumed launch is finalized from the recorded config without prompts
+	state, err = LoadLaunchState(statePath)
+	require.NoError(t, err)
+	resumeConfigPath, err := state.WriteConfig()
+	require.NoError(t, err)
+
+	resumed := finalize(resumeConfigPath, "")
+	expected, err := json.Marshal(config)
+	require.NoError(t, err)
+	actual, err := json.Marshal(resumed)
+	require.NoError(t, err)
+	require.JSONEq(t, string(expected), string(actual))
+
+	// the recorded config is not overwritten by the resumed launch
+	require.NoError(t, RecordLaunchConfig(state)(other)(nil))
+	require.Equal(t, config.L2Config.ChainID, state.Config.L2Config.ChainID)
+}
+
+func Test_ResumeLaunchConfig_NotRecorded(t *testing.T) {
+	state := NewLaunchState(filepath.Join(t.TempDir(), LaunchStateFileName))
+
+	_, err := state.WriteConfig()
+	require.Error(t, err)
+}
@@ -1,21 +1,32 @@
 package main
 
 import (
+	"bytes"
 	"fmt"
+	"io"
+	"os"
 	"path/filepath"
 	"strconv"
+	"time"
 
 	cmtcmd "github.com/cometbft/cometbft/cmd/cometbft/commands"
 	"github.com/spf13/cobra"
 
-	dbm "github.com/cosmos/cosmos-db"
+	"cosmossdk.io/store/rootmulti"
+
 	"github.com/cosmos/cosmos-sdk/server"
-	"github.com/cosmos/cosmos-sdk/server/types"
+
+	kvindexerkeeper "github.com/initia-labs/kvindexer/x/kvindexer/keeper"
+
+	minitiaapp "github.com/initia-labs/miniwasm/app"
 )
 
+const flagDryRun = "dry-run"
+
 // NewMultipleRollbackCmd creates a command to rollback CometBFT and multistore state by one height.
-func NewMultipleRollbackCmd(appCreator types.AppCreator) *cobra.Command {
+func NewMultipleRollbackCmd() *cobra.Command {
 	removeBlock := false
+	dryRun := false
 	cmd := &cobra.Command{
 		Use:   "mrollback [height]",
 		Short: "rollback Cosmos SDK and CometBFT state to the given height",
@@ -24,6 +35,14 @@ A state rollback is performed to recover from an incorrect application state tra
 when CometBFT has persisted an incorrect app hash and is thus unable to make
 progress. Rollback overwrites a state with the state at the given height. All
 blocks after the given height are removed from the blockchain.
+
+The rollback is refused when the application database and the CometBFT state
+disagree, or when the target height has been pruned. The kvindexer database
+can not be rolled back, so it is invalidated by moving it aside, and the
+indexer starts over from an empty database.
+
+Use --dry-run to print the heights and the app hashes to be restored without
+changing anything.
 `,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -37,14 +56,21 @@ blocks after the given height are removed from the blockchain.
 				return fmt.Errorf("height must be greater than 0")
 			}
 
-			dataDir := filepath.Join(ctx.Config.RootDir, "data")
-			db, err := dbm.NewDB("application", server.GetAppDBBackend(ctx.Viper), dataDir)
+			// the app must be loaded only once, as it locks the wasm vm
+			app, db, err := openOfflineApp(ctx)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			plan, err := newRollbackPlan(ctx, app, height)
 			if err != nil {
 				return err
 			}
-			app := appCreator(ctx.Logger, db, nil, ctx.Viper)
-			if curHeight := app.CommitMultiStore().LatestVersion(); height >= curHeight {
-				return fmt.Errorf("height must be less than the current height %d", curHeight)
+
+			plan.print(cmd.OutOrStdout(), removeBlock)
+			if dryRun {
+				return nil
 			}
 
 			// rollback CometBFT state
@@ -52,16 +78,152 @@ blocks after the given height are removed from the blockchain.
 			if err != nil {
 				return fmt.Errorf("failed to rollback CometBFT state: %w", err)
 			}
-			// rollback the multistore
 
+			// rollback the multistore
 			if err := app.CommitMultiStore().RollbackToVersion(height); err != nil {
 				return fmt.Errorf("failed to rollback to version: %w", err)
 			}
 
-			fmt.Printf("Rolled back state to height %d and hash %X\n", height, hash)
+			// invalidate the kvindexer
+			if plan.indexerDBExists {
+				invalidatedDir := fmt.Sprintf("%s.invalidated-%d", plan.indexerDBDir, time.Now().Unix())
+				if err := os.Rename(plan.indexerDBDir, invalidatedDir); err != nil {
+					return fmt.Errorf("failed to invalidate kvindexer db: %w", err)
+				}
+
+				fmt.Fprintf(cmd.OutOrStdout(), "Moved kvindexer db to %s\n", invalidatedDir)
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "Rolled back state to height %d and hash %X\n", height, hash)
 			return nil
 		},
 	}
 	cmd.Flags().BoolVar(&removeBlock, "hard", false, "remove blocks as well as state")
+	cmd.Flags().BoolVar(&dryRun, flagDryRun, false, "print the heights and the app hashes to be restored without rolling back")
 	return cmd
 }
+
+// rollbackPlan is the result of the rollback safety checks.
+type rollbackPlan struct {
+	height     int64
+	curHeight  int64
+	curAppHash []byte
+
+	// appHash is the app hash of the multistore at the target height
+	appHash []byte
+
+	blockStoreHeight int64
+
+	indexerDBDir    string
+	indexerDBExists bool
+}
+
+// newRollbackPlan checks the application database and the CometBFT state
+// agree, and the target height is not pruned from both.
+func newRollbackPlan(ctx *server.Context, app *minitiaapp.MinitiaApp, height int64) (*rollbackPlan, error) {
+	rms, ok := app.CommitMultiStore().(*rootmulti.Store)
+	if !ok {
+		return nil, fmt.Errorf("unexpected multistore type")
+	}
+
+	lastCommitID := rms.LastCommitID()
+	if height >= lastCommitID.Version {
+		return nil, fmt.Errorf("height must be less than the current height %d", lastCommitID.Version)
+	}
+
+	// the target version must not be pruned
+	if _, err := rms.CacheMultiStoreWithVersion(height); err != nil {
+		return nil, fmt.Errorf(
+			"version %d is not available in the application db; pruning=%s, pruning-keep-recent=%d, pruning-interval=%d: %w",
+			height,
+			ctx.Viper.GetString(server.FlagPruning),
+			ctx.Viper.GetUint64(server.FlagPruningKeepRecent),
+			ctx.Viper.GetUint64(server.FlagPruningInterval),
+			err,
+		)
+	}
+
+	commitInfo, err := rms.GetCommitInfo(height)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get commit info at height %d: %w", height, err)
+	}
+
+	plan := &rollbackPlan{
+		height:     height,
+		curHeight:  lastCommitID.Version,
+		curAppHash: lastCommitID.Hash,
+		appHash:    commitInfo.Hash(),
+	}
+
+	if err := plan.checkCometBFT(ctx); err != nil {
+		return nil, err
+	}
+
+	dbDir, _ := getDBConfig(ctx.Viper)
+	plan.indexerDBDir = filepath.Join(dbDir, kvindexerkeeper.StoreName+".db")
+	if _, err := os.Stat(plan.indexerDBDir); err == nil {
+		plan.indexerDBExists = true
+	} else if !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	return plan, nil
+}
+
+func (p *rollbackPlan) checkCometBFT(ctx *server.Context) error {
+	blockStore, stateStore, err := loadCometBFTStores(ctx.Config)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = blockStore.Close()
+		_ = stateStore.Close()
+	}()
+
+	state, err := stateStore.Load()
+	if err != nil {
+		return err
+	}
+	if state.IsEmpty() {
+		return fmt.Errorf("no CometBFT state found")
+	}
+
+	if state.LastBlockHeight != p.curHeight {
+		return fmt.Errorf("application db height %d and CometBFT state height %d disagree", p.curHeight, state.LastBlockHeight)
+	}
+	if !bytes.Equal(state.AppHash, p.curAppHash) {
+		return fmt.Errorf("application db app hash %X and CometBFT state app hash %X disagree at height %d", p.curAppHash, state.AppHash, p.curHeight)
+	}
+
+	// the target block and the next block are required to restore the state
+	p.blockStoreHeight = blockStore.Height()
+	for _, height := range []int64{p.height, p.height + 1} {
+		if blockStore.LoadBlockMeta(height) == nil {
+			return fmt.Errorf(
+				"block %d is not available in the block store of base %d; min-retain-blocks=%d",
+				height, blockStore.Base(), ctx.Viper.GetUint64(server.FlagMinRetainBlocks),
+			)
+		}
+	}
+
+	// the app hash of the target height is agreed upon in the next block
+	if blockAppHash := blockStore.LoadBlockMeta(p.height + 1).Header.AppHash; !bytes.Equal(blockAppHash, p.appHash) {
+		return fmt.Errorf("application db app hash %X and block %d app hash %X disagree", p.appHash, p.height+1, blockAppHash)
+	}
+
+	return nil
+}
+
+func (p rollbackPlan) print(w io.Writer, removeBlock bool) {
+	fmt.Fprintf(w, "Current height: %d, app hash: %X\n", p.curHeight, p.curAppHash)
+	fmt.Fprintf(w, "Block store height: %d\n", p.blockStoreHeight)
+	fmt.Fprintf(w, "Target height: %d, app hash: %X\n", p.height, p.appHash)
+	if removeBlock {
+		fmt.Fprintf(w, "Blocks after height %d will be removed\n", p.height)
+	} else if p.blockStoreHeight > p.height+1 {
+		fmt.Fprintf(w, "WARNING: blocks after height %d are kept; the node can not start with the block store ahead of the state, use --hard to remove them\n", p.height+1)
+	}
+	if p.indexerDBExists {
+		fmt.Fprintf(w, "kvindexer db %s will be invalidated\n", p.indexerDBDir)
+	}
+}
@@ -98,8 +98,13 @@ func NewRootCmd() (*cobra.Command, params.EncodingConfig) {
 		Use:   basename,
 		Short: "minitia App",
 		PersistentPreRunE: func(cmd *cobra.Command, _ []string) error {
-			// except for launch command, seal the config
-			if cmd.Name() != "launch" {
+			// except for launch and testnet commands, which run the relayer
+			// in-process, seal the config. The relayer sets the bech32 prefixes
+			// of the chain it talks to on the global config, which panics once
+			// sealed. Leaving it unsealed is safe, as these commands only run
+			// the chains of this binary, so the relayer sets the same prefixes
+			// and the addresses of the in-process nodes are not changed.
+			if cmd.Name() != "launch" && !(cmd.HasParent() && cmd.Parent().Name() == "testnet") {
 				sdk.GetConfig().Seal()
 			}
 
@@ -178,7 +183,10 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig, b
 
 	// add launch commands
 	rootCmd.AddCommand(LaunchCommand(a, encodingConfig, basicManager))
-	rootCmd.AddCommand(NewMultipleRollbackCmd(a.AppCreator()))
+	rootCmd.AddCommand(NewMultipleRollbackCmd())
+	rootCmd.AddCommand(StateCommand())
+	rootCmd.AddCommand(ExecutorPlansCommand(encodingConfig))
+	rootCmd.AddCommand(NewTestnetCmd(basicManager))
 }
 
 func addModuleInitFlags(startCmd *cobra.Command) {
@@ -200,6 +208,10 @@ func genesisCommand(encodingConfig params.EncodingConfig, basicManager module.Ba
 		genutilcli.AddGenesisAccountCmd(minitiaapp.DefaultNodeHome, ac),
 		opchildcli.AddGenesisValidatorCmd(basicManager, encodingConfig.TxConfig, banktypes.GenesisBalancesIterator{}, minitiaapp.DefaultNodeHome),
 		opchildcli.AddFeeWhitelistCmd(minitiaapp.DefaultNodeHome, ac),
+		AddWasmCodeCmd(minitiaapp.DefaultNodeHome, ac),
+		AddContractCmd(minitiaapp.DefaultNodeHome, ac),
+		AddFactoryDenomCmd(minitiaapp.DefaultNodeHome, ac),
+		SetIBCHookACLCmd(minitiaapp.DefaultNodeHome, ac),
 		genutilcli.ValidateGenesisCmd(basicManager),
 		genutilcli.GenTxCmd(basicManager, encodingConfig.TxConfig, banktypes.GenesisBalancesIterator{}, minitiaapp.DefaultNodeHome, ac),
 	)
@@ -0,0 +1,261 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	cmtcfg "github.com/cometbft/cometbft/config"
+	cmtstate "github.com/cometbft/cometbft/state"
+	cmtstore "github.com/cometbft/cometbft/store"
+	"github.com/spf13/cobra"
+
+	storetypes "cosmossdk.io/store/types"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+
+	minitiaapp "github.com/initia-labs/miniwasm/app"
+)
+
+const (
+	flagHeight         = "height"
+	flagOutputDocument = "output-document"
+)
+
+// StateCommand returns the offline state subcommands, which run against the
+// application database of a stopped node.
+func StateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "state",
+		Short:                      "Offline state subcommands for surgical repairs of a stopped node",
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		ExportModuleStateCmd(),
+		ImportModuleStateCmd(),
+	)
+
+	return cmd
+}
+
+// ExportModuleStateCmd returns a command to export the genesis state of a
+// module at a height.
+func ExportModuleStateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-module [module]",
+		Short: "Export the genesis state of a module at the given height",
+		Long: `Export the genesis state of a module at the given height from the application database.
+The latest height is used if the height is not given.
+
+Example:
+$ minitiad state export-module tokenfactory --height 100 --output-document tokenfactory.json
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, err := cmd.Flags().GetInt64(flagHeight)
+			if err != nil {
+				return err
+			}
+
+			outputDocument, err := cmd.Flags().GetString(flagOutputDocument)
+			if err != nil {
+				return err
+			}
+
+			return withOfflineApp(cmd, func(app *minitiaapp.MinitiaApp) error {
+				moduleState, err := app.ExportModuleState(args[0], height)
+				if err != nil {
+					return err
+				}
+
+				bz, err := json.MarshalIndent(moduleState, "", "  ")
+				if err != nil {
+					return err
+				}
+
+				if outputDocument == "" {
+					_, err = fmt.Fprintln(cmd.OutOrStdout(), string(bz))
+					return err
+				}
+
+				return os.WriteFile(outputDocument, bz, 0o600)
+			})
+		},
+	}
+
+	cmd.Flags().Int64(flagHeight, 0, "Height to export the module state at; defaults to the latest height")
+	cmd.Flags().String(flagOutputDocument, "", "Exported module state is written to the given file instead of STDOUT")
+
+	return cmd
+}
+
+// ImportModuleStateCmd returns a command to rewrite the store of a module with
+// a genesis state.
+func ImportModuleStateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import-module [module] [json-file]",
+		Short: "Rewrite the store of a module at the latest height with the given genesis state",
+		Long: `Rewrite the stores of a module at the latest height with the given genesis state.
+Only the module stores are rewritten, and the writes of the module's InitGenesis to the other
+module stores are discarded.
+
+The app hash of the latest height H changes, so the app hash of H in the CometBFT state is
+updated in the same command. The new app hash is agreed upon in the header of block H+1, so
+every node of the chain must run the same import at the same height before H+1 is produced:
+
+1. Stop the sequencer and every full node at the same height H. The block store must not be
+   ahead of the CometBFT state, i.e. block H+1 must not be saved yet.
+2. Export the module state on one node, edit it, and distribute the same json file:
+   $ minitiad state export-module tokenfactory --output-document tokenfactory.json
+3. Run the import on every node, and compare the printed app hash across the nodes:
+   $ minitiad state import-module tokenfactory tokenfactory.json
+4. Start the nodes. A node which does not run the import halts at H+1 with an app hash
+   mismatch, and a node synced from a height below H later must state sync past H.
+
+The command refuses to run if the application db and the CometBFT state disagree on the
+latest height or app hash.
+
+Example:
+$ minitiad state import-module tokenfactory tokenfactory.json
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			moduleState, err := os.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+			if !json.Valid(moduleState) {
+				return fmt.Errorf("invalid json file: %s", args[1])
+			}
+
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			blockStore, stateStore, err := loadCometBFTStores(serverCtx.Config)
+			if err != nil {
+				return err
+			}
+			defer func() {
+				_ = blockStore.Close()
+				_ = stateStore.Close()
+			}()
+
+			return withOfflineApp(cmd, func(app *minitiaapp.MinitiaApp) error {
+				state, err := loadAgreedCometBFTState(blockStore, stateStore, app.CommitMultiStore().LastCommitID())
+				if err != nil {
+					return err
+				}
+
+				commitID, discardedStores, err := app.ImportModuleState(args[0], moduleState)
+				if err != nil {
+					return err
+				}
+
+				if err := saveCometBFTAppHash(stateStore, state, commitID.Hash); err != nil {
+					return fmt.Errorf("imported module %s state, but failed to update the CometBFT state app hash to %X: %w", args[0], commitID.Hash, err)
+				}
+
+				if len(discardedStores) != 0 {
+					fmt.Fprintf(cmd.ErrOrStderr(), "WARNING: discarded the writes to the stores %v\n", discardedStores)
+				}
+
+				_, err = fmt.Fprintf(cmd.OutOrStdout(), "Imported module %s state at height %d and hash %X\n", args[0], commitID.Version, commitID.Hash)
+				return err
+			})
+		},
+	}
+
+	return cmd
+}
+
+func loadCometBFTStores(config *cmtcfg.Config) (*cmtstore.BlockStore, cmtstate.Store, error) {
+	blockStoreDB, err := cmtcfg.DefaultDBProvider(&cmtcfg.DBContext{ID: "blockstore", Config: config})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	stateDB, err := cmtcfg.DefaultDBProvider(&cmtcfg.DBContext{ID: "state", Config: config})
+	if err != nil {
+		_ = blockStoreDB.Close()
+		return nil, nil, err
+	}
+
+	return cmtstore.NewBlockStore(blockStoreDB), cmtstate.NewStore(stateDB, cmtstate.StoreOptions{
+		DiscardABCIResponses: config.Storage.DiscardABCIResponses,
+	}), nil
+}
+
+// loadAgreedCometBFTState loads the CometBFT state, which must agree with the
+// last commit of the application db. The block store must not be ahead of the
+// state, as the next block would carry the app hash before the import.
+func loadAgreedCometBFTState(blockStore *cmtstore.BlockStore, stateStore cmtstate.Store, lastCommitID storetypes.CommitID) (cmtstate.State, error) {
+	state, err := stateStore.Load()
+	if err != nil {
+		return cmtstate.State{}, err
+	}
+	if state.IsEmpty() {
+		return cmtstate.State{}, errors.New("no CometBFT state found")
+	}
+
+	if state.LastBlockHeight != lastCommitID.Version {
+		return cmtstate.State{}, fmt.Errorf("application db height %d and CometBFT state height %d disagree", lastCommitID.Version, state.LastBlockHeight)
+	}
+	if !bytes.Equal(state.AppHash, lastCommitID.Hash) {
+		return cmtstate.State{}, fmt.Errorf("application db app hash %X and CometBFT state app hash %X disagree at height %d", lastCommitID.Hash, state.AppHash, state.LastBlockHeight)
+	}
+	if blockStore.Height() > state.LastBlockHeight {
+		return cmtstate.State{}, fmt.Errorf("block store height %d is ahead of CometBFT state height %d", blockStore.Height(), state.LastBlockHeight)
+	}
+
+	return state, nil
+}
+
+// saveCometBFTAppHash updates the app hash of the last height of the CometBFT
+// state, and of the finalize block response of the height if it is persisted,
+// so the handshake agrees with the application db.
+func saveCometBFTAppHash(stateStore cmtstate.Store, state cmtstate.State, appHash []byte) error {
+	state.AppHash = appHash
+	if err := stateStore.Save(state); err != nil {
+		return err
+	}
+
+	res, err := stateStore.LoadFinalizeBlockResponse(state.LastBlockHeight)
+	if err != nil {
+		// the responses are not persisted with discard_abci_responses
+		return nil
+	}
+
+	res.AppHash = appHash
+	return stateStore.SaveFinalizeBlockResponse(state.LastBlockHeight, res)
+}
+
+// withOfflineApp loads the app from the application database of the node.
+func withOfflineApp(cmd *cobra.Command, fn func(app *minitiaapp.MinitiaApp) error) error {
+	app, db, err := openOfflineApp(server.GetServerContextFromCmd(cmd))
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	return fn(app)
+}
+
+// openOfflineApp opens the application database of a stopped node, and loads
+// the app without the kvindexer and the inter-block cache.
+func openOfflineApp(ctx *server.Context) (*minitiaapp.MinitiaApp, dbm.DB, error) {
+	dataDir := filepath.Join(ctx.Config.RootDir, "data")
+	db, err := dbm.NewDB("application", server.GetAppDBBackend(ctx.Viper), dataDir)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	app := minitiaapp.NewMinitiaApp(ctx.Logger, db, dbm.NewMemDB(), nil, true, []wasmkeeper.Option{}, ctx.Viper)
+	return app, db, nil
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	cmtstate "github.com/cometbft/cometbft/state"
+	cmtstore "github.com/cometbft/cometbft/store"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	storetypes "cosmossdk.io/store/types"
+)
+
+func newTestCometBFTState(t *testing.T, height int64, appHash []byte) (*cmtstore.BlockStore, cmtstate.Store) {
+	pubKey := ed25519.GenPrivKey().PubKey()
+	state, err := cmtstate.MakeGenesisState(&cmttypes.GenesisDoc{
+		ChainID:     "minitia-1",
+		GenesisTime: time.Now(),
+		Validators:  []cmttypes.GenesisValidator{{Address: pubKey.Address(), PubKey: pubKey, Power: 1}},
+	})
+	require.NoError(t, err)
+	state.LastBlockHeight = height
+	state.LastValidators = state.Validators.Copy()
+	state.AppHash = appHash
+
+	stateStore := cmtstate.NewStore(dbm.NewMemDB(), cmtstate.StoreOptions{})
+	require.NoError(t, stateStore.Save(state))
+	require.NoError(t, stateStore.SaveFinalizeBlockResponse(height, &abci.ResponseFinalizeBlock{AppHash: appHash}))
+
+	return cmtstore.NewBlockStore(dbm.NewMemDB()), stateStore
+}
+
+func Test_ImportModuleState_CometBFTAppHash(t *testing.T) {
+	oldHash, newHash := []byte("old_app_hash"), []byte("new_app_hash")
+	blockStore, stateStore := newTestCometBFTState(t, 10, oldHash)
+
+	state, err := loadAgreedCometBFTState(blockStore, stateStore, storetypes.CommitID{Version: 10, Hash: oldHash})
+	require.NoError(t, err)
+	require.NoError(t, saveCometBFTAppHash(stateStore, state, newHash))
+
+	// the handshake compares the app hash of the state with the application db
+	state, err = stateStore.Load()
+	require.NoError(t, err)
+	require.Equal(t, int64(10), state.LastBlockHeight)
+	require.Equal(t, newHash, state.AppHash)
+
+	res, err := stateStore.LoadFinalizeBlockResponse(10)
+	require.NoError(t, err)
+	require.Equal(t, newHash, res.AppHash)
+
+	// the state agrees with the imported application db
+	_, err = loadAgreedCometBFTState(blockStore, stateStore, storetypes.CommitID{Version: 10, Hash: newHash})
+	require.NoError(t, err)
+}
+
+func Test_ImportModuleState_CometBFTDisagreement(t *testing.T) {
+	appHash := []byte("app_hash")
+	blockStore, stateStore := newTestCometBFTState(t, 10, appHash)
+
+	_, err := loadAgreedCometBFTState(blockStore, stateStore, storetypes.CommitID{Version: 11, Hash: appHash})
+	require.ErrorContains(t, err, "height")
+
+	_, err = loadAgreedCometBFTState(blockStore, stateStore, storetypes.CommitID{Version: 10, Hash: []byte("other")})
+	require.ErrorContains(t, err, "app hash")
+
+	_, err = loadAgreedCometBFTState(blockStore, cmtstate.NewStore(dbm.NewMemDB(), cmtstate.StoreOptions{}), storetypes.CommitID{Version: 10, Hash: appHash})
+	require.ErrorContains(t, err, "no CometBFT state")
+}
@@ -0,0 +1,620 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	tmcfg "github.com/cometbft/cometbft/config"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/server"
+	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+
+	opchildtypes "github.com/initia-labs/OPinit/x/opchild/types"
+
+	minitiaapp "github.com/initia-labs/miniwasm/app"
+	minitiatypes "github.com/initia-labs/miniwasm/types"
+)
+
+const (
+	flagNumValidators = "v"
+	flagOutputDir     = "output-dir"
+	flagL1ChainID     = "l1-chain-id"
+	flagNumAccounts   = "num-accounts"
+	flagCommitTimeout = "commit-timeout"
+	flagPortOffset    = "port-offset"
+	flagEnableLogging = "enable-logging"
+
+	// TestnetFileName is the name of the testnet layout file, which is written
+	// to the output directory by init-files and read by start.
+	TestnetFileName = "testnet.json"
+
+	// TestnetAccountsFileName is the name of the file of the testnet account
+	// mnemonics, which is written to the output directory.
+	TestnetAccountsFileName = "accounts.json"
+
+	// testnetValidatorKeyName is the key name of the validator account in the
+	// keyring of each node.
+	testnetValidatorKeyName = "validator"
+
+	// testnetRelayerKeyName is the name of the relayer account.
+	testnetRelayerKeyName = "relayer"
+
+	// testnetPortStep is the port distance between the nodes.
+	testnetPortStep = 10
+
+	// testnetNFTTransferVersion is the channel version of the ics721 contracts.
+	testnetNFTTransferVersion = "ics721-1"
+)
+
+// default ports of the nodes, which are shifted by the port step for each node
+const (
+	testnetP2PPort  = 26656
+	testnetRPCPort  = 26657
+	testnetGRPCPort = 9090
+	testnetAPIPort  = 1317
+)
+
+// TestnetLayout is the layout of the testnet written to testnet.json.
+type TestnetLayout struct {
+	// L2 is the rollup chain with the opchild validators.
+	L2 TestnetChain `json:"l2"`
+
+	// L1 is the mock L1 chain, a single node chain of the same binary, which
+	// the L2 is connected to over IBC.
+	L1 TestnetChain `json:"l1"`
+
+	Relayer TestnetRelayer `json:"relayer"`
+}
+
+// TestnetChain is a chain of the testnet.
+type TestnetChain struct {
+	ChainID string        `json:"chain_id"`
+	Nodes   []TestnetNode `json:"nodes"`
+
+	// NFTTransferPort is the ibc port of the ics721 contract of the chain.
+	NFTTransferPort string `json:"nft_transfer_port"`
+}
+
+// TestnetNode is a node of a testnet chain.
+type TestnetNode struct {
+	Moniker string `json:"moniker"`
+
+	// Home is the node home, relative to the output directory.
+	Home string `json:"home"`
+
+	RPC  string `json:"rpc"`
+	GRPC string `json:"grpc"`
+	API  string `json:"api"`
+}
+
+// TestnetRelayer is the in-process relayer between the L2 and the L1.
+type TestnetRelayer struct {
+	// Home is the relayer home, relative to the output directory.
+	Home string `json:"home"`
+
+	// Path is the relayer path name of the L2 and the L1.
+	Path string `json:"path"`
+
+	// Linked is set once the transfer and the nft-transfer channels are
+	// created, so the channels are not created again on restart.
+	Linked bool `json:"linked"`
+}
+
+// TestnetAccount is a funded account of the testnet.
+type TestnetAccount struct {
+	Name     string `json:"name"`
+	Address  string `json:"address"`
+	Mnemonic string `json:"mnemonic"`
+}
+
+// testnetNodeKey is the validator of a node, generated by init-files.
+type testnetNodeKey struct {
+	nodeID  string
+	pubKey  cryptotypes.PubKey
+	address sdk.AccAddress
+}
+
+// NewTestnetCmd returns the testnet subcommands, which run a local devnet of
+// the L2, a mock L1 and a relayer on a single machine.
+func NewTestnetCmd(mbm module.BasicManager) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "testnet",
+		Short:                      "Subcommands for running a local rollup devnet",
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		TestnetInitFilesCmd(mbm),
+		TestnetStartCmd(),
+	)
+
+	return cmd
+}
+
+// TestnetInitFilesCmd returns a command to initialize the files of a local
+// devnet.
+func TestnetInitFilesCmd(mbm module.BasicManager) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "init-files",
+		Short: "Initialize the files of a local rollup devnet",
+		Long: `Initialize the node homes of the L2 with the given number of validators, and of a
+single node mock L1, which is a chain of the same binary. Both chains have the cw721 and
+the ics721 contracts stored, and the validators, the relayer and the test accounts funded.
+The validator of the first L2 node is the opchild admin and the bridge executor.
+
+The layout of the devnet is written to testnet.json, and the mnemonics of the accounts to
+accounts.json in the output directory. The test accounts are also added to the keyring of
+the first node of each chain.
+
+Example:
+$ minitiad testnet init-files --v 2 --output-dir ./.testnets
+$ minitiad testnet start --output-dir ./.testnets
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			outputDir, _ := cmd.Flags().GetString(flagOutputDir)
+			chainID, _ := cmd.Flags().GetString(flags.FlagChainID)
+			l1ChainID, _ := cmd.Flags().GetString(flagL1ChainID)
+			numValidators, _ := cmd.Flags().GetInt(flagNumValidators)
+			numAccounts, _ := cmd.Flags().GetInt(flagNumAccounts)
+			commitTimeout, _ := cmd.Flags().GetDuration(flagCommitTimeout)
+			portOffset, _ := cmd.Flags().GetInt(flagPortOffset)
+			denom, _ := cmd.Flags().GetString(FlagDenom)
+			minGasPrices, _ := cmd.Flags().GetString(server.FlagMinGasPrices)
+
+			if numValidators < 1 {
+				return errors.New("number of validators must be at least 1")
+			}
+			if chainID == l1ChainID {
+				return errors.New("chain ids of the L2 and the L1 must be different")
+			}
+
+			return initTestnetFiles(cmd.OutOrStdout(), clientCtx, mbm, testnetArgs{
+				outputDir:     outputDir,
+				chainID:       chainID,
+				l1ChainID:     l1ChainID,
+				numValidators: numValidators,
+				numAccounts:   numAccounts,
+				commitTimeout: commitTimeout,
+				portOffset:    portOffset,
+				denom:         denom,
+				minGasPrices:  minGasPrices,
+			})
+		},
+	}
+
+	cmd.Flags().Int(flagNumValidators, 2, "Number of validators of the L2")
+	cmd.Flags().String(flagOutputDir, "./.testnets", "Directory to store the devnet files")
+	cmd.Flags().String(flags.FlagChainID, "testnet-l2", "Chain id of the L2")
+	cmd.Flags().String(flagL1ChainID, "testnet-l1", "Chain id of the mock L1")
+	cmd.Flags().Int(flagNumAccounts, 5, "Number of funded test accounts")
+	cmd.Flags().Duration(flagCommitTimeout, 500*time.Millisecond, "Time to wait after a block commit before starting the next height")
+	cmd.Flags().Int(flagPortOffset, 0, fmt.Sprintf("Offset added to the default ports of the nodes, which are also shifted by %d for each node", testnetPortStep))
+	cmd.Flags().String(FlagDenom, minitiatypes.BaseDenom, "Denom of the chains")
+	cmd.Flags().String(server.FlagMinGasPrices, fmt.Sprintf("0%s", minitiatypes.BaseDenom), "Minimum gas prices of the nodes")
+
+	return cmd
+}
+
+type testnetArgs struct {
+	outputDir     string
+	chainID       string
+	l1ChainID     string
+	numValidators int
+	numAccounts   int
+	commitTimeout time.Duration
+	portOffset    int
+	denom         string
+	minGasPrices  string
+}
+
+func initTestnetFiles(w io.Writer, clientCtx client.Context, mbm module.BasicManager, args testnetArgs) error {
+	layoutFile := filepath.Join(args.outputDir, TestnetFileName)
+	if _, err := os.Stat(layoutFile); err == nil {
+		return fmt.Errorf("testnet already exists at %s; remove the directory to initialize a new one", args.outputDir)
+	} else if !os.IsNotExist(err) {
+		return err
+	}
+
+	// the test accounts and the relayer are funded on both chains
+	accounts, err := newTestnetAccounts(clientCtx.Codec, args.numAccounts)
+	if err != nil {
+		return err
+	}
+
+	layout := TestnetLayout{
+		Relayer: TestnetRelayer{
+			Home: "relayer",
+			Path: fmt.Sprintf("%s-%s", args.chainID, args.l1ChainID),
+		},
+	}
+
+	// the L2 nodes take the first ports, followed by the L1 node
+	layout.L2, err = initTestnetChain(clientCtx, mbm, args, args.chainID, args.numValidators, 0, accounts)
+	if err != nil {
+		return errors.Wrapf(err, "failed to initialize %s", args.chainID)
+	}
+
+	layout.L1, err = initTestnetChain(clientCtx, mbm, args, args.l1ChainID, 1, args.numValidators, accounts)
+	if err != nil {
+		return errors.Wrapf(err, "failed to initialize %s", args.l1ChainID)
+	}
+
+	if err := writeTestnetJSON(filepath.Join(args.outputDir, TestnetAccountsFileName), accounts); err != nil {
+		return err
+	}
+	if err := writeTestnetJSON(layoutFile, layout); err != nil {
+		return err
+	}
+
+	return printTestnetLayout(w, args.outputDir, layout, accounts)
+}
+
+// newTestnetAccounts generates the test accounts and the relayer account.
+func newTestnetAccounts(cdc codec.Codec, numAccounts int) ([]TestnetAccount, error) {
+	kb := keyring.NewInMemory(cdc)
+
+	names := []string{testnetRelayerKeyName}
+	for i := 0; i < numAccounts; i++ {
+		names = append(names, fmt.Sprintf("account%d", i))
+	}
+
+	accounts := make([]TestnetAccount, 0, len(names))
+	for _, name := range names {
+		record, mnemonic, err := kb.NewMnemonic(name, keyring.English, sdk.GetConfig().GetFullBIP44Path(), keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+		if err != nil {
+			return nil, err
+		}
+
+		addr, err := record.GetAddress()
+		if err != nil {
+			return nil, err
+		}
+
+		accounts = append(accounts, TestnetAccount{
+			Name:     name,
+			Address:  addr.String(),
+			Mnemonic: mnemonic,
+		})
+	}
+
+	return accounts, nil
+}
+
+// initTestnetChain initializes the node homes and the genesis of a chain. The
+// nodes listen on the ports from the port index of the first node.
+func initTestnetChain(
+	clientCtx client.Context,
+	mbm module.BasicManager,
+	args testnetArgs,
+	chainID string,
+	numNodes int,
+	portIndex int,
+	accounts []TestnetAccount,
+) (TestnetChain, error) {
+	chain := TestnetChain{ChainID: chainID}
+
+	configs := make([]*tmcfg.Config, numNodes)
+	keys := make([]testnetNodeKey, numNodes)
+	for i := 0; i < numNodes; i++ {
+		moniker := fmt.Sprintf("node%d", i)
+		home := filepath.Join(args.outputDir, chainID, moniker)
+		portOffset := args.portOffset + (portIndex+i)*testnetPortStep
+
+		config := initTendermintConfig()
+		config.SetRoot(home)
+		config.Moniker = moniker
+		if err := os.MkdirAll(filepath.Join(home, "config"), 0o755); err != nil {
+			return chain, err
+		}
+		if err := os.MkdirAll(filepath.Join(home, "data"), 0o755); err != nil {
+			return chain, err
+		}
+
+		nodeID, pubKey, err := genutil.InitializeNodeValidatorFiles(config)
+		if err != nil {
+			return chain, err
+		}
+
+		kb, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, home, nil, clientCtx.Codec)
+		if err != nil {
+			return chain, err
+		}
+
+		record, _, err := kb.NewMnemonic(testnetValidatorKeyName, keyring.English, sdk.GetConfig().GetFullBIP44Path(), keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+		if err != nil {
+			return chain, err
+		}
+
+		addr, err := record.GetAddress()
+		if err != nil {
+			return chain, err
+		}
+
+		// the test accounts are added to the keyring of the first node
+		if i == 0 {
+			for _, account := range accounts {
+				if _, err := kb.NewAccount(account.Name, account.Mnemonic, keyring.DefaultBIP39Passphrase, sdk.GetConfig().GetFullBIP44Path(), hd.Secp256k1); err != nil {
+					return chain, err
+				}
+			}
+		}
+
+		// the nodes listen on the localhost only, and produce blocks at the
+		// commit timeout interval, so the relayer progresses without txs.
+		config.P2P.ListenAddress = fmt.Sprintf("tcp://127.0.0.1:%d", testnetP2PPort+portOffset)
+		config.P2P.AllowDuplicateIP = true
+		config.P2P.AddrBookStrict = false
+		config.RPC.ListenAddress = fmt.Sprintf("tcp://127.0.0.1:%d", testnetRPCPort+portOffset)
+		config.RPC.PprofListenAddress = ""
+		config.Consensus.CreateEmptyBlocks = true
+		config.Consensus.CreateEmptyBlocksInterval = 0
+		config.Consensus.TimeoutCommit = args.commitTimeout
+		configs[i] = config
+
+		_, appConfig := initAppConfig()
+		minitiaAppConfig := appConfig.(minitiaAppConfig)
+		minitiaAppConfig.MinGasPrices = args.minGasPrices
+		minitiaAppConfig.API.Address = fmt.Sprintf("tcp://127.0.0.1:%d", testnetAPIPort+portOffset)
+		minitiaAppConfig.API.Swagger = false
+		minitiaAppConfig.GRPC.Address = fmt.Sprintf("127.0.0.1:%d", testnetGRPCPort+portOffset)
+
+		appTemplate, _ := initAppConfig()
+		serverconfig.SetConfigTemplate(appTemplate)
+		serverconfig.WriteConfigFile(filepath.Join(home, "config", "app.toml"), minitiaAppConfig)
+
+		if err := writeTestnetClientConfig(home, chainID, config.RPC.ListenAddress); err != nil {
+			return chain, err
+		}
+
+		keys[i] = testnetNodeKey{nodeID: nodeID, pubKey: pubKey, address: addr}
+		chain.Nodes = append(chain.Nodes, TestnetNode{
+			Moniker: moniker,
+			Home:    filepath.Join(chainID, moniker),
+			RPC:     fmt.Sprintf("http://127.0.0.1:%d", testnetRPCPort+portOffset),
+			GRPC:    minitiaAppConfig.GRPC.Address,
+			API:     fmt.Sprintf("http://127.0.0.1:%d", testnetAPIPort+portOffset),
+		})
+	}
+
+	// every node peers with all the other nodes of the chain
+	for i, config := range configs {
+		var peers []string
+		for j, key := range keys {
+			if i != j {
+				peers = append(peers, fmt.Sprintf("%s@%s", key.nodeID, strings.TrimPrefix(configs[j].P2P.ListenAddress, "tcp://")))
+			}
+		}
+		config.P2P.PersistentPeers = strings.Join(peers, ",")
+
+		tmcfg.WriteConfigFile(filepath.Join(config.RootDir, "config", "config.toml"), config)
+	}
+
+	appGenesis, nftTransferPort, err := newTestnetGenesis(clientCtx.Codec, mbm, args.denom, chainID, chain.Nodes, keys, accounts)
+	if err != nil {
+		return chain, err
+	}
+	chain.NFTTransferPort = nftTransferPort
+
+	for _, config := range configs {
+		if err := genutil.ExportGenesisFile(appGenesis, config.GenesisFile()); err != nil {
+			return chain, err
+		}
+	}
+
+	return chain, nil
+}
+
+// newTestnetGenesis returns the genesis of a chain with the nodes as the opchild
+// validators, the accounts funded, and the cw721 and the ics721 contracts. It
+// returns the ibc port of the ics721 contract.
+func newTestnetGenesis(
+	cdc codec.Codec,
+	mbm module.BasicManager,
+	denom string,
+	chainID string,
+	nodes []TestnetNode,
+	keys []testnetNodeKey,
+	accounts []TestnetAccount,
+) (*genutiltypes.AppGenesis, string, error) {
+	genState := minitiaapp.NewDefaultGenesisState(cdc, mbm, denom)
+
+	admin := keys[0].address.String()
+	opchildGenState := opchildtypes.GetGenesisStateFromAppState(cdc, genState)
+	opchildGenState.Params.Admin = admin
+	opchildGenState.Params.BridgeExecutors = []string{admin}
+
+	addrs := make([]sdk.AccAddress, 0, len(keys)+len(accounts))
+	for i, key := range keys {
+		validator, err := opchildtypes.NewValidator(sdk.ValAddress(key.address), key.pubKey, nodes[i].Moniker)
+		if err != nil {
+			return nil, "", err
+		}
+
+		opchildGenState.Validators = append(opchildGenState.Validators, validator)
+		addrs = append(addrs, key.address)
+	}
+
+	bz, err := cdc.MarshalJSON(opchildGenState)
+	if err != nil {
+		return nil, "", err
+	}
+	genState[opchildtypes.ModuleName] = bz
+
+	for _, account := range accounts {
+		addr, err := sdk.AccAddressFromBech32(account.Address)
+		if err != nil {
+			return nil, "", err
+		}
+
+		addrs = append(addrs, addr)
+	}
+
+	if err := addTestnetGenesisAccounts(cdc, genState, addrs, sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(1_000_000_000_000)))); err != nil {
+		return nil, "", err
+	}
+
+	// store the wasm artifacts of the launch
+	wasmConfig := DefaultWasmLaunchConfig()
+	codeIDs := make(map[string]uint64, len(wasmConfig.Artifacts))
+	for _, artifact := range wasmConfig.Artifacts {
+		wasmCode, err := wasmConfig.WasmCode(artifact)
+		if err != nil {
+			return nil, "", err
+		}
+
+		codeIDs[artifact.Name], err = genState.AddWasmCode(cdc, admin, wasmCode, wasmtypes.AllowEverybody, false)
+		if err != nil {
+			return nil, "", errors.Wrapf(err, "failed to add wasm code of %s", artifact.Name)
+		}
+	}
+
+	appState, err := json.MarshalIndent(genState, "", " ")
+	if err != nil {
+		return nil, "", err
+	}
+
+	appGenesis := genutiltypes.NewAppGenesisWithVersion(chainID, appState)
+	if err := appGenesis.ValidateAndComplete(); err != nil {
+		return nil, "", err
+	}
+
+	// instantiate the contracts of the launch on top of the genesis
+	var nftTransferPort string
+	for _, artifact := range wasmConfig.Artifacts {
+		if artifact.Instantiate == nil {
+			continue
+		}
+
+		msg, err := artifact.Instantiate.InstantiateMsg(codeIDs)
+		if err != nil {
+			return nil, "", err
+		}
+
+		contractAddr, err := minitiaapp.InstantiateGenesisContract(appGenesis, minitiaapp.GenesisContract{
+			CodeID:  codeIDs[artifact.Name],
+			Creator: admin,
+			Admin:   admin,
+			Label:   artifact.Instantiate.Label,
+			Msg:     msg,
+		})
+		if err != nil {
+			return nil, "", errors.Wrapf(err, "failed to instantiate %s", artifact.Name)
+		}
+
+		if artifact.Name == wasmConfig.NFTTransferContract {
+			nftTransferPort = fmt.Sprintf("%s.%s", wasmtypes.ModuleName, contractAddr)
+		}
+	}
+
+	if err := minitiaapp.DryInitChain(appGenesis, nil); err != nil {
+		return nil, "", err
+	}
+
+	return appGenesis, nftTransferPort, nil
+}
+
+// addTestnetGenesisAccounts adds the accounts with the coins to the auth and
+// the bank genesis states.
+func addTestnetGenesisAccounts(cdc codec.Codec, genState minitiaapp.GenesisState, addrs []sdk.AccAddress, coins sdk.Coins) error {
+	authGenState := authtypes.GetGenesisStateFromAppState(cdc, genState)
+	genAccounts, err := authtypes.UnpackAccounts(authGenState.Accounts)
+	if err != nil {
+		return err
+	}
+
+	bankGenState := banktypes.GetGenesisStateFromAppState(cdc, genState)
+	for _, addr := range addrs {
+		genAccounts = append(genAccounts, authtypes.NewBaseAccount(addr, nil, 0, 0))
+		bankGenState.Balances = append(bankGenState.Balances, banktypes.Balance{Address: addr.String(), Coins: coins})
+		bankGenState.Supply = bankGenState.Supply.Add(coins...)
+	}
+
+	authGenState.Accounts, err = authtypes.PackAccounts(authtypes.SanitizeGenesisAccounts(genAccounts))
+	if err != nil {
+		return err
+	}
+	bankGenState.Balances = banktypes.SanitizeGenesisBalances(bankGenState.Balances)
+
+	if genState[authtypes.ModuleName], err = cdc.MarshalJSON(&authGenState); err != nil {
+		return err
+	}
+	if genState[banktypes.ModuleName], err = cdc.MarshalJSON(bankGenState); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// writeTestnetClientConfig writes client.toml of the node, so the commands run
+// with the node home are sent to the node.
+func writeTestnetClientConfig(home, chainID, node string) error {
+	clientConfig := fmt.Sprintf(`# This is a TOML config file.
+# For more information, see https://github.com/toml-lang/toml
+
+###############################################################################
+###                           Client Configuration                            ###
+###############################################################################
+
+# The network chain ID
+chain-id = "%s"
+# The keyring's backend, where the keys are stored (os|file|kwallet|pass|test|memory)
+keyring-backend = "%s"
+# CLI output format (text|json)
+output = "text"
+# <host>:<port> to CometBFT RPC interface for this chain
+node = "%s"
+# Transaction broadcasting mode (sync|async)
+broadcast-mode = "sync"
+`, chainID, keyring.BackendTest, node)
+
+	return os.WriteFile(filepath.Join(home, "config", "client.toml"), []byte(clientConfig), 0o600)
+}
+
+func writeTestnetJSON(path string, v interface{}) error {
+	bz, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, bz, 0o600)
+}
+
+func readTestnetJSON(path string, v interface{}) error {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return errors.Wrapf(err, "failed to read %s", path)
+	}
+
+	return json.Unmarshal(bz, v)
+}
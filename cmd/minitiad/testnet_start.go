@@ -0,0 +1,381 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+
+	relayercmd "github.com/cosmos/relayer/v2/cmd"
+	relayertypes "github.com/cosmos/relayer/v2/relayer"
+	relayerconfig "github.com/cosmos/relayer/v2/relayer/chains/cosmos"
+
+	minitiaapp "github.com/initia-labs/miniwasm/app"
+)
+
+// testnetStartHeight is the height the chains must reach before the relayer
+// creates the channels.
+const testnetStartHeight = 2
+
+// TestnetStartCmd returns a command to run the devnet initialized by
+// init-files in-process.
+func TestnetStartCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "start",
+		Short: "Run the local rollup devnet in-process",
+		Long: `Run all the nodes of the L2 and the mock L1, initialized by init-files, in this process.
+On the first start, the relayer creates the transfer channel and the nft-transfer channel
+between the ics721 contracts of the chains. The relayer relays the packets between the
+chains until the devnet is stopped.
+
+Example:
+$ minitiad testnet start --output-dir ./.testnets
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			outputDir, _ := cmd.Flags().GetString(flagOutputDir)
+			enableLogging, _ := cmd.Flags().GetBool(flagEnableLogging)
+
+			return startTestnet(cmd, outputDir, enableLogging)
+		},
+	}
+
+	cmd.Flags().String(flagOutputDir, "./.testnets", "Directory of the devnet files")
+	cmd.Flags().Bool(flagEnableLogging, false, "Enable the logging of the nodes and the relayer")
+
+	return cmd
+}
+
+func startTestnet(cmd *cobra.Command, outputDir string, enableLogging bool) error {
+	var layout TestnetLayout
+	if err := readTestnetJSON(filepath.Join(outputDir, TestnetFileName), &layout); err != nil {
+		return err
+	}
+
+	var accounts []TestnetAccount
+	if err := readTestnetJSON(filepath.Join(outputDir, TestnetAccountsFileName), &accounts); err != nil {
+		return err
+	}
+
+	ctx, stop := signal.NotifyContext(cmd.Context(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	g, ctx := errgroup.WithContext(ctx)
+	clientCtx := client.GetClientContextFromCmd(cmd)
+
+	for _, chain := range []TestnetChain{layout.L2, layout.L1} {
+		for _, node := range chain.Nodes {
+			home := filepath.Join(outputDir, node.Home)
+			if err := startTestnetNode(ctx, g, clientCtx, home, chain.ChainID, node.Moniker, enableLogging); err != nil {
+				return errors.Wrapf(err, "failed to start %s of %s", node.Moniker, chain.ChainID)
+			}
+		}
+	}
+
+	relayerLogger := zap.NewNop()
+	if enableLogging {
+		// the relayer creates its own logger
+		relayerLogger = nil
+	}
+
+	relayerHome := filepath.Join(outputDir, layout.Relayer.Home)
+	g.Go(func() error {
+		for _, chain := range []TestnetChain{layout.L2, layout.L1} {
+			if err := waitForTestnetChain(ctx, chain); err != nil {
+				return err
+			}
+		}
+
+		if !layout.Relayer.Linked {
+			if err := linkTestnetChains(ctx, relayerLogger, relayerHome, layout, accounts); err != nil {
+				return errors.Wrap(err, "failed to link the chains")
+			}
+
+			layout.Relayer.Linked = true
+			if err := writeTestnetJSON(filepath.Join(outputDir, TestnetFileName), layout); err != nil {
+				return err
+			}
+		}
+
+		if err := printTestnetLayout(cmd.OutOrStdout(), outputDir, layout, accounts); err != nil {
+			return err
+		}
+
+		err := runTestnetRelayer(ctx, relayerLogger, relayerHome, "start", layout.Relayer.Path)
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		return err
+	})
+
+	err := g.Wait()
+	if errors.Is(err, context.Canceled) {
+		return nil
+	}
+
+	return err
+}
+
+// startTestnetNode runs the start command of the node in a goroutine of the
+// error group. The node is stopped when the context is done.
+func startTestnetNode(
+	ctx context.Context,
+	g *errgroup.Group,
+	clientCtx client.Context,
+	home, chainID, moniker string,
+	enableLogging bool,
+) error {
+	serverCtx, err := newTestnetServerContext(home)
+	if err != nil {
+		return err
+	}
+
+	serverCtx.Logger = log.NewNopLogger()
+	if enableLogging {
+		logger, err := server.CreateSDKLogger(serverCtx, os.Stdout)
+		if err != nil {
+			return err
+		}
+
+		serverCtx.Logger = logger.With("chain_id", chainID, "moniker", moniker)
+	}
+
+	startCmd := server.StartCmdWithOptions((&appCreator{}).AppCreator(), home, server.StartCmdOptions{
+		AddFlags: addModuleInitFlags,
+		PostSetup: func(_ *server.Context, _ client.Context, nodeCtx context.Context, nodeGroup *errgroup.Group) error {
+			// stop the node with the devnet
+			nodeGroup.Go(func() error {
+				select {
+				case <-ctx.Done():
+					return ctx.Err()
+				case <-nodeCtx.Done():
+					return nil
+				}
+			})
+
+			return nil
+		},
+	})
+
+	clientCtx = clientCtx.WithHomeDir(home).WithChainID(chainID)
+	cmdCtx := context.WithValue(ctx, client.ClientContextKey, &clientCtx)
+	cmdCtx = context.WithValue(cmdCtx, server.ServerContextKey, serverCtx)
+	startCmd.SetContext(cmdCtx)
+
+	if err := startCmd.Flags().Set(flags.FlagHome, home); err != nil {
+		return err
+	}
+	if err := startCmd.PreRunE(startCmd, nil); err != nil {
+		return err
+	}
+
+	g.Go(func() error {
+		return startCmd.RunE(startCmd, nil)
+	})
+
+	return nil
+}
+
+// newTestnetServerContext returns the server context with the configs of the
+// node home.
+func newTestnetServerContext(home string) (*server.Context, error) {
+	cmd := &cobra.Command{}
+	cmd.Flags().String(flags.FlagHome, home, "The application home directory")
+
+	appTemplate, appConfig := initAppConfig()
+	return server.InterceptConfigsAndCreateContext(cmd, appTemplate, appConfig, initTendermintConfig())
+}
+
+// waitForTestnetChain waits until the first node of the chain reaches the
+// start height.
+func waitForTestnetChain(ctx context.Context, chain TestnetChain) error {
+	rpcClient, err := rpchttp.New(chain.Nodes[0].RPC, "/websocket")
+	if err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(500 * time.Millisecond)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+
+		status, err := rpcClient.Status(ctx)
+		if err == nil && status.SyncInfo.LatestBlockHeight >= testnetStartHeight {
+			return nil
+		}
+	}
+}
+
+// linkTestnetChains initializes the relayer, and creates the transfer channel
+// and the nft-transfer channel between the L2 and the L1.
+func linkTestnetChains(ctx context.Context, logger *zap.Logger, home string, layout TestnetLayout, accounts []TestnetAccount) error {
+	var mnemonic string
+	for _, account := range accounts {
+		if account.Name == testnetRelayerKeyName {
+			mnemonic = account.Mnemonic
+		}
+	}
+	if mnemonic == "" {
+		return errors.New("relayer account not found")
+	}
+
+	// the relayer is initialized again if the previous link was interrupted
+	if err := os.RemoveAll(home); err != nil {
+		return err
+	}
+	if err := runTestnetRelayer(ctx, logger, home, "config", "init"); err != nil {
+		return err
+	}
+
+	for _, chain := range []TestnetChain{layout.L2, layout.L1} {
+		chainConfig := struct {
+			Type  string                             `json:"type"`
+			Value relayerconfig.CosmosProviderConfig `json:"value"`
+		}{
+			Type: "cosmos",
+			Value: relayerconfig.CosmosProviderConfig{
+				Key:            testnetRelayerKeyName,
+				ChainID:        chain.ChainID,
+				RPCAddr:        chain.Nodes[0].RPC,
+				AccountPrefix:  minitiaapp.AccountAddressPrefix,
+				KeyringBackend: "test",
+				GasAdjustment:  1.5,
+				Timeout:        "60s",
+				OutputFormat:   "json",
+			},
+		}
+
+		chainFile := filepath.Join(home, fmt.Sprintf("%s.json", chain.ChainID))
+		if err := writeTestnetJSON(chainFile, chainConfig); err != nil {
+			return err
+		}
+
+		if err := runTestnetRelayer(ctx, logger, home, "chains", "add", "--file", chainFile, chain.ChainID); err != nil {
+			return err
+		}
+
+		if err := runTestnetRelayer(ctx, logger, home, "keys", "restore", chain.ChainID, testnetRelayerKeyName, mnemonic); err != nil {
+			return err
+		}
+	}
+
+	pathFile := filepath.Join(home, "path.json")
+	if err := writeTestnetJSON(pathFile, relayertypes.Path{
+		Src: &relayertypes.PathEnd{ChainID: layout.L2.ChainID},
+		Dst: &relayertypes.PathEnd{ChainID: layout.L1.ChainID},
+	}); err != nil {
+		return err
+	}
+
+	if err := runTestnetRelayer(ctx, logger, home, "paths", "add", layout.L2.ChainID, layout.L1.ChainID, layout.Relayer.Path, "--file", pathFile); err != nil {
+		return err
+	}
+
+	if err := runTestnetRelayer(ctx, logger, home, "tx", "link", layout.Relayer.Path); err != nil {
+		return err
+	}
+
+	return runTestnetRelayer(ctx, logger, home,
+		"tx", "link", layout.Relayer.Path,
+		"--src-port", layout.L2.NFTTransferPort,
+		"--dst-port", layout.L1.NFTTransferPort,
+		"--version", testnetNFTTransferVersion,
+	)
+}
+
+// runTestnetRelayer runs the relayer command in-process.
+func runTestnetRelayer(ctx context.Context, logger *zap.Logger, home string, args ...string) error {
+	cmd := relayercmd.NewRootCmd(logger)
+	cmd.SilenceUsage = true
+	cmd.SetArgs(append(args, "--home", home))
+
+	return cmd.ExecuteContext(ctx)
+}
+
+// printTestnetLayout prints the nodes and the accounts of the devnet.
+func printTestnetLayout(w io.Writer, outputDir string, layout TestnetLayout, accounts []TestnetAccount) error {
+	type printNode struct {
+		Moniker string `json:"moniker"`
+		Home    string `json:"home"`
+		RPC     string `json:"rpc"`
+		GRPC    string `json:"grpc"`
+		API     string `json:"api"`
+	}
+	type printChain struct {
+		ChainID         string      `json:"chain_id"`
+		Nodes           []printNode `json:"nodes"`
+		NFTTransferPort string      `json:"nft_transfer_port"`
+	}
+	type printAccount struct {
+		Name    string `json:"name"`
+		Address string `json:"address"`
+	}
+
+	chains := make([]printChain, 0, 2)
+	for _, chain := range []TestnetChain{layout.L2, layout.L1} {
+		nodes := make([]printNode, 0, len(chain.Nodes))
+		for _, node := range chain.Nodes {
+			nodes = append(nodes, printNode{
+				Moniker: node.Moniker,
+				Home:    filepath.Join(outputDir, node.Home),
+				RPC:     node.RPC,
+				GRPC:    node.GRPC,
+				API:     node.API,
+			})
+		}
+
+		chains = append(chains, printChain{
+			ChainID:         chain.ChainID,
+			Nodes:           nodes,
+			NFTTransferPort: chain.NFTTransferPort,
+		})
+	}
+
+	printAccounts := make([]printAccount, 0, len(accounts))
+	for _, account := range accounts {
+		printAccounts = append(printAccounts, printAccount{Name: account.Name, Address: account.Address})
+	}
+
+	out, err := json.MarshalIndent(struct {
+		Chains       []printChain   `json:"chains"`
+		RelayerPath  string         `json:"relayer_path"`
+		Linked       bool           `json:"linked"`
+		Accounts     []printAccount `json:"accounts"`
+		AccountsFile string         `json:"accounts_file"`
+	}{
+		Chains:       chains,
+		RelayerPath:  layout.Relayer.Path,
+		Linked:       layout.Relayer.Linked,
+		Accounts:     printAccounts,
+		AccountsFile: filepath.Join(outputDir, TestnetAccountsFileName),
+	}, "", " ")
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(w, "%s\n", out)
+	return err
+}
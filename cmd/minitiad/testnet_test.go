@@ -0,0 +1,73 @@
+package main
+
+import (
+	"io"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+
+	minitiaapp "github.com/initia-labs/miniwasm/app"
+	minitiatypes "github.com/initia-labs/miniwasm/types"
+)
+
+func Test_InitTestnetFiles(t *testing.T) {
+	// sets the bech32 prefixes of the app
+	_, encodingConfig := NewRootCmd()
+	clientCtx := client.Context{}.
+		WithCodec(encodingConfig.Codec).
+		WithInterfaceRegistry(encodingConfig.InterfaceRegistry).
+		WithTxConfig(encodingConfig.TxConfig).
+		WithLegacyAmino(encodingConfig.Amino)
+
+	args := testnetArgs{
+		outputDir:     t.TempDir(),
+		chainID:       "testnet-l2",
+		l1ChainID:     "testnet-l1",
+		numValidators: 2,
+		numAccounts:   1,
+		commitTimeout: 500 * time.Millisecond,
+		denom:         minitiatypes.BaseDenom,
+		minGasPrices:  "0" + minitiatypes.BaseDenom,
+	}
+	require.NoError(t, initTestnetFiles(io.Discard, clientCtx, minitiaapp.BasicManager(), args))
+
+	// the start command reads the layout and the accounts back
+	var layout TestnetLayout
+	require.NoError(t, readTestnetJSON(filepath.Join(args.outputDir, TestnetFileName), &layout))
+	require.Equal(t, args.chainID, layout.L2.ChainID)
+	require.Equal(t, args.l1ChainID, layout.L1.ChainID)
+	require.Len(t, layout.L2.Nodes, 2)
+	require.Len(t, layout.L1.Nodes, 1)
+	require.NotEmpty(t, layout.L2.NFTTransferPort)
+	require.NotEmpty(t, layout.L1.NFTTransferPort)
+	require.False(t, layout.Relayer.Linked)
+
+	var accounts []TestnetAccount
+	require.NoError(t, readTestnetJSON(filepath.Join(args.outputDir, TestnetAccountsFileName), &accounts))
+	require.Len(t, accounts, 2)
+	require.Equal(t, testnetRelayerKeyName, accounts[0].Name)
+	for _, account := range accounts {
+		_, err := sdk.AccAddressFromBech32(account.Address)
+		require.NoError(t, err)
+		require.NotEmpty(t, account.Mnemonic)
+	}
+
+	// the genesis of every node initializes the chain
+	for _, chain := range []TestnetChain{layout.L2, layout.L1} {
+		for _, node := range chain.Nodes {
+			appGenesis, err := genutiltypes.AppGenesisFromFile(filepath.Join(args.outputDir, node.Home, "config", "genesis.json"))
+			require.NoError(t, err)
+			require.Equal(t, chain.ChainID, appGenesis.ChainID)
+			require.NoError(t, minitiaapp.DryInitChain(appGenesis, nil))
+		}
+	}
+
+	// the existing testnet is not overwritten
+	require.ErrorContains(t, initTestnetFiles(io.Discard, clientCtx, minitiaapp.BasicManager(), args), "already exists")
+}
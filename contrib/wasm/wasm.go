@@ -0,0 +1,15 @@
+// Package wasm embeds the default wasm artifacts stored and instantiated by
+// the launch command.
+package wasm
+
+import (
+	_ "embed"
+)
+
+var (
+	//go:embed cw721_base.wasm
+	CW721Base []byte
+
+	//go:embed ics721_base.wasm
+	ICS721Base []byte
+)
@@ -0,0 +1,218 @@
+package keeper_test
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store"
+	"cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/tx/signing"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/crypto"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codecaddress "github.com/cosmos/cosmos-sdk/codec/address"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/std"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authcodec "github.com/cosmos/cosmos-sdk/x/auth/codec"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	bankkeeper "github.com/initia-labs/miniwasm/x/bank/keeper"
+	customtypes "github.com/initia-labs/miniwasm/x/bank/types"
+)
+
+var ModuleBasics = module.NewBasicManager(
+	auth.AppModuleBasic{},
+	bank.AppModuleBasic{},
+)
+
+// testStoreKey is the store of the test listeners
+var testStoreKey = storetypes.NewKVStoreKey("test")
+
+const (
+	testDenom     = "test"
+	blockedDenom  = "blocked"
+	stakingModule = "staking"
+)
+
+func MakeTestCodec(_ testing.TB) codec.Codec {
+	interfaceRegistry, _ := codectypes.NewInterfaceRegistryWithOptions(codectypes.InterfaceRegistryOptions{
+		ProtoFiles: proto.HybridResolver,
+		SigningOptions: signing.Options{
+			AddressCodec:          codecaddress.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix()),
+			ValidatorAddressCodec: codecaddress.NewBech32Codec(sdk.GetConfig().GetBech32ValidatorAddrPrefix()),
+		},
+	})
+
+	std.RegisterInterfaces(interfaceRegistry)
+	ModuleBasics.RegisterInterfaces(interfaceRegistry)
+	customtypes.RegisterInterfaces(interfaceRegistry)
+
+	return codec.NewProtoCodec(interfaceRegistry)
+}
+
+// mockHooks records every hook call and blocks the coins of the blocked denom.
+type mockHooks struct {
+	calls *[]string
+	// blockedSends records the from and to addresses of the BlockBeforeSend calls
+	blockedSends *[][2]sdk.AccAddress
+}
+
+var (
+	_ bankkeeper.BankHooks     = mockHooks{}
+	_ bankkeeper.MintBurnHooks = mockHooks{}
+)
+
+func (h mockHooks) record(hook string) {
+	*h.calls = append(*h.calls, hook)
+}
+
+func (h mockHooks) block(hook string, amount sdk.Coins) error {
+	h.record(hook)
+	if amount.AmountOf(blockedDenom).IsPositive() {
+		return errors.New("blocked")
+	}
+
+	return nil
+}
+
+func (h mockHooks) TrackBeforeSend(_ context.Context, _, _ sdk.AccAddress, _ sdk.Coins) {
+	h.record("TrackBeforeSend")
+}
+
+func (h mockHooks) BlockBeforeSend(_ context.Context, from, to sdk.AccAddress, amount sdk.Coins) error {
+	*h.blockedSends = append(*h.blockedSends, [2]sdk.AccAddress{from, to})
+	return h.block("BlockBeforeSend", amount)
+}
+
+func (h mockHooks) TrackBeforeMint(_ context.Context, _ sdk.AccAddress, _ sdk.Coins) {
+	h.record("TrackBeforeMint")
+}
+
+func (h mockHooks) BlockBeforeMint(_ context.Context, _ sdk.AccAddress, amount sdk.Coins) error {
+	return h.block("BlockBeforeMint", amount)
+}
+
+func (h mockHooks) TrackBeforeBurn(_ context.Context, _ sdk.AccAddress, _ sdk.Coins) {
+	h.record("TrackBeforeBurn")
+}
+
+func (h mockHooks) BlockBeforeBurn(_ context.Context, _ sdk.AccAddress, amount sdk.Coins) error {
+	return h.block("BlockBeforeBurn", amount)
+}
+
+type TestKeepers struct {
+	AccountKeeper *authkeeper.AccountKeeper
+	BankKeeper    *bankkeeper.Keeper
+	// HookCalls records the hook calls in order
+	HookCalls *[]string
+	// BlockedSends records the from and to addresses of the BlockBeforeSend calls
+	BlockedSends *[][2]sdk.AccAddress
+}
+
+// ResetHookCalls clears the recorded hook calls.
+func (k TestKeepers) ResetHookCalls() {
+	*k.HookCalls = (*k.HookCalls)[:0]
+	*k.BlockedSends = (*k.BlockedSends)[:0]
+}
+
+var keyCounter uint64
+
+func keyPubAddr() (crypto.PrivKey, crypto.PubKey, sdk.AccAddress) {
+	keyCounter++
+	seed := make([]byte, 8)
+	binary.BigEndian.PutUint64(seed, keyCounter)
+
+	key := ed25519.GenPrivKeyFromSecret(seed)
+	pub := key.PubKey()
+	addr := sdk.AccAddress(pub.Address())
+	return key, pub, addr
+}
+
+func createDefaultTestInput(t testing.TB) (sdk.Context, TestKeepers) {
+	keys := storetypes.NewKVStoreKeys(authtypes.StoreKey, banktypes.StoreKey, testStoreKey.Name())
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+	for name, v := range keys {
+		if name == testStoreKey.Name() {
+			v = testStoreKey
+		}
+		ms.MountStoreWithDB(v, storetypes.StoreTypeIAVL, db)
+	}
+	require.NoError(t, ms.LoadLatestVersion())
+
+	ctx := sdk.NewContext(ms, tmproto.Header{
+		Height: 1234567,
+		Time:   time.Date(2020, time.April, 22, 12, 0, 0, 0, time.UTC),
+	}, false, log.NewNopLogger())
+
+	appCodec := MakeTestCodec(t)
+
+	maccPerms := map[string][]string{ // module account permissions
+		authtypes.FeeCollectorName: nil,
+		govtypes.ModuleName:        {authtypes.Burner},
+		authtypes.Minter:           {authtypes.Minter, authtypes.Burner},
+		stakingModule:              {authtypes.Staking},
+	}
+
+	ac := authcodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	accountKeeper := authkeeper.NewAccountKeeper(
+		appCodec,
+		runtime.NewKVStoreService(keys[authtypes.StoreKey]), // target store
+		authtypes.ProtoBaseAccount,                          // prototype
+		maccPerms,
+		ac,
+		sdk.GetConfig().GetBech32AccountAddrPrefix(),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	bankKeeper := bankkeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(keys[banktypes.StoreKey]),
+		accountKeeper,
+		map[string]bool{},
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		log.NewNopLogger(),
+	)
+	require.NoError(t, bankKeeper.SetParams(ctx, banktypes.DefaultParams()))
+
+	// create module accounts
+	for name := range maccPerms {
+		accountKeeper.GetModuleAccount(ctx, name)
+	}
+
+	calls := []string{}
+	blockedSends := [][2]sdk.AccAddress{}
+	bankKeeper.SetHooks(bankkeeper.NewMultiBankHooks(mockHooks{calls: &calls, blockedSends: &blockedSends}))
+
+	return ctx, TestKeepers{
+		AccountKeeper: &accountKeeper,
+		BankKeeper:    &bankKeeper,
+		HookCalls:     &calls,
+		BlockedSends:  &blockedSends,
+	}
+}
+
+// fundAccount mints the coins and sends them to the address without calling the hooks.
+func fundAccount(t testing.TB, ctx sdk.Context, input TestKeepers, addr sdk.AccAddress, amount sdk.Coins) {
+	require.NoError(t, input.BankKeeper.BaseKeeper.MintCoins(ctx, authtypes.Minter, amount))
+	require.NoError(t, input.BankKeeper.BaseSendKeeper.SendCoins(ctx, input.AccountKeeper.GetModuleAddress(authtypes.Minter), addr, amount))
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"cosmossdk.io/core/store"
 	errorsmod "cosmossdk.io/errors"
@@ -15,13 +16,16 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	customtypes "github.com/initia-labs/miniwasm/x/bank/types"
 )
 
 type Keeper struct {
 	bankkeeper.BaseKeeper
 
-	ak    types.AccountKeeper
-	hooks BankHooks
+	ak      types.AccountKeeper
+	hooks   BankHooks
+	metrics *Metrics
 }
 
 func NewKeeper(
@@ -52,11 +56,12 @@ func (k Keeper) DelegateCoins(ctx context.Context, delegatorAddr, moduleAccAddr
 		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, amt.String())
 	}
 
+	// call the BlockBeforeSend hooks and the TrackBeforeSend hooks
 	err := k.BlockBeforeSend(ctx, delegatorAddr, moduleAccAddr, amt)
 	if err != nil {
 		return err
 	}
-	// call the TrackBeforeSend hooks and the BlockBeforeSend hooks
+
 	k.TrackBeforeSend(ctx, delegatorAddr, moduleAccAddr, amt)
 
 	return k.BaseKeeper.DelegateCoins(ctx, delegatorAddr, moduleAccAddr, amt)
@@ -72,7 +77,7 @@ func (k Keeper) UndelegateCoins(ctx context.Context, moduleAccAddr, delegatorAdd
 		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, amt.String())
 	}
 
-	// call the TrackBeforeSend hooks and the BlockBeforeSend hooks
+	// call the BlockBeforeSend hooks and the TrackBeforeSend hooks
 	err := k.BlockBeforeSend(ctx, moduleAccAddr, delegatorAddr, amt)
 	if err != nil {
 		return err
@@ -80,7 +85,7 @@ func (k Keeper) UndelegateCoins(ctx context.Context, moduleAccAddr, delegatorAdd
 
 	k.TrackBeforeSend(ctx, moduleAccAddr, delegatorAddr, amt)
 
-	return k.BaseKeeper.UndelegateCoins(ctx, moduleAccAddr, moduleAccAddr, amt)
+	return k.BaseKeeper.UndelegateCoins(ctx, moduleAccAddr, delegatorAddr, amt)
 }
 
 // SendCoinsFromModuleToModule transfers coins from a ModuleAccount to another.
@@ -113,6 +118,7 @@ func (k Keeper) SendCoinsFromModuleToAccount(
 	}
 
 	if k.BlockedAddr(recipientAddr) {
+		k.metrics.RecordBlockedSend(ctx, BlockedReasonBlockedAddress)
 		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", recipientAddr)
 	}
 
@@ -168,11 +174,22 @@ func (k Keeper) UndelegateCoinsFromModuleToAccount(
 	return k.UndelegateCoins(ctx, acc.GetAddress(), recipientAddr, amt)
 }
 
+// BankHooks defines the hooks called before coins are moved. See the README for the
+// hooks fired by each entry point of the keeper.
 type BankHooks interface {
 	TrackBeforeSend(ctx context.Context, from, to sdk.AccAddress, amount sdk.Coins)       // Must be before any send is executed
 	BlockBeforeSend(ctx context.Context, from, to sdk.AccAddress, amount sdk.Coins) error // Must be before any send is executed
 }
 
+// MintBurnHooks defines the optional hooks called before coins are minted to or burned
+// from a module account. They are called if the registered BankHooks implement them.
+type MintBurnHooks interface {
+	TrackBeforeMint(ctx context.Context, moduleAddr sdk.AccAddress, amount sdk.Coins)       // Must be before any mint is executed
+	BlockBeforeMint(ctx context.Context, moduleAddr sdk.AccAddress, amount sdk.Coins) error // Must be before any mint is executed
+	TrackBeforeBurn(ctx context.Context, moduleAddr sdk.AccAddress, amount sdk.Coins)       // Must be before any burn is executed
+	BlockBeforeBurn(ctx context.Context, moduleAddr sdk.AccAddress, amount sdk.Coins) error // Must be before any burn is executed
+}
+
 // TrackBeforeSend executes the TrackBeforeSend hook if registered.
 func (k Keeper) TrackBeforeSend(ctx context.Context, from, to sdk.AccAddress, amount sdk.Coins) {
 	if k.hooks != nil {
@@ -188,6 +205,36 @@ func (k Keeper) BlockBeforeSend(ctx context.Context, from, to sdk.AccAddress, am
 	return nil
 }
 
+// TrackBeforeMint executes the TrackBeforeMint hook if registered.
+func (k Keeper) TrackBeforeMint(ctx context.Context, moduleAddr sdk.AccAddress, amount sdk.Coins) {
+	if hooks, ok := k.hooks.(MintBurnHooks); ok {
+		hooks.TrackBeforeMint(ctx, moduleAddr, amount)
+	}
+}
+
+// BlockBeforeMint executes the BlockBeforeMint hook if registered.
+func (k Keeper) BlockBeforeMint(ctx context.Context, moduleAddr sdk.AccAddress, amount sdk.Coins) error {
+	if hooks, ok := k.hooks.(MintBurnHooks); ok {
+		return hooks.BlockBeforeMint(ctx, moduleAddr, amount)
+	}
+	return nil
+}
+
+// TrackBeforeBurn executes the TrackBeforeBurn hook if registered.
+func (k Keeper) TrackBeforeBurn(ctx context.Context, moduleAddr sdk.AccAddress, amount sdk.Coins) {
+	if hooks, ok := k.hooks.(MintBurnHooks); ok {
+		hooks.TrackBeforeBurn(ctx, moduleAddr, amount)
+	}
+}
+
+// BlockBeforeBurn executes the BlockBeforeBurn hook if registered.
+func (k Keeper) BlockBeforeBurn(ctx context.Context, moduleAddr sdk.AccAddress, amount sdk.Coins) error {
+	if hooks, ok := k.hooks.(MintBurnHooks); ok {
+		return hooks.BlockBeforeBurn(ctx, moduleAddr, amount)
+	}
+	return nil
+}
+
 func (k *Keeper) SetHooks(bh BankHooks) *Keeper {
 	if k.hooks != nil {
 		panic("cannot set bank hooks twice")
@@ -196,6 +243,43 @@ func (k *Keeper) SetHooks(bh BankHooks) *Keeper {
 	return k
 }
 
+// SetMetrics sets the prometheus metrics of the bank module.
+func (k *Keeper) SetMetrics(metrics *Metrics) *Keeper {
+	if k.metrics != nil {
+		panic("cannot set bank metrics twice")
+	}
+	k.metrics = metrics
+	return k
+}
+
+// Metrics returns the prometheus metrics of the bank module, or nil if not set.
+func (k Keeper) Metrics() *Metrics {
+	return k.metrics
+}
+
+// AppendSendRestriction adds the restriction to the end of the send restrictions. The
+// rejected sends are recorded in the metrics.
+func (k *Keeper) AppendSendRestriction(restriction types.SendRestrictionFn) {
+	k.BaseKeeper.AppendSendRestriction(k.measureSendRestriction(restriction))
+}
+
+// PrependSendRestriction adds the restriction to the beginning of the send restrictions.
+// The rejected sends are recorded in the metrics.
+func (k *Keeper) PrependSendRestriction(restriction types.SendRestrictionFn) {
+	k.BaseKeeper.PrependSendRestriction(k.measureSendRestriction(restriction))
+}
+
+func (k *Keeper) measureSendRestriction(restriction types.SendRestrictionFn) types.SendRestrictionFn {
+	return func(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+		newToAddr, err := restriction(ctx, fromAddr, toAddr, amt)
+		if err != nil {
+			k.metrics.RecordBlockedSend(ctx, BlockedReasonSendRestriction)
+		}
+
+		return newToAddr, err
+	}
+}
+
 // SendCoinsWithoutBlockHook calls sendCoins without calling the `BlockBeforeSend` hook.
 func (k Keeper) SendCoinsWithoutBlockHook(ctx context.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error {
 	// call the TrackBeforeSend hooks
@@ -207,9 +291,137 @@ func (k Keeper) SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccAddress,
 	// BlockBeforeSend hook should always be called before the TrackBeforeSend hook.
 	err := k.BlockBeforeSend(ctx, fromAddr, toAddr, amt)
 	if err != nil {
+		k.metrics.RecordBlockedSend(ctx, BlockedReasonHook)
 		return err
 	}
 	// call the TrackBeforeSend hooks
 	k.TrackBeforeSend(ctx, fromAddr, toAddr, amt)
-	return k.BaseSendKeeper.SendCoins(ctx, fromAddr, toAddr, amt)
+	if err := k.BaseSendKeeper.SendCoins(ctx, fromAddr, toAddr, amt); err != nil {
+		return err
+	}
+
+	k.metrics.recordSend(ctx, k, amt)
+	return nil
+}
+
+// InputOutputCoins performs multi-send functionality. The BlockBeforeSend hooks are called
+// for every output before any TrackBeforeSend hook, so a blocked output does not leave
+// tracked sends behind.
+func (k Keeper) InputOutputCoins(ctx context.Context, input types.Input, outputs []types.Output) error {
+	fromAddr, err := k.ak.AddressCodec().StringToBytes(input.Address)
+	if err != nil {
+		return err
+	}
+
+	toAddrs := make([]sdk.AccAddress, len(outputs))
+	for i, out := range outputs {
+		toAddrs[i], err = k.ak.AddressCodec().StringToBytes(out.Address)
+		if err != nil {
+			return err
+		}
+
+		if err := k.BlockBeforeSend(ctx, fromAddr, toAddrs[i], out.Coins); err != nil {
+			k.metrics.RecordBlockedSend(ctx, BlockedReasonHook)
+			return err
+		}
+	}
+
+	for i, out := range outputs {
+		k.TrackBeforeSend(ctx, fromAddr, toAddrs[i], out.Coins)
+	}
+
+	if err := k.BaseSendKeeper.InputOutputCoins(ctx, input, outputs); err != nil {
+		return err
+	}
+
+	for _, out := range outputs {
+		k.metrics.recordSend(ctx, k, out.Coins)
+	}
+
+	return nil
+}
+
+// MultiSendCoins performs the multi-send functionality with multiple senders. Like
+// InputOutputCoins, the BlockBeforeSend hooks are called for every leg with its own
+// sender and recipient before any TrackBeforeSend hook. A multi_send_leg event is
+// emitted per leg.
+func (k Keeper) MultiSendCoins(ctx context.Context, legs []customtypes.MultiSendLeg) error {
+	fromAddrs := make([]sdk.AccAddress, len(legs))
+	toAddrs := make([]sdk.AccAddress, len(legs))
+	for i, leg := range legs {
+		var err error
+		fromAddrs[i], err = k.ak.AddressCodec().StringToBytes(leg.FromAddress)
+		if err != nil {
+			return err
+		}
+
+		toAddrs[i], err = k.ak.AddressCodec().StringToBytes(leg.ToAddress)
+		if err != nil {
+			return err
+		}
+
+		if err := k.BlockBeforeSend(ctx, fromAddrs[i], toAddrs[i], leg.Amount); err != nil {
+			k.metrics.RecordBlockedSend(ctx, BlockedReasonHook)
+			return err
+		}
+	}
+
+	for i, leg := range legs {
+		k.TrackBeforeSend(ctx, fromAddrs[i], toAddrs[i], leg.Amount)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	for i, leg := range legs {
+		if err := k.BaseSendKeeper.SendCoins(ctx, fromAddrs[i], toAddrs[i], leg.Amount); err != nil {
+			return err
+		}
+
+		k.metrics.recordSend(ctx, k, leg.Amount)
+
+		sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+			customtypes.EventTypeMultiSendLeg,
+			sdk.NewAttribute(customtypes.AttributeKeyLegIndex, strconv.Itoa(i)),
+			sdk.NewAttribute(customtypes.AttributeKeySender, leg.FromAddress),
+			sdk.NewAttribute(customtypes.AttributeKeyRecipient, leg.ToAddress),
+			sdk.NewAttribute(customtypes.AttributeKeyAmount, leg.Amount.String()),
+		))
+	}
+
+	return nil
+}
+
+// MintCoins creates new coins from thin air and adds it to the module account.
+// The BlockBeforeMint and TrackBeforeMint hooks are called with the module account address.
+func (k Keeper) MintCoins(ctx context.Context, moduleName string, amounts sdk.Coins) error {
+	moduleAddr := k.ak.GetModuleAddress(moduleName)
+	if moduleAddr == nil {
+		panic(errorsmod.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s does not exist", moduleName))
+	}
+
+	// call the BlockBeforeMint hooks and the TrackBeforeMint hooks
+	if err := k.BlockBeforeMint(ctx, moduleAddr, amounts); err != nil {
+		return err
+	}
+
+	k.TrackBeforeMint(ctx, moduleAddr, amounts)
+
+	return k.BaseKeeper.MintCoins(ctx, moduleName, amounts)
+}
+
+// BurnCoins burns and deletes coins from the balance of the module account.
+// The BlockBeforeBurn and TrackBeforeBurn hooks are called with the module account address.
+func (k Keeper) BurnCoins(ctx context.Context, moduleName string, amounts sdk.Coins) error {
+	moduleAddr := k.ak.GetModuleAddress(moduleName)
+	if moduleAddr == nil {
+		panic(errorsmod.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s does not exist", moduleName))
+	}
+
+	// call the BlockBeforeBurn hooks and the TrackBeforeBurn hooks
+	if err := k.BlockBeforeBurn(ctx, moduleAddr, amounts); err != nil {
+		return err
+	}
+
+	k.TrackBeforeBurn(ctx, moduleAddr, amounts)
+
+	return k.BaseKeeper.BurnCoins(ctx, moduleName, amounts)
 }
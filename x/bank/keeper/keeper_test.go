@@ -0,0 +1,178 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	customtypes "github.com/initia-labs/miniwasm/x/bank/types"
+)
+
+var (
+	sendHooks = []string{"BlockBeforeSend", "TrackBeforeSend"}
+	mintHooks = []string{"BlockBeforeMint", "TrackBeforeMint"}
+	burnHooks = []string{"BlockBeforeBurn", "TrackBeforeBurn"}
+)
+
+// Test_HooksMatrix asserts the hooks fired by each entry point, as documented in the README.
+func Test_HooksMatrix(t *testing.T) {
+	testCases := []struct {
+		name string
+		// hooks expected to be called on success, in order
+		hooks []string
+		// blockable is true if a block hook can reject the operation
+		blockable bool
+		run       func(ctx sdk.Context, input TestKeepers, user, user2 sdk.AccAddress, amount sdk.Coins) error
+	}{
+		{
+			name:      "SendCoins",
+			hooks:     sendHooks,
+			blockable: true,
+			run: func(ctx sdk.Context, input TestKeepers, user, user2 sdk.AccAddress, amount sdk.Coins) error {
+				return input.BankKeeper.SendCoins(ctx, user, user2, amount)
+			},
+		},
+		{
+			name:  "SendCoinsWithoutBlockHook",
+			hooks: []string{"TrackBeforeSend"},
+			run: func(ctx sdk.Context, input TestKeepers, user, user2 sdk.AccAddress, amount sdk.Coins) error {
+				return input.BankKeeper.SendCoinsWithoutBlockHook(ctx, user, user2, amount)
+			},
+		},
+		{
+			name:      "SendCoinsFromModuleToAccount",
+			hooks:     sendHooks,
+			blockable: true,
+			run: func(ctx sdk.Context, input TestKeepers, user, user2 sdk.AccAddress, amount sdk.Coins) error {
+				return input.BankKeeper.SendCoinsFromModuleToAccount(ctx, authtypes.Minter, user2, amount)
+			},
+		},
+		{
+			name:      "SendCoinsFromAccountToModule",
+			hooks:     sendHooks,
+			blockable: true,
+			run: func(ctx sdk.Context, input TestKeepers, user, user2 sdk.AccAddress, amount sdk.Coins) error {
+				return input.BankKeeper.SendCoinsFromAccountToModule(ctx, user, govtypes.ModuleName, amount)
+			},
+		},
+		{
+			name:  "SendCoinsFromModuleToModule",
+			hooks: []string{"TrackBeforeSend"},
+			run: func(ctx sdk.Context, input TestKeepers, user, user2 sdk.AccAddress, amount sdk.Coins) error {
+				return input.BankKeeper.SendCoinsFromModuleToModule(ctx, authtypes.Minter, govtypes.ModuleName, amount)
+			},
+		},
+		{
+			name:      "DelegateCoinsFromAccountToModule",
+			hooks:     sendHooks,
+			blockable: true,
+			run: func(ctx sdk.Context, input TestKeepers, user, user2 sdk.AccAddress, amount sdk.Coins) error {
+				return input.BankKeeper.DelegateCoinsFromAccountToModule(ctx, user, stakingModule, amount)
+			},
+		},
+		{
+			name:      "UndelegateCoinsFromModuleToAccount",
+			hooks:     sendHooks,
+			blockable: true,
+			run: func(ctx sdk.Context, input TestKeepers, user, user2 sdk.AccAddress, amount sdk.Coins) error {
+				if err := input.BankKeeper.BaseKeeper.DelegateCoinsFromAccountToModule(ctx, user, stakingModule, amount); err != nil {
+					return err
+				}
+
+				return input.BankKeeper.UndelegateCoinsFromModuleToAccount(ctx, stakingModule, user, amount)
+			},
+		},
+		{
+			name:      "InputOutputCoins",
+			hooks:     []string{"BlockBeforeSend", "BlockBeforeSend", "TrackBeforeSend", "TrackBeforeSend"},
+			blockable: true,
+			run: func(ctx sdk.Context, input TestKeepers, user, user2 sdk.AccAddress, amount sdk.Coins) error {
+				half := amount.QuoInt(math.NewInt(2))
+				return input.BankKeeper.InputOutputCoins(ctx, banktypes.NewInput(user, half.Add(half...)), []banktypes.Output{
+					banktypes.NewOutput(user2, half),
+					banktypes.NewOutput(user2, half),
+				})
+			},
+		},
+		{
+			name:      "MultiSendCoins",
+			hooks:     []string{"BlockBeforeSend", "BlockBeforeSend", "TrackBeforeSend", "TrackBeforeSend"},
+			blockable: true,
+			run: func(ctx sdk.Context, input TestKeepers, user, user2 sdk.AccAddress, amount sdk.Coins) error {
+				half := amount.QuoInt(math.NewInt(2))
+				return input.BankKeeper.MultiSendCoins(ctx, []customtypes.MultiSendLeg{
+					customtypes.NewMultiSendLeg(user.String(), user2.String(), half),
+					customtypes.NewMultiSendLeg(user.String(), user2.String(), half),
+				})
+			},
+		},
+		{
+			name:      "MintCoins",
+			hooks:     mintHooks,
+			blockable: true,
+			run: func(ctx sdk.Context, input TestKeepers, user, user2 sdk.AccAddress, amount sdk.Coins) error {
+				return input.BankKeeper.MintCoins(ctx, authtypes.Minter, amount)
+			},
+		},
+		{
+			name:      "BurnCoins",
+			hooks:     burnHooks,
+			blockable: true,
+			run: func(ctx sdk.Context, input TestKeepers, user, user2 sdk.AccAddress, amount sdk.Coins) error {
+				return input.BankKeeper.BurnCoins(ctx, authtypes.Minter, amount)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			for _, denom := range []string{testDenom, blockedDenom} {
+				ctx, input := createDefaultTestInput(t)
+				_, _, user := keyPubAddr()
+				_, _, user2 := keyPubAddr()
+
+				amount := sdk.NewCoins(sdk.NewInt64Coin(denom, 100))
+				fundAccount(t, ctx, input, user, amount)
+				fundAccount(t, ctx, input, input.AccountKeeper.GetModuleAddress(authtypes.Minter), amount)
+
+				err := tc.run(ctx, input, user, user2, amount)
+				if denom == blockedDenom && tc.blockable {
+					require.Error(t, err)
+
+					// the track hooks must not be called after a block hook failed
+					require.NotEmpty(t, *input.HookCalls)
+					for _, call := range *input.HookCalls {
+						require.NotContains(t, call, "Track")
+					}
+					continue
+				}
+
+				require.NoError(t, err)
+				require.Equal(t, tc.hooks, *input.HookCalls)
+			}
+		})
+	}
+}
+
+func Test_UndelegateCoins_Recipient(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	_, _, user := keyPubAddr()
+
+	amount := sdk.NewCoins(sdk.NewInt64Coin(testDenom, 100))
+	fundAccount(t, ctx, input, user, amount)
+
+	moduleAddr := input.AccountKeeper.GetModuleAddress(stakingModule)
+	require.NoError(t, input.BankKeeper.DelegateCoins(ctx, user, moduleAddr, amount))
+	require.True(t, input.BankKeeper.GetAllBalances(ctx, user).IsZero())
+	require.NoError(t, input.BankKeeper.UndelegateCoins(ctx, moduleAddr, user, amount))
+
+	// the coins must be sent to the delegator, not back to the module account
+	require.Equal(t, amount, input.BankKeeper.GetAllBalances(ctx, user))
+	require.True(t, input.BankKeeper.GetAllBalances(ctx, moduleAddr).IsZero())
+}
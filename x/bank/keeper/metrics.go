@@ -0,0 +1,197 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	metricsNamespace = "miniwasm"
+	metricsSubsystem = "bank"
+)
+
+// OtherDenomLabel is the denom label of the sends of the denoms which are not
+// allowed in the metrics.
+const OtherDenomLabel = "other"
+
+// blocked send reasons
+const (
+	BlockedReasonSendDisabled    = "send_disabled"
+	BlockedReasonBlockedAddress  = "blocked_address"
+	BlockedReasonHook            = "hook"
+	BlockedReasonSendRestriction = "send_restriction"
+)
+
+// Metrics defines the prometheus metrics of the bank module. The metrics are only
+// recorded while finalizing blocks, so the check and simulation runs are not counted.
+// A nil *Metrics records nothing.
+//
+// Anyone can create factory denoms and ibc vouchers, along with their metadata, so
+// only the allowed denoms are recorded with their own denom label to bound the
+// number of series. The sends of the other denoms are recorded as OtherDenomLabel.
+type Metrics struct {
+	denoms map[string]struct{}
+
+	sends        *prometheus.CounterVec
+	sendVolume   *prometheus.CounterVec
+	hookDuration *prometheus.HistogramVec
+	hookFailures *prometheus.CounterVec
+	blockedSends *prometheus.CounterVec
+}
+
+// NewMetrics creates the bank metrics of the chain and registers them to the
+// registerer. The metrics have the chain id as a const label, so the chains running
+// in a single process are recorded apart. If the metrics are already registered, the
+// registered collectors are reused.
+func NewMetrics(reg prometheus.Registerer, chainID string, denoms []string) *Metrics {
+	constLabels := prometheus.Labels{"chain_id": chainID}
+
+	m := &Metrics{
+		denoms: make(map[string]struct{}, len(denoms)),
+		sends: registerCollector(reg, prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace:   metricsNamespace,
+			Subsystem:   metricsSubsystem,
+			Name:        "sends_total",
+			Help:        "Number of coin sends, per denom.",
+			ConstLabels: constLabels,
+		}, []string{"denom"})),
+		sendVolume: registerCollector(reg, prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace:   metricsNamespace,
+			Subsystem:   metricsSubsystem,
+			Name:        "send_volume_total",
+			Help:        "Volume of coin sends in display units of the denom metadata, or base units if the denom has no metadata, per denom.",
+			ConstLabels: constLabels,
+		}, []string{"denom"})),
+		hookDuration: registerCollector(reg, prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace:   metricsNamespace,
+			Subsystem:   metricsSubsystem,
+			Name:        "hook_duration_seconds",
+			Help:        "Duration of the bank hook invocations, per listener and hook.",
+			Buckets:     []float64{0.0001, 0.0005, 0.001, 0.005, 0.01, 0.05, 0.1, 0.5, 1},
+			ConstLabels: constLabels,
+		}, []string{"listener", "hook"})),
+		hookFailures: registerCollector(reg, prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace:   metricsNamespace,
+			Subsystem:   metricsSubsystem,
+			Name:        "hook_failures_total",
+			Help:        "Number of failed bank hook invocations, per listener and hook.",
+			ConstLabels: constLabels,
+		}, []string{"listener", "hook"})),
+		blockedSends: registerCollector(reg, prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace:   metricsNamespace,
+			Subsystem:   metricsSubsystem,
+			Name:        "blocked_sends_total",
+			Help:        "Number of rejected sends, per reason.",
+			ConstLabels: constLabels,
+		}, []string{"reason"})),
+	}
+
+	for _, denom := range denoms {
+		m.denoms[denom] = struct{}{}
+	}
+
+	return m
+}
+
+// registerCollector registers the collector, or returns the registered one if it
+// already exists.
+func registerCollector[C prometheus.Collector](reg prometheus.Registerer, c C) C {
+	if err := reg.Register(c); err != nil {
+		var are prometheus.AlreadyRegisteredError
+		if errors.As(err, &are) {
+			if existing, ok := are.ExistingCollector.(C); ok {
+				return existing
+			}
+		}
+
+		panic(err)
+	}
+
+	return c
+}
+
+// enabled returns true if the metrics should be recorded in the context.
+func (m *Metrics) enabled(ctx context.Context) bool {
+	return m != nil && sdk.UnwrapSDKContext(ctx).ExecMode() == sdk.ExecModeFinalize
+}
+
+// ObserveHook records the duration and the failure of a hook invocation.
+func (m *Metrics) ObserveHook(ctx context.Context, listener, hook string, start time.Time, err error) {
+	if !m.enabled(ctx) {
+		return
+	}
+
+	m.hookDuration.WithLabelValues(listener, hook).Observe(time.Since(start).Seconds())
+	if err != nil {
+		m.hookFailures.WithLabelValues(listener, hook).Inc()
+	}
+}
+
+// RecordBlockedSend records a rejected send.
+func (m *Metrics) RecordBlockedSend(ctx context.Context, reason string) {
+	if !m.enabled(ctx) {
+		return
+	}
+
+	m.blockedSends.WithLabelValues(reason).Inc()
+}
+
+// recordSend records the count and the volume of a send. The exponents are looked up
+// from the denom metadata. The volume is only recorded for the allowed denoms, as
+// the amounts of the other denoms can't be summed.
+func (m *Metrics) recordSend(ctx context.Context, k Keeper, amount sdk.Coins) {
+	if !m.enabled(ctx) {
+		return
+	}
+
+	// the metadata lookup must not consume the gas of the tx
+	sdkCtx := sdk.UnwrapSDKContext(ctx).WithGasMeter(storetypes.NewInfiniteGasMeter())
+	for _, coin := range amount {
+		if _, ok := m.denoms[coin.Denom]; !ok {
+			m.sends.WithLabelValues(OtherDenomLabel).Inc()
+			continue
+		}
+
+		m.sends.WithLabelValues(coin.Denom).Inc()
+		m.sendVolume.WithLabelValues(coin.Denom).Add(scaleAmount(coin.Amount, k.displayExponent(sdkCtx, coin.Denom)))
+	}
+}
+
+// displayExponent returns the exponent of the display unit of the denom, or zero if
+// the denom has no metadata.
+func (k Keeper) displayExponent(ctx context.Context, denom string) uint32 {
+	metadata, found := k.GetDenomMetaData(ctx, denom)
+	if !found {
+		return 0
+	}
+
+	for _, unit := range metadata.DenomUnits {
+		if unit.Denom == metadata.Display {
+			return unit.Exponent
+		}
+	}
+
+	return 0
+}
+
+// scaleAmount converts the amount to the display unit with the exponent. The conversion
+// goes through big.Float, so the amounts which do not fit in int64 are not dropped.
+func scaleAmount(amount math.Int, exponent uint32) float64 {
+	value := new(big.Float).SetInt(amount.BigInt())
+	if exponent > 0 {
+		scale := new(big.Float).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(exponent)), nil))
+		value.Quo(value, scale)
+	}
+
+	f, _ := value.Float64()
+	return f
+}
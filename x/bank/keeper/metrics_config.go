@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"fmt"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cast"
+)
+
+const (
+	flagMetricsPrefix = "bank-metrics"
+	flagMetricsDenoms = "denoms"
+)
+
+// MetricsConfig defines the app.toml config of the bank metrics.
+type MetricsConfig struct {
+	// Denoms defines the denoms which are recorded with their own denom label.
+	Denoms []string `mapstructure:"denoms"`
+}
+
+// DefaultMetricsConfig returns the default config of the bank metrics.
+func DefaultMetricsConfig() MetricsConfig {
+	return MetricsConfig{Denoms: []string{}}
+}
+
+// NewMetricsConfig reads the config of the bank metrics from the app options.
+func NewMetricsConfig(appOpts servertypes.AppOptions) (MetricsConfig, error) {
+	cfg := DefaultMetricsConfig()
+
+	key := fmt.Sprintf("%s.%s", flagMetricsPrefix, flagMetricsDenoms)
+	if v := appOpts.Get(key); v != nil {
+		denoms, err := cast.ToStringSliceE(v)
+		if err != nil {
+			return MetricsConfig{}, fmt.Errorf("invalid %s: %w", key, err)
+		}
+		cfg.Denoms = denoms
+	}
+
+	return cfg, nil
+}
+
+// MetricsConfigTemplate is the app.toml template of the bank metrics.
+const MetricsConfigTemplate = `
+###############################################################################
+###                         Bank Metrics                                    ###
+###############################################################################
+
+[bank-metrics]
+
+# The denoms which are recorded with their own denom label in the send metrics,
+# when the telemetry is enabled. Anyone can create factory denoms and ibc
+# vouchers, so the sends of the other denoms are recorded as "other" to bound
+# the number of series.
+denoms = [{{ range $i, $denom := .BankMetricsConfig.Denoms }}{{ if $i }}, {{ end }}"{{ $denom }}"{{ end }}]
+`
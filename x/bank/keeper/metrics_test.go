@@ -0,0 +1,173 @@
+package keeper_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	bankkeeper "github.com/initia-labs/miniwasm/x/bank/keeper"
+)
+
+func Test_Metrics_Sends(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	reg := prometheus.NewRegistry()
+	input.BankKeeper.SetMetrics(bankkeeper.NewMetrics(reg, "minitia-1", []string{testDenom, "allowed"}))
+
+	// 18 decimals token; the amount does not fit in int64
+	input.BankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{
+		Base:    testDenom,
+		Display: "display",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: testDenom, Exponent: 0},
+			{Denom: "display", Exponent: 18},
+		},
+	})
+
+	_, _, user := keyPubAddr()
+	_, _, user2 := keyPubAddr()
+	amount, ok := math.NewIntFromString("1500000000000000000000")
+	require.True(t, ok)
+	coins := sdk.NewCoins(
+		sdk.NewCoin(testDenom, amount),
+		sdk.NewInt64Coin("allowed", 10),
+		sdk.NewInt64Coin("spam1", 10),
+		sdk.NewInt64Coin("spam2", 10),
+	)
+	fundAccount(t, ctx, input, user, coins.Add(coins...))
+
+	// not recorded out of the block finalization
+	require.NoError(t, input.BankKeeper.SendCoins(ctx, user, user2, coins))
+	require.Equal(t, 0, testutil.CollectAndCount(reg, "miniwasm_bank_sends_total"))
+
+	ctx = ctx.WithExecMode(sdk.ExecModeFinalize)
+	require.NoError(t, input.BankKeeper.SendCoins(ctx, user, user2, coins))
+
+	require.Equal(t, 3, testutil.CollectAndCount(reg, "miniwasm_bank_sends_total"))
+	require.Equal(t, float64(1), gatherValue(t, reg, "miniwasm_bank_sends_total", testDenom))
+	require.InDelta(t, 1500, volume(t, reg, testDenom), 1e-9)
+	// no metadata; the volume is in base units
+	require.InDelta(t, 10, volume(t, reg, "allowed"), 1e-9)
+
+	// the denoms which are not allowed are recorded as other, without the volume
+	require.Equal(t, float64(2), gatherValue(t, reg, "miniwasm_bank_sends_total", bankkeeper.OtherDenomLabel))
+	require.Equal(t, 2, testutil.CollectAndCount(reg, "miniwasm_bank_send_volume_total"))
+}
+
+func Test_Metrics_ChainID(t *testing.T) {
+	ctx, _ := createDefaultTestInput(t)
+	ctx = ctx.WithExecMode(sdk.ExecModeFinalize)
+	reg := prometheus.NewRegistry()
+
+	// the chains of a single process are recorded apart
+	metrics := bankkeeper.NewMetrics(reg, "minitia-1", nil)
+	otherMetrics := bankkeeper.NewMetrics(reg, "minitia-2", nil)
+	metrics.RecordBlockedSend(ctx, bankkeeper.BlockedReasonHook)
+	otherMetrics.RecordBlockedSend(ctx, bankkeeper.BlockedReasonHook)
+	otherMetrics.RecordBlockedSend(ctx, bankkeeper.BlockedReasonHook)
+
+	families, err := reg.Gather()
+	require.NoError(t, err)
+
+	values := make(map[string]float64)
+	for _, family := range families {
+		if family.GetName() != "miniwasm_bank_blocked_sends_total" {
+			continue
+		}
+
+		for _, metric := range family.GetMetric() {
+			for _, label := range metric.GetLabel() {
+				if label.GetName() == "chain_id" {
+					values[label.GetValue()] = metric.GetCounter().GetValue()
+				}
+			}
+		}
+	}
+	require.Equal(t, map[string]float64{"minitia-1": 1, "minitia-2": 2}, values)
+}
+
+func Test_Metrics_BlockedSends(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	ctx = ctx.WithExecMode(sdk.ExecModeFinalize)
+	reg := prometheus.NewRegistry()
+	input.BankKeeper.SetMetrics(bankkeeper.NewMetrics(reg, "minitia-1", nil))
+
+	_, _, user := keyPubAddr()
+	_, _, user2 := keyPubAddr()
+	fundAccount(t, ctx, input, user, sdk.NewCoins(sdk.NewInt64Coin(blockedDenom, 100), sdk.NewInt64Coin("restricted", 100)))
+
+	restrictionErr := errors.New("restricted")
+	input.BankKeeper.AppendSendRestriction(func(_ context.Context, _, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+		if amt.AmountOf("restricted").IsPositive() {
+			return nil, restrictionErr
+		}
+
+		return toAddr, nil
+	})
+
+	err := input.BankKeeper.SendCoins(ctx, user, user2, sdk.NewCoins(sdk.NewInt64Coin(blockedDenom, 10)))
+	require.Error(t, err)
+	err = input.BankKeeper.SendCoins(ctx, user, user2, sdk.NewCoins(sdk.NewInt64Coin("restricted", 10)))
+	require.ErrorIs(t, err, restrictionErr)
+
+	require.Equal(t, 2, testutil.CollectAndCount(reg, "miniwasm_bank_blocked_sends_total"))
+	require.Equal(t, 0, testutil.CollectAndCount(reg, "miniwasm_bank_sends_total"))
+}
+
+func Test_Metrics_Hooks(t *testing.T) {
+	ctx, _ := createDefaultTestInput(t)
+	ctx = ctx.WithExecMode(sdk.ExecModeFinalize)
+	reg := prometheus.NewRegistry()
+	metrics := bankkeeper.NewMetrics(reg, "minitia-1", nil)
+
+	metrics.ObserveHook(ctx, "tokenfactory", "BlockBeforeSend", time.Now(), nil)
+	metrics.ObserveHook(ctx, "tokenfactory", "BlockBeforeSend", time.Now(), errors.New("failed"))
+
+	require.Equal(t, 1, testutil.CollectAndCount(reg, "miniwasm_bank_hook_duration_seconds"))
+	require.Equal(t, 1, testutil.CollectAndCount(reg, "miniwasm_bank_hook_failures_total"))
+
+	// the registered collectors are reused
+	require.NotPanics(t, func() { bankkeeper.NewMetrics(reg, "minitia-1", nil) })
+
+	// nil metrics record nothing
+	var nilMetrics *bankkeeper.Metrics
+	require.NotPanics(t, func() {
+		nilMetrics.ObserveHook(ctx, "tokenfactory", "BlockBeforeSend", time.Now(), nil)
+		nilMetrics.RecordBlockedSend(ctx, bankkeeper.BlockedReasonHook)
+	})
+}
+
+// gatherValue returns the value of the metric with the denom label.
+func gatherValue(t *testing.T, reg *prometheus.Registry, name, denom string) float64 {
+	families, err := reg.Gather()
+	require.NoError(t, err)
+
+	for _, family := range families {
+		if family.GetName() != name {
+			continue
+		}
+
+		for _, metric := range family.GetMetric() {
+			for _, label := range metric.GetLabel() {
+				if label.GetName() == "denom" && label.GetValue() == denom {
+					return metric.GetCounter().GetValue()
+				}
+			}
+		}
+	}
+
+	require.FailNow(t, "metric not found", "%s{denom=%s}", name, denom)
+	return 0
+}
+
+func volume(t *testing.T, reg *prometheus.Registry, denom string) float64 {
+	return gatherValue(t, reg, "miniwasm_bank_send_volume_total", denom)
+}
@@ -3,22 +3,24 @@ package keeper
 import (
 	"context"
 
-	"github.com/hashicorp/go-metrics"
-
 	errorsmod "cosmossdk.io/errors"
 
-	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	customtypes "github.com/initia-labs/miniwasm/x/bank/types"
 )
 
 type msgServer struct {
 	*Keeper
 }
 
-var _ types.MsgServer = msgServer{}
+var (
+	_ types.MsgServer       = msgServer{}
+	_ customtypes.MsgServer = msgServer{}
+)
 
 // NewMsgServerImpl returns an implementation of the bank MsgServer interface
 // for the provided Keeper.
@@ -26,6 +28,12 @@ func NewMsgServerImpl(keeper *Keeper) types.MsgServer {
 	return &msgServer{Keeper: keeper}
 }
 
+// NewCustomMsgServerImpl returns an implementation of the miniwasm bank extension
+// MsgServer interface for the provided Keeper.
+func NewCustomMsgServerImpl(keeper *Keeper) customtypes.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
 func (k msgServer) Send(goCtx context.Context, msg *types.MsgSend) (*types.MsgSendResponse, error) {
 	var (
 		from, to []byte
@@ -51,10 +59,12 @@ func (k msgServer) Send(goCtx context.Context, msg *types.MsgSend) (*types.MsgSe
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	if err := k.IsSendEnabledCoins(ctx, msg.Amount...); err != nil {
+		k.metrics.RecordBlockedSend(ctx, BlockedReasonSendDisabled)
 		return nil, err
 	}
 
 	if k.BlockedAddr(to) {
+		k.metrics.RecordBlockedSend(ctx, BlockedReasonBlockedAddress)
 		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", msg.ToAddress)
 	}
 
@@ -63,18 +73,6 @@ func (k msgServer) Send(goCtx context.Context, msg *types.MsgSend) (*types.MsgSe
 		return nil, err
 	}
 
-	defer func() {
-		for _, a := range msg.Amount {
-			if a.Amount.IsInt64() {
-				telemetry.SetGaugeWithLabels(
-					[]string{"tx", "msg", "send"},
-					float32(a.Amount.Int64()),
-					[]metrics.Label{telemetry.NewLabel("denom", a.Denom)},
-				)
-			}
-		}
-	}()
-
 	return &types.MsgSendResponse{}, nil
 }
 
@@ -100,6 +98,7 @@ func (k msgServer) MultiSend(goCtx context.Context, msg *types.MsgMultiSend) (*t
 	// NOTE: totalIn == totalOut should already have been checked
 	for _, in := range msg.Inputs {
 		if err := k.IsSendEnabledCoins(ctx, in.Coins...); err != nil {
+			k.metrics.RecordBlockedSend(ctx, BlockedReasonSendDisabled)
 			return nil, err
 		}
 	}
@@ -111,6 +110,7 @@ func (k msgServer) MultiSend(goCtx context.Context, msg *types.MsgMultiSend) (*t
 		}
 
 		if k.BlockedAddr(accAddr) {
+			k.metrics.RecordBlockedSend(ctx, BlockedReasonBlockedAddress)
 			return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", out.Address)
 		}
 	}
@@ -123,6 +123,38 @@ func (k msgServer) MultiSend(goCtx context.Context, msg *types.MsgMultiSend) (*t
 	return &types.MsgMultiSendResponse{}, nil
 }
 
+// MultiSendV2 sends coins from multiple senders to multiple recipients. Every sender
+// signs the transaction, so the legs are executed atomically.
+func (k msgServer) MultiSendV2(goCtx context.Context, msg *customtypes.MsgMultiSendV2) (*customtypes.MsgMultiSendV2Response, error) {
+	if err := msg.Validate(k.ak.AddressCodec()); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	for _, leg := range msg.Legs {
+		if err := k.IsSendEnabledCoins(ctx, leg.Amount...); err != nil {
+			k.metrics.RecordBlockedSend(ctx, BlockedReasonSendDisabled)
+			return nil, err
+		}
+
+		accAddr, err := k.ak.AddressCodec().StringToBytes(leg.ToAddress)
+		if err != nil {
+			return nil, err
+		}
+
+		if k.BlockedAddr(accAddr) {
+			k.metrics.RecordBlockedSend(ctx, BlockedReasonBlockedAddress)
+			return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", leg.ToAddress)
+		}
+	}
+
+	if err := k.MultiSendCoins(ctx, msg.Legs); err != nil {
+		return nil, err
+	}
+
+	return &customtypes.MsgMultiSendV2Response{}, nil
+}
+
 func (k msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
 	if k.GetAuthority() != req.Authority {
 		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
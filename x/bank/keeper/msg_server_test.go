@@ -0,0 +1,109 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	bankkeeper "github.com/initia-labs/miniwasm/x/bank/keeper"
+	customtypes "github.com/initia-labs/miniwasm/x/bank/types"
+)
+
+func TestMsgMultiSendV2(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	msgServer := bankkeeper.NewCustomMsgServerImpl(input.BankKeeper)
+
+	_, _, alice := keyPubAddr()
+	_, _, bob := keyPubAddr()
+	_, _, carol := keyPubAddr()
+	_, _, dave := keyPubAddr()
+
+	fundAccount(t, ctx, input, alice, sdk.NewCoins(sdk.NewInt64Coin(testDenom, 100)))
+	fundAccount(t, ctx, input, bob, sdk.NewCoins(sdk.NewInt64Coin(testDenom, 100)))
+
+	msg := customtypes.NewMsgMultiSendV2([]customtypes.MultiSendLeg{
+		customtypes.NewMultiSendLeg(alice.String(), carol.String(), sdk.NewCoins(sdk.NewInt64Coin(testDenom, 30))),
+		customtypes.NewMultiSendLeg(bob.String(), carol.String(), sdk.NewCoins(sdk.NewInt64Coin(testDenom, 40))),
+		customtypes.NewMultiSendLeg(bob.String(), dave.String(), sdk.NewCoins(sdk.NewInt64Coin(testDenom, 50))),
+	})
+
+	// every sender is a signer
+	signers, _, err := MakeTestCodec(t).GetMsgV1Signers(msg)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{alice, bob, bob}, signers)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err = msgServer.MultiSendV2(ctx, msg)
+	require.NoError(t, err)
+
+	require.Equal(t, int64(70), input.BankKeeper.GetBalance(ctx, alice, testDenom).Amount.Int64())
+	require.Equal(t, int64(10), input.BankKeeper.GetBalance(ctx, bob, testDenom).Amount.Int64())
+	require.Equal(t, int64(70), input.BankKeeper.GetBalance(ctx, carol, testDenom).Amount.Int64())
+	require.Equal(t, int64(50), input.BankKeeper.GetBalance(ctx, dave, testDenom).Amount.Int64())
+
+	// the hooks see the real sender and recipient of each leg
+	require.Equal(t, [][2]sdk.AccAddress{{alice, carol}, {bob, carol}, {bob, dave}}, *input.BlockedSends)
+
+	// an event per leg
+	legEvents := []sdk.Event{}
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == customtypes.EventTypeMultiSendLeg {
+			legEvents = append(legEvents, event)
+		}
+	}
+	require.Len(t, legEvents, 3)
+	require.Equal(t, sdk.NewEvent(
+		customtypes.EventTypeMultiSendLeg,
+		sdk.NewAttribute(customtypes.AttributeKeyLegIndex, "2"),
+		sdk.NewAttribute(customtypes.AttributeKeySender, bob.String()),
+		sdk.NewAttribute(customtypes.AttributeKeyRecipient, dave.String()),
+		sdk.NewAttribute(customtypes.AttributeKeyAmount, "50"+testDenom),
+	), legEvents[2])
+}
+
+func TestMsgMultiSendV2_Atomic(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	msgServer := bankkeeper.NewCustomMsgServerImpl(input.BankKeeper)
+
+	_, _, alice := keyPubAddr()
+	_, _, bob := keyPubAddr()
+	_, _, carol := keyPubAddr()
+
+	fundAccount(t, ctx, input, alice, sdk.NewCoins(sdk.NewInt64Coin(testDenom, 100)))
+	fundAccount(t, ctx, input, bob, sdk.NewCoins(sdk.NewInt64Coin(blockedDenom, 100)))
+
+	// the second leg is blocked by the hook, so no leg is executed
+	cacheCtx, _ := ctx.CacheContext()
+	_, err := msgServer.MultiSendV2(cacheCtx, customtypes.NewMsgMultiSendV2([]customtypes.MultiSendLeg{
+		customtypes.NewMultiSendLeg(alice.String(), carol.String(), sdk.NewCoins(sdk.NewInt64Coin(testDenom, 30))),
+		customtypes.NewMultiSendLeg(bob.String(), carol.String(), sdk.NewCoins(sdk.NewInt64Coin(blockedDenom, 30))),
+	}))
+	require.Error(t, err)
+	require.True(t, input.BankKeeper.GetAllBalances(cacheCtx, carol).IsZero())
+	for _, call := range *input.HookCalls {
+		require.NotContains(t, call, "Track")
+	}
+
+	// insufficient funds of a later leg
+	input.ResetHookCalls()
+	cacheCtx, _ = ctx.CacheContext()
+	_, err = msgServer.MultiSendV2(cacheCtx, customtypes.NewMsgMultiSendV2([]customtypes.MultiSendLeg{
+		customtypes.NewMultiSendLeg(alice.String(), carol.String(), sdk.NewCoins(sdk.NewInt64Coin(testDenom, 30))),
+		customtypes.NewMultiSendLeg(alice.String(), carol.String(), sdk.NewCoins(sdk.NewInt64Coin(testDenom, 80))),
+	}))
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+
+	// no legs
+	_, err = msgServer.MultiSendV2(ctx, customtypes.NewMsgMultiSendV2(nil))
+	require.ErrorIs(t, err, banktypes.ErrNoOutputs)
+
+	// invalid amount
+	_, err = msgServer.MultiSendV2(ctx, customtypes.NewMsgMultiSendV2([]customtypes.MultiSendLeg{
+		customtypes.NewMultiSendLeg(alice.String(), carol.String(), sdk.Coins{}),
+	}))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidCoins)
+}
@@ -0,0 +1,147 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ BankHooks     = MultiBankHooks{}
+	_ MintBurnHooks = MultiBankHooks{}
+)
+
+// MultiBankHooks combines multiple bank hooks; all hook functions are run in array sequence.
+//
+// Each listener runs in its own cached context with its own gas meter. The state changes
+// of a listener are only written if it succeeds, and the gas it consumed is charged to the
+// parent context. A panic of a listener is recovered; it fails the operation for the
+// Block* hooks and is logged and ignored for the Track* hooks, so the other listeners
+// still observe the operation. An out of gas panic of a listener is not recovered, since
+// the listener only ran out of the remaining gas of the parent context.
+type MultiBankHooks []BankHooks
+
+// NewMultiBankHooks returns the bank hooks, which call the hooks in the given order.
+func NewMultiBankHooks(hooks ...BankHooks) MultiBankHooks {
+	return hooks
+}
+
+func (h MultiBankHooks) TrackBeforeSend(ctx context.Context, from, to sdk.AccAddress, amount sdk.Coins) {
+	for _, hook := range h {
+		_ = runHook(ctx, hook, "TrackBeforeSend", func(ctx context.Context) error {
+			hook.TrackBeforeSend(ctx, from, to, amount)
+			return nil
+		})
+	}
+}
+
+func (h MultiBankHooks) BlockBeforeSend(ctx context.Context, from, to sdk.AccAddress, amount sdk.Coins) error {
+	for _, hook := range h {
+		if err := runHook(ctx, hook, "BlockBeforeSend", func(ctx context.Context) error {
+			return hook.BlockBeforeSend(ctx, from, to, amount)
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (h MultiBankHooks) TrackBeforeMint(ctx context.Context, moduleAddr sdk.AccAddress, amount sdk.Coins) {
+	for _, hook := range h {
+		if hook, ok := hook.(MintBurnHooks); ok {
+			_ = runHook(ctx, hook, "TrackBeforeMint", func(ctx context.Context) error {
+				hook.TrackBeforeMint(ctx, moduleAddr, amount)
+				return nil
+			})
+		}
+	}
+}
+
+func (h MultiBankHooks) BlockBeforeMint(ctx context.Context, moduleAddr sdk.AccAddress, amount sdk.Coins) error {
+	for _, hook := range h {
+		if hook, ok := hook.(MintBurnHooks); ok {
+			if err := runHook(ctx, hook, "BlockBeforeMint", func(ctx context.Context) error {
+				return hook.BlockBeforeMint(ctx, moduleAddr, amount)
+			}); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (h MultiBankHooks) TrackBeforeBurn(ctx context.Context, moduleAddr sdk.AccAddress, amount sdk.Coins) {
+	for _, hook := range h {
+		if hook, ok := hook.(MintBurnHooks); ok {
+			_ = runHook(ctx, hook, "TrackBeforeBurn", func(ctx context.Context) error {
+				hook.TrackBeforeBurn(ctx, moduleAddr, amount)
+				return nil
+			})
+		}
+	}
+}
+
+func (h MultiBankHooks) BlockBeforeBurn(ctx context.Context, moduleAddr sdk.AccAddress, amount sdk.Coins) error {
+	for _, hook := range h {
+		if hook, ok := hook.(MintBurnHooks); ok {
+			if err := runHook(ctx, hook, "BlockBeforeBurn", func(ctx context.Context) error {
+				return hook.BlockBeforeBurn(ctx, moduleAddr, amount)
+			}); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// runHook runs a hook of a listener in a cached context with its own gas meter, limited
+// by the remaining gas of the parent context. The consumed gas is charged to the parent
+// context even if the hook fails, and the state changes are written only on success.
+func runHook(ctx context.Context, listener any, hookName string, fn func(ctx context.Context) error) (err error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	parentGasMeter := sdkCtx.GasMeter()
+
+	var gasMeter storetypes.GasMeter
+	if parentGasMeter.Limit() == math.MaxUint64 {
+		gasMeter = storetypes.NewInfiniteGasMeter()
+	} else {
+		gasMeter = storetypes.NewGasMeter(parentGasMeter.Limit() - parentGasMeter.GasConsumedToLimit())
+	}
+
+	cacheCtx, writeCache := sdkCtx.WithGasMeter(gasMeter).CacheContext()
+	defer func() {
+		r := recover()
+
+		// charge the gas consumed by the listener to the parent context; this may panic
+		// with out of gas, which must not be recovered here.
+		parentGasMeter.ConsumeGas(gasMeter.GasConsumedToLimit(), fmt.Sprintf("bank hook %s", hookName))
+
+		// the listener ran out of the remaining gas of the parent context, so the out of
+		// gas panic is propagated to abort the tx like any other out of gas.
+		if _, ok := r.(storetypes.ErrorOutOfGas); ok {
+			panic(r)
+		}
+
+		if r != nil {
+			err = errorsmod.Wrapf(sdkerrors.ErrPanic, "bank hook %s of %T panicked: %v", hookName, listener, r)
+			sdkCtx.Logger().Error("bank hook panicked", "hook", hookName, "listener", fmt.Sprintf("%T", listener), "error", err)
+		}
+
+		if err != nil {
+			return
+		}
+
+		writeCache()
+	}()
+
+	return fn(cacheCtx)
+}
@@ -0,0 +1,166 @@
+package keeper_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	bankkeeper "github.com/initia-labs/miniwasm/x/bank/keeper"
+)
+
+// testListener records its calls, writes its name to the store and consumes gas.
+type testListener struct {
+	name  string
+	calls *[]string
+	gas   uint64
+
+	panics   bool
+	blockErr error
+}
+
+var _ bankkeeper.BankHooks = testListener{}
+
+func (l testListener) run(ctx context.Context) {
+	*l.calls = append(*l.calls, l.name)
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.KVStore(testStoreKey).Set([]byte(l.name), []byte{1})
+	sdkCtx.GasMeter().ConsumeGas(l.gas, l.name)
+
+	if l.panics {
+		panic(l.name)
+	}
+}
+
+func (l testListener) TrackBeforeSend(ctx context.Context, _, _ sdk.AccAddress, _ sdk.Coins) {
+	l.run(ctx)
+}
+
+func (l testListener) BlockBeforeSend(ctx context.Context, _, _ sdk.AccAddress, _ sdk.Coins) error {
+	l.run(ctx)
+	return l.blockErr
+}
+
+func Test_MultiBankHooks_Order(t *testing.T) {
+	ctx, _ := createDefaultTestInput(t)
+	_, _, user := keyPubAddr()
+	_, _, user2 := keyPubAddr()
+
+	calls := []string{}
+	hooks := bankkeeper.NewMultiBankHooks(
+		testListener{name: "first", calls: &calls},
+		testListener{name: "second", calls: &calls},
+	)
+
+	amount := sdk.NewCoins(sdk.NewInt64Coin(testDenom, 100))
+	require.NoError(t, hooks.BlockBeforeSend(ctx, user, user2, amount))
+	hooks.TrackBeforeSend(ctx, user, user2, amount)
+	require.Equal(t, []string{"first", "second", "first", "second"}, calls)
+
+	// the mint and burn hooks are skipped for the listeners not implementing them
+	require.NoError(t, hooks.BlockBeforeMint(ctx, user, amount))
+	hooks.TrackBeforeBurn(ctx, user, amount)
+	require.Len(t, calls, 4)
+}
+
+func Test_MultiBankHooks_TrackPanicIsolation(t *testing.T) {
+	ctx, _ := createDefaultTestInput(t)
+	_, _, user := keyPubAddr()
+	_, _, user2 := keyPubAddr()
+
+	calls := []string{}
+	hooks := bankkeeper.NewMultiBankHooks(
+		testListener{name: "panicking", calls: &calls, gas: 1_000, panics: true},
+		testListener{name: "volume", calls: &calls, gas: 2_000},
+	)
+
+	ctx = ctx.WithGasMeter(storetypes.NewGasMeter(1_000_000))
+	hooks.TrackBeforeSend(ctx, user, user2, sdk.NewCoins(sdk.NewInt64Coin(testDenom, 100)))
+
+	// the other listeners still observe the send
+	require.Equal(t, []string{"panicking", "volume"}, calls)
+
+	// the state changes of the panicking listener are discarded
+	store := ctx.KVStore(testStoreKey)
+	require.False(t, store.Has([]byte("panicking")))
+	require.True(t, store.Has([]byte("volume")))
+
+	// the gas of both listeners is charged to the parent
+	require.GreaterOrEqual(t, ctx.GasMeter().GasConsumed(), uint64(3_000))
+}
+
+func Test_MultiBankHooks_BlockFailure(t *testing.T) {
+	ctx, _ := createDefaultTestInput(t)
+	_, _, user := keyPubAddr()
+	_, _, user2 := keyPubAddr()
+	amount := sdk.NewCoins(sdk.NewInt64Coin(testDenom, 100))
+
+	// a panic fails the operation
+	calls := []string{}
+	hooks := bankkeeper.NewMultiBankHooks(
+		testListener{name: "panicking", calls: &calls, panics: true},
+		testListener{name: "never", calls: &calls},
+	)
+	err := hooks.BlockBeforeSend(ctx, user, user2, amount)
+	require.ErrorIs(t, err, sdkerrors.ErrPanic)
+	require.Equal(t, []string{"panicking"}, calls)
+
+	// an error fails the operation and discards the state changes of the listener
+	calls = []string{}
+	blockErr := errors.New("compliance")
+	hooks = bankkeeper.NewMultiBankHooks(
+		testListener{name: "compliance", calls: &calls, blockErr: blockErr},
+	)
+	err = hooks.BlockBeforeSend(ctx, user, user2, amount)
+	require.ErrorIs(t, err, blockErr)
+	require.False(t, ctx.KVStore(testStoreKey).Has([]byte("compliance")))
+}
+
+func Test_MultiBankHooks_OutOfGas(t *testing.T) {
+	ctx, _ := createDefaultTestInput(t)
+	_, _, user := keyPubAddr()
+	_, _, user2 := keyPubAddr()
+
+	calls := []string{}
+	hooks := bankkeeper.NewMultiBankHooks(
+		testListener{name: "expensive", calls: &calls, gas: 10_000},
+	)
+
+	// the listener can not consume more than the remaining gas of the parent, and
+	// the out of gas is not converted to a listener failure
+	ctx = ctx.WithGasMeter(storetypes.NewGasMeter(5_000))
+	require.PanicsWithValue(t, storetypes.ErrorOutOfGas{Descriptor: "expensive"}, func() {
+		_ = hooks.BlockBeforeSend(ctx, user, user2, sdk.NewCoins(sdk.NewInt64Coin(testDenom, 100)))
+	})
+	require.Equal(t, uint64(5_000), ctx.GasMeter().GasConsumed())
+	require.False(t, ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()).KVStore(testStoreKey).Has([]byte("expensive")))
+}
+
+func Test_MultiBankHooks_TrackOutOfGas(t *testing.T) {
+	ctx, _ := createDefaultTestInput(t)
+	_, _, user := keyPubAddr()
+	_, _, user2 := keyPubAddr()
+
+	calls := []string{}
+	hooks := bankkeeper.NewMultiBankHooks(
+		testListener{name: "expensive", calls: &calls, gas: 10_000},
+		testListener{name: "next", calls: &calls},
+	)
+
+	// the out of gas of a track listener aborts the operation instead of being
+	// logged and ignored like a listener panic
+	ctx = ctx.WithGasMeter(storetypes.NewGasMeter(5_000))
+	require.PanicsWithValue(t, storetypes.ErrorOutOfGas{Descriptor: "expensive"}, func() {
+		hooks.TrackBeforeSend(ctx, user, user2, sdk.NewCoins(sdk.NewInt64Coin(testDenom, 100)))
+	})
+	require.Equal(t, []string{"expensive"}, calls)
+	require.Equal(t, uint64(5_000), ctx.GasMeter().GasConsumed())
+	require.False(t, ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()).KVStore(testStoreKey).Has([]byte("expensive")))
+}
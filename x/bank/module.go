@@ -2,12 +2,14 @@ package bank
 
 import (
 	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	bank "github.com/cosmos/cosmos-sdk/x/bank"
 	"github.com/cosmos/cosmos-sdk/x/bank/exported"
 	types "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"github.com/initia-labs/miniwasm/x/bank/keeper"
+	customtypes "github.com/initia-labs/miniwasm/x/bank/types"
 )
 
 const ConsensusVersion = 1
@@ -26,8 +28,23 @@ func NewAppModule(cdc codec.Codec, keeper *keeper.Keeper, accountKeeper types.Ac
 	}
 }
 
+// RegisterLegacyAminoCodec registers the bank module's types and the miniwasm
+// bank extension types on the LegacyAmino codec.
+func (am AppModule) RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	am.AppModule.RegisterLegacyAminoCodec(cdc)
+	customtypes.RegisterLegacyAminoCodec(cdc)
+}
+
+// RegisterInterfaces registers the bank module's interface types and the miniwasm
+// bank extension types.
+func (am AppModule) RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
+	am.AppModule.RegisterInterfaces(registry)
+	customtypes.RegisterInterfaces(registry)
+}
+
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.Keeper))
+	customtypes.RegisterMsgServer(cfg.MsgServer(), keeper.NewCustomMsgServerImpl(am.Keeper))
 	types.RegisterQueryServer(cfg.QueryServer(), am.Keeper.BaseKeeper)
 }
 
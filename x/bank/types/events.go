@@ -0,0 +1,11 @@
+package types
+
+// event types
+const (
+	EventTypeMultiSendLeg = "multi_send_leg"
+
+	AttributeKeyLegIndex  = "leg_index"
+	AttributeKeySender    = "sender"
+	AttributeKeyRecipient = "recipient"
+	AttributeKeyAmount    = "amount"
+)
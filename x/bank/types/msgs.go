@@ -0,0 +1,56 @@
+package types
+
+import (
+	"cosmossdk.io/core/address"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+var _ sdk.Msg = &MsgMultiSendV2{}
+
+// NewMsgMultiSendV2 creates a msg to send coins from multiple senders to multiple recipients
+func NewMsgMultiSendV2(legs []MultiSendLeg) *MsgMultiSendV2 {
+	return &MsgMultiSendV2{Legs: legs}
+}
+
+// NewMultiSendLeg creates a leg of a MsgMultiSendV2
+func NewMultiSendLeg(fromAddr, toAddr string, amount sdk.Coins) MultiSendLeg {
+	return MultiSendLeg{
+		FromAddress: fromAddr,
+		ToAddress:   toAddr,
+		Amount:      amount,
+	}
+}
+
+func (m MsgMultiSendV2) Validate(accAddrCodec address.Codec) error {
+	if len(m.Legs) == 0 {
+		return banktypes.ErrNoOutputs
+	}
+
+	for i, leg := range m.Legs {
+		if err := leg.Validate(accAddrCodec); err != nil {
+			return errorsmod.Wrapf(err, "leg %d", i)
+		}
+	}
+
+	return nil
+}
+
+func (m MultiSendLeg) Validate(accAddrCodec address.Codec) error {
+	if _, err := accAddrCodec.StringToBytes(m.FromAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid from address: %s", err)
+	}
+
+	if _, err := accAddrCodec.StringToBytes(m.ToAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid to address: %s", err)
+	}
+
+	if !m.Amount.IsValid() || !m.Amount.IsAllPositive() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, m.Amount.String())
+	}
+
+	return nil
+}
@@ -0,0 +1,851 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: miniwasm/bank/v1/tx.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgMultiSendV2 is the sdk.Msg type for sending coins from multiple senders
+// to multiple recipients in one transaction. Unlike the cosmos MsgMultiSend,
+// it allows multiple senders, and every sender must sign the transaction.
+// Either all the legs are executed or none of them.
+type MsgMultiSendV2 struct {
+	// legs are the transfers to execute, in order.
+	Legs []MultiSendLeg `protobuf:"bytes,1,rep,name=legs,proto3" json:"legs"`
+}
+
+func (m *MsgMultiSendV2) Reset()         { *m = MsgMultiSendV2{} }
+func (m *MsgMultiSendV2) String() string { return proto.CompactTextString(m) }
+func (*MsgMultiSendV2) ProtoMessage()    {}
+func (*MsgMultiSendV2) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d34f4975a63906b, []int{0}
+}
+func (m *MsgMultiSendV2) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMultiSendV2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMultiSendV2.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMultiSendV2) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMultiSendV2.Merge(m, src)
+}
+func (m *MsgMultiSendV2) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMultiSendV2) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMultiSendV2.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMultiSendV2 proto.InternalMessageInfo
+
+func (m *MsgMultiSendV2) GetLegs() []MultiSendLeg {
+	if m != nil {
+		return m.Legs
+	}
+	return nil
+}
+
+// MultiSendLeg defines a single transfer of a MsgMultiSendV2 from a sender to
+// a recipient.
+type MultiSendLeg struct {
+	FromAddress string                                   `protobuf:"bytes,1,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+	ToAddress   string                                   `protobuf:"bytes,2,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+	Amount      github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MultiSendLeg) Reset()         { *m = MultiSendLeg{} }
+func (m *MultiSendLeg) String() string { return proto.CompactTextString(m) }
+func (*MultiSendLeg) ProtoMessage()    {}
+func (*MultiSendLeg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d34f4975a63906b, []int{1}
+}
+func (m *MultiSendLeg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MultiSendLeg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MultiSendLeg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MultiSendLeg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiSendLeg.Merge(m, src)
+}
+func (m *MultiSendLeg) XXX_Size() int {
+	return m.Size()
+}
+func (m *MultiSendLeg) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiSendLeg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiSendLeg proto.InternalMessageInfo
+
+func (m *MultiSendLeg) GetFromAddress() string {
+	if m != nil {
+		return m.FromAddress
+	}
+	return ""
+}
+
+func (m *MultiSendLeg) GetToAddress() string {
+	if m != nil {
+		return m.ToAddress
+	}
+	return ""
+}
+
+func (m *MultiSendLeg) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// MsgMultiSendV2Response defines the response structure for executing a
+// MsgMultiSendV2 message.
+type MsgMultiSendV2Response struct {
+}
+
+func (m *MsgMultiSendV2Response) Reset()         { *m = MsgMultiSendV2Response{} }
+func (m *MsgMultiSendV2Response) String() string { return proto.CompactTextString(m) }
+func (*MsgMultiSendV2Response) ProtoMessage()    {}
+func (*MsgMultiSendV2Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d34f4975a63906b, []int{2}
+}
+func (m *MsgMultiSendV2Response) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMultiSendV2Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMultiSendV2Response.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMultiSendV2Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMultiSendV2Response.Merge(m, src)
+}
+func (m *MsgMultiSendV2Response) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMultiSendV2Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMultiSendV2Response.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMultiSendV2Response proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgMultiSendV2)(nil), "miniwasm.bank.v1.MsgMultiSendV2")
+	proto.RegisterType((*MultiSendLeg)(nil), "miniwasm.bank.v1.MultiSendLeg")
+	proto.RegisterType((*MsgMultiSendV2Response)(nil), "miniwasm.bank.v1.MsgMultiSendV2Response")
+}
+
+func init() { proto.RegisterFile("miniwasm/bank/v1/tx.proto", fileDescriptor_6d34f4975a63906b) }
+
+var fileDescriptor_6d34f4975a63906b = []byte{
+	// 459 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x52, 0x3d, 0x8f, 0x52, 0x41,
+	0x14, 0xe5, 0x2d, 0xba, 0x09, 0x03, 0x31, 0xee, 0x73, 0xa3, 0x40, 0xf1, 0x76, 0xa5, 0x22, 0x44,
+	0x66, 0x02, 0x16, 0x26, 0x6b, 0x2c, 0xc4, 0x64, 0x2b, 0x69, 0xd8, 0xc4, 0x44, 0x1b, 0x32, 0x0f,
+	0xc6, 0x71, 0xb2, 0xcc, 0x0c, 0x79, 0x77, 0xc0, 0xa5, 0x33, 0x96, 0x56, 0xc6, 0xd2, 0x5f, 0x60,
+	0xac, 0x28, 0xfc, 0x11, 0x5b, 0x6e, 0xac, 0xac, 0xd4, 0x40, 0xc1, 0xdf, 0x30, 0xf3, 0x01, 0x01,
+	0xb3, 0xc9, 0x36, 0xef, 0xcd, 0xcc, 0xb9, 0x67, 0xce, 0x9c, 0x73, 0x2f, 0xaa, 0x48, 0xa1, 0xc4,
+	0x7b, 0x0a, 0x92, 0xa4, 0x54, 0x9d, 0x93, 0x69, 0x8b, 0x98, 0x0b, 0x3c, 0xce, 0xb4, 0xd1, 0xf1,
+	0xdd, 0x35, 0x84, 0x2d, 0x84, 0xa7, 0xad, 0xea, 0x01, 0x95, 0x42, 0x69, 0xe2, 0xbe, 0xbe, 0xa8,
+	0x5a, 0x19, 0x68, 0x90, 0x1a, 0xfa, 0x6e, 0x47, 0xfc, 0x26, 0x40, 0x89, 0xdf, 0x91, 0x94, 0x02,
+	0x23, 0xd3, 0x56, 0xca, 0x0c, 0x6d, 0x91, 0x81, 0x16, 0x2a, 0xe0, 0x0f, 0x02, 0x2e, 0x81, 0x5b,
+	0x5d, 0x09, 0x3c, 0x00, 0x87, 0x5c, 0x73, 0xed, 0x2f, 0xb4, 0x2b, 0x7f, 0x5a, 0xcb, 0xd0, 0x9d,
+	0x2e, 0xf0, 0xee, 0x64, 0x64, 0xc4, 0x19, 0x53, 0xc3, 0x57, 0xed, 0xf8, 0x19, 0xba, 0x35, 0x62,
+	0x1c, 0xca, 0xd1, 0x71, 0xbe, 0x5e, 0x6c, 0x27, 0xf8, 0xff, 0xf7, 0xe2, 0x4d, 0xf1, 0x4b, 0xc6,
+	0x3b, 0x85, 0xcb, 0xdf, 0x47, 0xb9, 0x6f, 0xab, 0x79, 0x23, 0xea, 0x39, 0xda, 0xc9, 0xc3, 0x8f,
+	0xab, 0x79, 0xc3, 0x2d, 0x3f, 0xad, 0xe6, 0x8d, 0x7b, 0xce, 0xff, 0xae, 0x42, 0xed, 0xcb, 0x1e,
+	0x2a, 0x6d, 0x5f, 0x12, 0x3f, 0x45, 0xa5, 0xb7, 0x99, 0x96, 0x7d, 0x3a, 0x1c, 0x66, 0x0c, 0xac,
+	0x74, 0x54, 0x2f, 0x74, 0xca, 0x3f, 0x7f, 0x34, 0x0f, 0x83, 0xf7, 0xe7, 0x1e, 0x39, 0x33, 0x99,
+	0x50, 0xbc, 0x57, 0xb4, 0xd5, 0xe1, 0x28, 0x7e, 0x82, 0x90, 0xd1, 0x1b, 0xea, 0xde, 0x0d, 0xd4,
+	0x82, 0xd1, 0x6b, 0xe2, 0x0c, 0xed, 0x53, 0xa9, 0x27, 0xca, 0x94, 0xf3, 0xce, 0x6a, 0x05, 0x07,
+	0x86, 0x8d, 0x16, 0x87, 0x68, 0xf1, 0x0b, 0x2d, 0x54, 0xe7, 0xd4, 0xba, 0xfc, 0xfe, 0xe7, 0xa8,
+	0xce, 0x85, 0x79, 0x37, 0x49, 0xf1, 0x40, 0xcb, 0xd0, 0x95, 0xf0, 0x6b, 0xc2, 0xf0, 0x9c, 0x98,
+	0xd9, 0x98, 0x81, 0x23, 0xc0, 0xd7, 0xd5, 0xbc, 0x51, 0x1a, 0x31, 0x4e, 0x07, 0xb3, 0xbe, 0x6d,
+	0x0e, 0xf8, 0x88, 0x82, 0xe0, 0xc9, 0x81, 0x0d, 0x69, 0xc7, 0x73, 0xad, 0x8c, 0xee, 0xef, 0xc6,
+	0xd4, 0x63, 0x30, 0xd6, 0x0a, 0x58, 0x9b, 0xa3, 0x7c, 0x17, 0x78, 0xfc, 0x1a, 0x15, 0xb7, 0xdb,
+	0x74, 0x7c, 0x4d, 0x63, 0x76, 0xf8, 0xd5, 0xfa, 0x4d, 0x15, 0x6b, 0x85, 0xea, 0xed, 0x0f, 0xf6,
+	0x75, 0x9d, 0xd3, 0xcb, 0x45, 0x12, 0x5d, 0x2d, 0x92, 0xe8, 0xef, 0x22, 0x89, 0x3e, 0x2f, 0x93,
+	0xdc, 0xd5, 0x32, 0xc9, 0xfd, 0x5a, 0x26, 0xb9, 0x37, 0x8f, 0xb6, 0x7c, 0x0b, 0x25, 0x8c, 0xa0,
+	0xcd, 0x11, 0x4d, 0x81, 0x6c, 0xc6, 0xfc, 0xc2, 0x0f, 0xba, 0x4b, 0x20, 0xdd, 0x77, 0xa3, 0xf5,
+	0xf8, 0xdf, 0x00, 0xd2, 0x80, 0x72, 0x3a, 0x06, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// MultiSendV2 defines a method for sending coins from multiple senders to
+	// multiple recipients atomically.
+	MultiSendV2(ctx context.Context, in *MsgMultiSendV2, opts ...grpc.CallOption) (*MsgMultiSendV2Response, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) MultiSendV2(ctx context.Context, in *MsgMultiSendV2, opts ...grpc.CallOption) (*MsgMultiSendV2Response, error) {
+	out := new(MsgMultiSendV2Response)
+	err := c.cc.Invoke(ctx, "/miniwasm.bank.v1.Msg/MultiSendV2", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// MultiSendV2 defines a method for sending coins from multiple senders to
+	// multiple recipients atomically.
+	MultiSendV2(context.Context, *MsgMultiSendV2) (*MsgMultiSendV2Response, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) MultiSendV2(ctx context.Context, req *MsgMultiSendV2) (*MsgMultiSendV2Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MultiSendV2 not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_MultiSendV2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMultiSendV2)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MultiSendV2(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/miniwasm.bank.v1.Msg/MultiSendV2",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MultiSendV2(ctx, req.(*MsgMultiSendV2))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "miniwasm.bank.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "MultiSendV2",
+			Handler:    _Msg_MultiSendV2_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "miniwasm/bank/v1/tx.proto",
+}
+
+func (m *MsgMultiSendV2) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMultiSendV2) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMultiSendV2) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Legs) > 0 {
+		for iNdEx := len(m.Legs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Legs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MultiSendLeg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MultiSendLeg) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MultiSendLeg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMultiSendV2Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMultiSendV2Response) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMultiSendV2Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgMultiSendV2) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Legs) > 0 {
+		for _, e := range m.Legs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MultiSendLeg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgMultiSendV2Response) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgMultiSendV2) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMultiSendV2: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMultiSendV2: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Legs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Legs = append(m.Legs, MultiSendLeg{})
+			if err := m.Legs[len(m.Legs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MultiSendLeg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MultiSendLeg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MultiSendLeg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMultiSendV2Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMultiSendV2Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMultiSendV2Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
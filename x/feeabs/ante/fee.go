@@ -0,0 +1,132 @@
+package ante
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	opchildante "github.com/initia-labs/OPinit/x/opchild/ante"
+	opchildtypes "github.com/initia-labs/OPinit/x/opchild/types"
+)
+
+// FeeAbsKeeper defines the expected feeabs keeper used to convert the
+// alternative fee denoms.
+type FeeAbsKeeper interface {
+	IsFeeDenom(ctx context.Context, denom string) (bool, error)
+	ConvertToBaseDenom(ctx context.Context, coin sdk.Coin) (sdk.Coin, error)
+}
+
+// MempoolFeeChecker extends the opchild MempoolFeeChecker to accept the
+// governance whitelisted alternative fee denoms. The alternative fee coins
+// are converted to the base fee denoms with the oracle prices before being
+// compared with the min gas prices.
+//
+// The fee coins are returned as they are, so the DeductFeeDecorator forwards
+// the alternative fee coins to the fee collector without swapping them.
+// Note this only applies when ctx.CheckTx = true
+// CONTRACT: Tx must implement FeeTx to use MempoolFeeChecker
+type MempoolFeeChecker struct {
+	keeper       opchildtypes.AnteKeeper
+	feeAbsKeeper FeeAbsKeeper
+}
+
+// NewMempoolFeeChecker create MempoolFeeChecker instance
+func NewMempoolFeeChecker(
+	keeper opchildtypes.AnteKeeper,
+	feeAbsKeeper FeeAbsKeeper,
+) MempoolFeeChecker {
+	return MempoolFeeChecker{
+		keeper:       keeper,
+		feeAbsKeeper: feeAbsKeeper,
+	}
+}
+
+func (mfd MempoolFeeChecker) CheckTxFeeWithMinGasPrices(ctx sdk.Context, tx sdk.Tx) (sdk.Coins, int64, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return nil, 0, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	feeCoins := feeTx.GetFee()
+	gas := feeTx.GetGas()
+
+	if ctx.IsCheckTx() {
+		minGasPrices := ctx.MinGasPrices()
+		if mfd.keeper != nil {
+			paramsMinGasPrices, err := mfd.keeper.MinGasPrices(ctx)
+			if err != nil {
+				return nil, 0, err
+			}
+
+			minGasPrices = opchildante.CombinedMinGasPrices(minGasPrices, paramsMinGasPrices)
+		}
+
+		if !minGasPrices.IsZero() {
+			requiredFees := computeRequiredFees(gas, minGasPrices)
+			if feeCoins.IsAnyGTE(requiredFees) {
+				return feeCoins, 1 /* FIFO */, nil
+			}
+
+			convertedFees, err := mfd.convertFees(ctx, feeCoins)
+			if err != nil {
+				return nil, 0, err
+			}
+
+			if !convertedFees.IsAnyGTE(requiredFees) {
+				return nil, 0, errorsmod.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s (converted: %s) required: %s", feeCoins, convertedFees, requiredFees)
+			}
+		}
+	}
+
+	return feeCoins, 1 /* FIFO */, nil
+}
+
+// convertFees converts the alternative fee coins to the base fee denoms and
+// adds them to the other fee coins.
+func (mfd MempoolFeeChecker) convertFees(ctx sdk.Context, feeCoins sdk.Coins) (sdk.Coins, error) {
+	if mfd.feeAbsKeeper == nil {
+		return feeCoins, nil
+	}
+
+	convertedFees := sdk.NewCoins()
+	for _, coin := range feeCoins {
+		isFeeDenom, err := mfd.feeAbsKeeper.IsFeeDenom(ctx, coin.Denom)
+		if err != nil {
+			return nil, err
+		} else if !isFeeDenom {
+			convertedFees = convertedFees.Add(coin)
+			continue
+		}
+
+		converted, err := mfd.feeAbsKeeper.ConvertToBaseDenom(ctx, coin)
+		if err != nil {
+			return nil, err
+		}
+
+		convertedFees = convertedFees.Add(converted)
+	}
+
+	return convertedFees, nil
+}
+
+// computeRequiredFees returns required fees
+func computeRequiredFees(gas uint64, minGasPrices sdk.DecCoins) sdk.Coins {
+	// special case: if minGasPrices=[], requiredFees=[]
+	requiredFees := make(sdk.Coins, len(minGasPrices))
+
+	// if not all coins are zero, check fee with min_gas_price
+	if !minGasPrices.IsZero() {
+		// Determine the required fees by multiplying each required minimum gas
+		// price by the gas limit, where fee = ceil(minGasPrice * gasLimit).
+		for i, gp := range minGasPrices {
+			fee := gp.Amount.MulInt(math.NewIntFromUint64(gas))
+			requiredFees[i] = sdk.NewCoin(gp.Denom, fee.Ceil().RoundInt())
+		}
+	}
+
+	return requiredFees.Sort()
+}
@@ -0,0 +1,106 @@
+package ante_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store"
+	"cosmossdk.io/store/metrics"
+	dbm "github.com/cosmos/cosmos-db"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/initia-labs/miniwasm/x/feeabs/ante"
+	"github.com/initia-labs/miniwasm/x/feeabs/types"
+)
+
+type mockFeeTx struct {
+	sdk.Tx
+
+	fee sdk.Coins
+	gas uint64
+}
+
+func (tx mockFeeTx) GetFee() sdk.Coins  { return tx.fee }
+func (tx mockFeeTx) GetGas() uint64     { return tx.gas }
+func (tx mockFeeTx) FeePayer() []byte   { return nil }
+func (tx mockFeeTx) FeeGranter() []byte { return nil }
+
+type mockOPChildKeeper struct {
+	minGasPrices sdk.DecCoins
+}
+
+func (k mockOPChildKeeper) MinGasPrices(context.Context) (sdk.DecCoins, error) {
+	return k.minGasPrices, nil
+}
+
+// mockFeeAbsKeeper converts the uusdc to umin 1:2 and fails for the ustale.
+type mockFeeAbsKeeper struct{}
+
+func (mockFeeAbsKeeper) IsFeeDenom(_ context.Context, denom string) (bool, error) {
+	return denom == "uusdc" || denom == "ustale", nil
+}
+
+func (mockFeeAbsKeeper) ConvertToBaseDenom(_ context.Context, coin sdk.Coin) (sdk.Coin, error) {
+	if coin.Denom == "ustale" {
+		return sdk.Coin{}, types.ErrStalePrice
+	}
+
+	return sdk.NewCoin("umin", coin.Amount.MulRaw(2)), nil
+}
+
+func Test_CheckTxFeeWithMinGasPrices(t *testing.T) {
+	ms := store.NewCommitMultiStore(dbm.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics())
+	ctx := sdk.NewContext(ms, tmproto.Header{}, true, log.NewNopLogger())
+
+	checker := ante.NewMempoolFeeChecker(
+		mockOPChildKeeper{minGasPrices: sdk.NewDecCoins(sdk.NewDecCoinFromDec("umin", math.LegacyOneDec()))},
+		mockFeeAbsKeeper{},
+	)
+
+	// base denom
+	fee := sdk.NewCoins(sdk.NewInt64Coin("umin", 100))
+	feeCoins, priority, err := checker.CheckTxFeeWithMinGasPrices(ctx, mockFeeTx{fee: fee, gas: 100})
+	require.NoError(t, err)
+	require.Equal(t, fee, feeCoins)
+	require.Equal(t, int64(1), priority)
+
+	// alternative denom is returned as it is
+	fee = sdk.NewCoins(sdk.NewInt64Coin("uusdc", 50))
+	feeCoins, _, err = checker.CheckTxFeeWithMinGasPrices(ctx, mockFeeTx{fee: fee, gas: 100})
+	require.NoError(t, err)
+	require.Equal(t, fee, feeCoins)
+
+	// insufficient alternative fee
+	fee = sdk.NewCoins(sdk.NewInt64Coin("uusdc", 49))
+	_, _, err = checker.CheckTxFeeWithMinGasPrices(ctx, mockFeeTx{fee: fee, gas: 100})
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+
+	// base and alternative denoms are added up
+	fee = sdk.NewCoins(sdk.NewInt64Coin("umin", 2), sdk.NewInt64Coin("uusdc", 49))
+	_, _, err = checker.CheckTxFeeWithMinGasPrices(ctx, mockFeeTx{fee: fee, gas: 100})
+	require.NoError(t, err)
+
+	// not whitelisted denom
+	fee = sdk.NewCoins(sdk.NewInt64Coin("uatom", 1000))
+	_, _, err = checker.CheckTxFeeWithMinGasPrices(ctx, mockFeeTx{fee: fee, gas: 100})
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+
+	// stale price
+	fee = sdk.NewCoins(sdk.NewInt64Coin("ustale", 1000))
+	_, _, err = checker.CheckTxFeeWithMinGasPrices(ctx, mockFeeTx{fee: fee, gas: 100})
+	require.ErrorIs(t, err, types.ErrStalePrice)
+
+	// not checked out of CheckTx
+	fee = sdk.NewCoins(sdk.NewInt64Coin("uatom", 1))
+	feeCoins, _, err = checker.CheckTxFeeWithMinGasPrices(ctx.WithIsCheckTx(false), mockFeeTx{fee: fee, gas: 100})
+	require.NoError(t, err)
+	require.Equal(t, fee, feeCoins)
+}
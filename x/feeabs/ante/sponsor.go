@@ -0,0 +1,255 @@
+package ante
+
+import (
+	"context"
+	"encoding/json"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+
+	"github.com/initia-labs/miniwasm/x/feeabs/types"
+)
+
+// ContractKeeper defines the expected wasm keeper used to ask the sponsoring
+// contracts.
+type ContractKeeper interface {
+	Sudo(ctx context.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error)
+}
+
+// ExtensionOptionChecker accepts the sponsored fee extension option.
+func ExtensionOptionChecker(any *codectypes.Any) bool {
+	return any.TypeUrl == "/"+proto.MessageName(&types.ExtensionOptionSponsoredFee{})
+}
+
+// SponsoredFeeDecorator checks the fee of a tx which has the sponsored fee
+// extension option and sets the priority of the tx, without deducting the fee
+// from the fee payer. The other txs are handed to the wrapped DeductFeeDecorator.
+//
+// The sponsoring contract is only asked after the signatures are verified, by
+// the DeductSponsoredFeeDecorator, so a tx with a forged signer never reaches
+// the contract.
+type SponsoredFeeDecorator struct {
+	txFeeChecker ante.TxFeeChecker
+
+	deductFeeDecorator sdk.AnteDecorator
+}
+
+// NewSponsoredFeeDecorator create SponsoredFeeDecorator instance
+func NewSponsoredFeeDecorator(
+	txFeeChecker ante.TxFeeChecker,
+	deductFeeDecorator sdk.AnteDecorator,
+) SponsoredFeeDecorator {
+	return SponsoredFeeDecorator{
+		txFeeChecker:       txFeeChecker,
+		deductFeeDecorator: deductFeeDecorator,
+	}
+}
+
+func (sfd SponsoredFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	_, found, err := getSponsoredFee(tx)
+	if err != nil {
+		return ctx, err
+	} else if !found {
+		return sfd.deductFeeDecorator.AnteHandle(ctx, tx, simulate, next)
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	if !simulate && ctx.BlockHeight() > 0 && feeTx.GetGas() == 0 {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrInvalidGasLimit, "must provide positive gas")
+	}
+
+	if feeTx.FeeGranter() != nil {
+		return ctx, errorsmod.Wrap(types.ErrInvalidSponsor, "fee granter can't be used with a fee sponsor")
+	}
+
+	var priority int64
+	if !simulate {
+		_, priority, err = sfd.txFeeChecker(ctx, tx)
+		if err != nil {
+			return ctx, err
+		}
+	}
+
+	newCtx := ctx.WithPriority(priority)
+
+	return next(newCtx, tx, simulate)
+}
+
+// DeductSponsoredFeeDecorator deducts the fee of a tx which has the sponsored fee
+// extension option from the balance of the sponsoring contract, after the
+// contract approves it with the sponsor_fee sudo call. It must run after the
+// signature verification, so the signer given to the contract is authenticated,
+// and after the SponsoredFeeDecorator, which checks the fee. The other txs are
+// passed through.
+//
+// The sudo call is executed in a cached context with SponsorFeeGasLimit gas,
+// and its state changes are written only when the contract approves.
+type DeductSponsoredFeeDecorator struct {
+	cdc            codec.Codec
+	accountKeeper  ante.AccountKeeper
+	bankKeeper     authtypes.BankKeeper
+	contractKeeper ContractKeeper
+}
+
+// NewDeductSponsoredFeeDecorator create DeductSponsoredFeeDecorator instance
+func NewDeductSponsoredFeeDecorator(
+	cdc codec.Codec,
+	accountKeeper ante.AccountKeeper,
+	bankKeeper authtypes.BankKeeper,
+	contractKeeper ContractKeeper,
+) DeductSponsoredFeeDecorator {
+	return DeductSponsoredFeeDecorator{
+		cdc:            cdc,
+		accountKeeper:  accountKeeper,
+		bankKeeper:     bankKeeper,
+		contractKeeper: contractKeeper,
+	}
+}
+
+func (dsfd DeductSponsoredFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	sponsor, found, err := getSponsoredFee(tx)
+	if err != nil {
+		return ctx, err
+	} else if !found {
+		return next(ctx, tx, simulate)
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	// the fee is checked by the SponsoredFeeDecorator, and the fee checkers
+	// return the fee coins of the tx as they are.
+	fee := feeTx.GetFee()
+
+	contractAddr, err := dsfd.accountKeeper.AddressCodec().StringToBytes(sponsor.Contract)
+	if err != nil {
+		return ctx, errorsmod.Wrapf(types.ErrInvalidSponsor, "invalid contract address: %s", err)
+	}
+
+	signer, err := dsfd.accountKeeper.AddressCodec().BytesToString(feeTx.FeePayer())
+	if err != nil {
+		return ctx, err
+	}
+
+	if err := dsfd.sponsorFee(ctx, contractAddr, tx.GetMsgs(), signer, fee); err != nil {
+		return ctx, err
+	}
+
+	sponsorAcc := dsfd.accountKeeper.GetAccount(ctx, contractAddr)
+	if sponsorAcc == nil {
+		return ctx, sdkerrors.ErrUnknownAddress.Wrapf("fee sponsor address: %s does not exist", sponsor.Contract)
+	}
+
+	// deduct the fees from the sponsor
+	if !fee.IsZero() {
+		if err := ante.DeductFees(dsfd.bankKeeper, ctx, sponsorAcc, fee); err != nil {
+			return ctx, err
+		}
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeTx,
+			sdk.NewAttribute(sdk.AttributeKeyFee, fee.String()),
+			sdk.NewAttribute(sdk.AttributeKeyFeePayer, sponsor.Contract),
+		),
+		sdk.NewEvent(
+			types.EventTypeSponsorFee,
+			sdk.NewAttribute(types.AttributeKeyContract, sponsor.Contract),
+			sdk.NewAttribute(types.AttributeKeySigner, signer),
+			sdk.NewAttribute(types.AttributeKeyFee, fee.String()),
+		),
+	})
+
+	return next(ctx, tx, simulate)
+}
+
+// sponsorFee asks the sponsoring contract to sponsor the fee. The contract
+// rejects the sponsorship by returning an error.
+func (dsfd DeductSponsoredFeeDecorator) sponsorFee(ctx sdk.Context, contractAddr sdk.AccAddress, msgs []sdk.Msg, signer string, fee sdk.Coins) (err error) {
+	txMsgs := make([]json.RawMessage, len(msgs))
+	for i, msg := range msgs {
+		txMsgs[i], err = dsfd.cdc.MarshalInterfaceJSON(msg)
+		if err != nil {
+			return err
+		}
+	}
+
+	msgBz, err := json.Marshal(types.SponsorFeeSudoMsg{
+		SponsorFee: types.SponsorFeeMsg{
+			TxMsgs: txMsgs,
+			Signer: signer,
+			Fee:    wasmkeeper.ConvertSdkCoinsToWasmCoins(fee),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(storetypes.NewGasMeter(types.SponsorFeeGasLimit))
+	defer func() {
+		// consume gas used for calling contract to the parent ctx
+		ctx.GasMeter().ConsumeGas(cacheCtx.GasMeter().GasConsumedToLimit(), "sponsor fee")
+	}()
+
+	defer func() {
+		if r := recover(); r != nil {
+			oog, ok := r.(storetypes.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+
+			err = errorsmod.Wrapf(types.ErrSponsorOutOfGas, "out of gas in location: %s; gasLimit: %d", oog.Descriptor, types.SponsorFeeGasLimit)
+		}
+	}()
+
+	if _, err := dsfd.contractKeeper.Sudo(cacheCtx, contractAddr, msgBz); err != nil {
+		return errorsmod.Wrap(types.ErrSponsorRejected, err.Error())
+	}
+
+	// write the cache context only if the contract approved the sponsorship
+	write()
+
+	return nil
+}
+
+// getSponsoredFee returns the sponsored fee extension option of the tx.
+func getSponsoredFee(tx sdk.Tx) (types.ExtensionOptionSponsoredFee, bool, error) {
+	extTx, ok := tx.(ante.HasExtensionOptionsTx)
+	if !ok {
+		return types.ExtensionOptionSponsoredFee{}, false, nil
+	}
+
+	for _, opt := range extTx.GetExtensionOptions() {
+		if !ExtensionOptionChecker(opt) {
+			continue
+		}
+
+		var sponsor types.ExtensionOptionSponsoredFee
+		if err := sponsor.Unmarshal(opt.Value); err != nil {
+			return types.ExtensionOptionSponsoredFee{}, false, errorsmod.Wrapf(types.ErrInvalidSponsor, "failed to decode extension option: %s", err)
+		}
+
+		return sponsor, true, nil
+	}
+
+	return types.ExtensionOptionSponsoredFee{}, false, nil
+}
@@ -0,0 +1,229 @@
+package ante_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/log"
+	"cosmossdk.io/store"
+	"cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+	dbm "github.com/cosmos/cosmos-db"
+
+	codecaddress "github.com/cosmos/cosmos-sdk/codec/address"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/initia-labs/miniwasm/x/feeabs/ante"
+	"github.com/initia-labs/miniwasm/x/feeabs/types"
+)
+
+var (
+	signerAddr   = sdk.AccAddress([]byte("signer______________"))
+	contractAddr = sdk.AccAddress([]byte("contract____________"))
+	granterAddr  = sdk.AccAddress([]byte("granter_____________"))
+
+	sponsorStoreKey = storetypes.NewKVStoreKey("sponsor")
+)
+
+type mockSponsoredTx struct {
+	mockFeeTx
+
+	msgs    []sdk.Msg
+	granter sdk.AccAddress
+	extOpts []*codectypes.Any
+}
+
+func (tx mockSponsoredTx) GetMsgs() []sdk.Msg                                { return tx.msgs }
+func (tx mockSponsoredTx) FeePayer() []byte                                  { return signerAddr }
+func (tx mockSponsoredTx) FeeGranter() []byte                                { return tx.granter }
+func (tx mockSponsoredTx) GetExtensionOptions() []*codectypes.Any            { return tx.extOpts }
+func (tx mockSponsoredTx) GetNonCriticalExtensionOptions() []*codectypes.Any { return nil }
+
+type mockAccountKeeper struct {
+	ac address.Codec
+}
+
+func (k mockAccountKeeper) GetParams(context.Context) authtypes.Params {
+	return authtypes.DefaultParams()
+}
+func (k mockAccountKeeper) GetAccount(_ context.Context, addr sdk.AccAddress) sdk.AccountI {
+	return authtypes.NewBaseAccountWithAddress(addr)
+}
+func (k mockAccountKeeper) SetAccount(context.Context, sdk.AccountI) {}
+func (k mockAccountKeeper) GetModuleAddress(moduleName string) sdk.AccAddress {
+	return authtypes.NewModuleAddress(moduleName)
+}
+func (k mockAccountKeeper) AddressCodec() address.Codec { return k.ac }
+
+// mockBankKeeper records the fees deducted from each account.
+type mockBankKeeper struct {
+	deducted map[string]sdk.Coins
+}
+
+func (k *mockBankKeeper) IsSendEnabledCoins(context.Context, ...sdk.Coin) error { return nil }
+func (k *mockBankKeeper) SendCoins(context.Context, sdk.AccAddress, sdk.AccAddress, sdk.Coins) error {
+	return nil
+}
+func (k *mockBankKeeper) SendCoinsFromAccountToModule(_ context.Context, from sdk.AccAddress, _ string, amt sdk.Coins) error {
+	k.deducted[from.String()] = k.deducted[from.String()].Add(amt...)
+	return nil
+}
+
+// mockContractKeeper stores the sudo msg, and rejects or consumes gas as set.
+type mockContractKeeper struct {
+	msgs   []types.SponsorFeeSudoMsg
+	reject bool
+	gas    uint64
+}
+
+func (k *mockContractKeeper) Sudo(ctx context.Context, _ sdk.AccAddress, msg []byte) ([]byte, error) {
+	var sudoMsg types.SponsorFeeSudoMsg
+	if err := json.Unmarshal(msg, &sudoMsg); err != nil {
+		return nil, err
+	}
+	k.msgs = append(k.msgs, sudoMsg)
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.KVStore(sponsorStoreKey).Set([]byte(sudoMsg.SponsorFee.Signer), []byte{1})
+	sdkCtx.GasMeter().ConsumeGas(k.gas, "sponsor")
+
+	if k.reject {
+		return nil, errors.New("not sponsored")
+	}
+
+	return nil, nil
+}
+
+// mockDeductFeeDecorator records whether it was called.
+type mockDeductFeeDecorator struct {
+	called *bool
+}
+
+func (d mockDeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	*d.called = true
+	return next(ctx, tx, simulate)
+}
+
+// mockSigVerificationDecorator rejects the txs while forged is set.
+type mockSigVerificationDecorator struct {
+	forged *bool
+}
+
+func (d mockSigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if *d.forged {
+		return ctx, sdkerrors.ErrUnauthorized.Wrap("signature verification failed")
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+func Test_SponsoredFeeDecorator(t *testing.T) {
+	encodingConfig := moduletestutil.MakeTestEncodingConfig()
+	banktypes.RegisterInterfaces(encodingConfig.InterfaceRegistry)
+	types.RegisterInterfaces(encodingConfig.InterfaceRegistry)
+
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+	ms.MountStoreWithDB(sponsorStoreKey, storetypes.StoreTypeIAVL, db)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	ctx := sdk.NewContext(ms, tmproto.Header{Height: 1}, false, log.NewNopLogger()).
+		WithGasMeter(storetypes.NewInfiniteGasMeter())
+
+	ac := codecaddress.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	bankKeeper := &mockBankKeeper{deducted: make(map[string]sdk.Coins)}
+	contractKeeper := &mockContractKeeper{}
+	deductFeeCalled := false
+	feeChecker := func(ctx sdk.Context, tx sdk.Tx) (sdk.Coins, int64, error) {
+		return tx.(sdk.FeeTx).GetFee(), 1, nil
+	}
+
+	forged := false
+	anteHandler := sdk.ChainAnteDecorators(
+		ante.NewSponsoredFeeDecorator(feeChecker, mockDeductFeeDecorator{called: &deductFeeCalled}),
+		mockSigVerificationDecorator{forged: &forged},
+		ante.NewDeductSponsoredFeeDecorator(encodingConfig.Codec, mockAccountKeeper{ac: ac}, bankKeeper, contractKeeper),
+	)
+
+	contract, err := ac.BytesToString(contractAddr)
+	require.NoError(t, err)
+	signer, err := ac.BytesToString(signerAddr)
+	require.NoError(t, err)
+	extOpt, err := codectypes.NewAnyWithValue(&types.ExtensionOptionSponsoredFee{Contract: contract})
+	require.NoError(t, err)
+	require.True(t, ante.ExtensionOptionChecker(extOpt))
+
+	fee := sdk.NewCoins(sdk.NewInt64Coin("umin", 100))
+	msgs := []sdk.Msg{banktypes.NewMsgSend(signerAddr, contractAddr, fee)}
+
+	// not sponsored tx is handed to the deduct fee decorator
+	_, err = anteHandler(ctx, mockSponsoredTx{mockFeeTx: mockFeeTx{fee: fee, gas: 100_000}, msgs: msgs}, false)
+	require.NoError(t, err)
+	require.True(t, deductFeeCalled)
+	require.Empty(t, contractKeeper.msgs)
+
+	// the contract is not asked with a forged signer
+	tx := mockSponsoredTx{mockFeeTx: mockFeeTx{fee: fee, gas: 100_000}, msgs: msgs, extOpts: []*codectypes.Any{extOpt}}
+	forged = true
+	_, err = anteHandler(ctx, tx, false)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	require.Empty(t, contractKeeper.msgs)
+	require.Empty(t, bankKeeper.deducted)
+	require.False(t, ctx.KVStore(sponsorStoreKey).Has([]byte(signer)))
+
+	// approved sponsorship
+	forged = false
+	_, err = anteHandler(ctx, tx, false)
+	require.NoError(t, err)
+	require.Equal(t, fee, bankKeeper.deducted[contractAddr.String()])
+	require.Empty(t, bankKeeper.deducted[signerAddr.String()])
+	require.True(t, ctx.KVStore(sponsorStoreKey).Has([]byte(signer)))
+
+	require.Len(t, contractKeeper.msgs, 1)
+	sudoMsg := contractKeeper.msgs[0].SponsorFee
+	require.Equal(t, signer, sudoMsg.Signer)
+	require.Equal(t, "umin", sudoMsg.Fee[0].Denom)
+	require.Equal(t, "100", sudoMsg.Fee[0].Amount)
+	require.Len(t, sudoMsg.TxMsgs, 1)
+	require.Contains(t, string(sudoMsg.TxMsgs[0]), "/cosmos.bank.v1beta1.MsgSend")
+
+	found := false
+	for _, event := range ctx.EventManager().Events() {
+		found = found || event.Type == types.EventTypeSponsorFee
+	}
+	require.True(t, found)
+
+	// rejected sponsorship doesn't write the contract state
+	ctx.KVStore(sponsorStoreKey).Delete([]byte(signer))
+	contractKeeper.reject = true
+	_, err = anteHandler(ctx, tx, false)
+	require.ErrorIs(t, err, types.ErrSponsorRejected)
+	require.Equal(t, fee, bankKeeper.deducted[contractAddr.String()])
+	require.False(t, ctx.KVStore(sponsorStoreKey).Has([]byte(signer)))
+
+	// the sponsor gas is capped
+	contractKeeper.reject = false
+	contractKeeper.gas = types.SponsorFeeGasLimit + 1
+	gasBefore := ctx.GasMeter().GasConsumed()
+	_, err = anteHandler(ctx, tx, false)
+	require.ErrorIs(t, err, types.ErrSponsorOutOfGas)
+	require.Equal(t, types.SponsorFeeGasLimit, ctx.GasMeter().GasConsumed()-gasBefore)
+
+	// fee granter can't be used with a sponsor
+	contractKeeper.gas = 0
+	tx.granter = granterAddr
+	_, err = anteHandler(ctx, tx, false)
+	require.ErrorIs(t, err, types.ErrInvalidSponsor)
+}
@@ -0,0 +1,112 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store"
+	"cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+	dbm "github.com/cosmos/cosmos-db"
+
+	codecaddress "github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	oracletypes "github.com/skip-mev/connect/v2/x/oracle/types"
+
+	"github.com/initia-labs/miniwasm/x/feeabs/keeper"
+	"github.com/initia-labs/miniwasm/x/feeabs/types"
+)
+
+var blockTime = time.Date(2020, time.April, 22, 12, 0, 0, 0, time.UTC)
+
+type mockPrice struct {
+	price    oracletypes.QuotePrice
+	decimals uint64
+}
+
+// mockOracleKeeper returns the prices set by the tests.
+type mockOracleKeeper struct {
+	prices map[string]mockPrice
+}
+
+func (k *mockOracleKeeper) SetPrice(cp string, price int64, decimals uint64, updatedAt time.Time) {
+	k.prices[cp] = mockPrice{
+		price: oracletypes.QuotePrice{
+			Price:          math.NewInt(price),
+			BlockTimestamp: updatedAt,
+		},
+		decimals: decimals,
+	}
+}
+
+func (k *mockOracleKeeper) GetPriceForCurrencyPair(_ sdk.Context, cp connecttypes.CurrencyPair) (oracletypes.QuotePrice, error) {
+	price, found := k.prices[cp.String()]
+	if !found {
+		return oracletypes.QuotePrice{}, oracletypes.NewQuotePriceNotExistError(cp)
+	}
+
+	return price.price, nil
+}
+
+func (k *mockOracleKeeper) GetDecimalsForCurrencyPair(_ sdk.Context, cp connecttypes.CurrencyPair) (uint64, error) {
+	price, found := k.prices[cp.String()]
+	if !found {
+		return 0, oracletypes.NewQuotePriceNotExistError(cp)
+	}
+
+	return price.decimals, nil
+}
+
+type TestKeepers struct {
+	AddressCodec address.Codec
+	OracleKeeper *mockOracleKeeper
+	FeeAbsKeeper *keeper.Keeper
+}
+
+func createDefaultTestInput(t testing.TB) (sdk.Context, TestKeepers) {
+	encodingConfig := moduletestutil.MakeTestEncodingConfig()
+	types.RegisterInterfaces(encodingConfig.InterfaceRegistry)
+
+	db := dbm.NewMemDB()
+	keys := storetypes.NewKVStoreKeys(types.StoreKey)
+	ms := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+	for _, v := range keys {
+		ms.MountStoreWithDB(v, storetypes.StoreTypeIAVL, db)
+	}
+	require.NoError(t, ms.LoadLatestVersion())
+
+	ctx := sdk.NewContext(ms, tmproto.Header{
+		Height: 1234567,
+		Time:   blockTime,
+	}, false, log.NewNopLogger())
+
+	ac := codecaddress.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	oracleKeeper := &mockOracleKeeper{prices: make(map[string]mockPrice)}
+	feeAbsKeeper := keeper.NewKeeper(
+		ac,
+		encodingConfig.Codec,
+		runtime.NewKVStoreService(keys[types.StoreKey]),
+		oracleKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+	feeAbsKeeper.InitGenesis(ctx, *types.DefaultGenesis())
+
+	return ctx, TestKeepers{
+		AddressCodec: ac,
+		OracleKeeper: oracleKeeper,
+		FeeAbsKeeper: feeAbsKeeper,
+	}
+}
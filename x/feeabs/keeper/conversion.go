@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/miniwasm/x/feeabs/types"
+)
+
+// IsFeeDenom returns true if the denom is an allowed alternative fee denom.
+func (k Keeper) IsFeeDenom(ctx context.Context, denom string) (bool, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	_, found := params.GetFeeDenom(denom)
+	return found, nil
+}
+
+// ConvertToBaseDenom converts an alternative fee coin to the base fee denom
+// with the oracle price, deducting the conversion spread. The price must not
+// be older than the max price age.
+func (k Keeper) ConvertToBaseDenom(ctx context.Context, coin sdk.Coin) (sdk.Coin, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	feeDenom, found := params.GetFeeDenom(coin.Denom)
+	if !found {
+		return sdk.Coin{}, errorsmod.Wrap(types.ErrFeeDenomNotAllowed, coin.Denom)
+	}
+
+	price, err := k.getPrice(ctx, params, feeDenom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// scale the price from the display units to the base units of the denoms
+	price = price.Mul(pow10(feeDenom.BaseExponent)).Quo(pow10(feeDenom.DenomExponent))
+
+	amount := price.MulInt(coin.Amount).Mul(math.LegacyOneDec().Sub(params.ConversionSpread)).TruncateInt()
+	return sdk.NewCoin(feeDenom.BaseDenom, amount), nil
+}
+
+// getPrice returns the oracle price of the fee denom in the base denom display
+// units.
+func (k Keeper) getPrice(ctx context.Context, params types.Params, feeDenom types.FeeDenom) (math.LegacyDec, error) {
+	cp, err := feeDenom.ParseCurrencyPair()
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	quotePrice, err := k.oracleKeeper.GetPriceForCurrencyPair(sdkCtx, cp)
+	if err != nil {
+		return math.LegacyDec{}, errorsmod.Wrapf(types.ErrInvalidPrice, "failed to get price of %s: %s", cp, err)
+	}
+
+	if quotePrice.Price.IsNil() || !quotePrice.Price.IsPositive() {
+		return math.LegacyDec{}, errorsmod.Wrapf(types.ErrInvalidPrice, "non-positive price of %s", cp)
+	}
+
+	if age := sdkCtx.BlockTime().Sub(quotePrice.BlockTimestamp); age > params.MaxPriceAge {
+		return math.LegacyDec{}, errorsmod.Wrapf(types.ErrStalePrice, "price of %s is %s old; max age: %s", cp, age, params.MaxPriceAge)
+	}
+
+	decimals, err := k.oracleKeeper.GetDecimalsForCurrencyPair(sdkCtx, cp)
+	if err != nil {
+		return math.LegacyDec{}, errorsmod.Wrapf(types.ErrInvalidPrice, "failed to get decimals of %s: %s", cp, err)
+	}
+
+	return math.LegacyNewDecFromInt(quotePrice.Price).Quo(pow10(decimals)), nil
+}
+
+// pow10 returns 10^exp as a decimal. The exponents of the fee denoms are bounded by
+// types.MaxExponent, so the result never overflows.
+func pow10[T uint32 | uint64](exp T) math.LegacyDec {
+	return math.LegacyNewDecFromInt(math.NewIntWithDecimal(1, int(exp)))
+}
@@ -0,0 +1,94 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/miniwasm/x/feeabs/types"
+)
+
+func setFeeDenoms(t *testing.T, ctx sdk.Context, input TestKeepers, spread math.LegacyDec, feeDenoms ...types.FeeDenom) {
+	params := types.DefaultParams()
+	params.FeeDenoms = feeDenoms
+	params.ConversionSpread = spread
+	require.NoError(t, input.FeeAbsKeeper.SetParams(ctx, params))
+}
+
+func Test_ConvertToBaseDenom(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	setFeeDenoms(t, ctx, input, math.LegacyNewDecWithPrec(1, 2), types.FeeDenom{
+		Denom:         "uusdc",
+		BaseDenom:     "umin",
+		CurrencyPair:  "USDC/MIN",
+		DenomExponent: 6,
+		BaseExponent:  6,
+	}, types.FeeDenom{
+		Denom:         "weth",
+		BaseDenom:     "umin",
+		CurrencyPair:  "ETH/MIN",
+		DenomExponent: 18,
+		BaseExponent:  6,
+	})
+
+	// 1 USDC = 2.5 MIN
+	input.OracleKeeper.SetPrice("USDC/MIN", 250000000, 8, blockTime)
+	// 1 ETH = 1000 MIN
+	input.OracleKeeper.SetPrice("ETH/MIN", 1000000, 3, blockTime.Add(-time.Second))
+
+	converted, err := input.FeeAbsKeeper.ConvertToBaseDenom(ctx, sdk.NewInt64Coin("uusdc", 1_000_000))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("umin", 2_475_000), converted)
+
+	converted, err = input.FeeAbsKeeper.ConvertToBaseDenom(ctx, sdk.NewCoin("weth", math.NewIntWithDecimal(1, 15)))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("umin", 990_000), converted)
+
+	// not whitelisted
+	_, err = input.FeeAbsKeeper.ConvertToBaseDenom(ctx, sdk.NewInt64Coin("uatom", 1_000_000))
+	require.ErrorIs(t, err, types.ErrFeeDenomNotAllowed)
+
+	isFeeDenom, err := input.FeeAbsKeeper.IsFeeDenom(ctx, "uusdc")
+	require.NoError(t, err)
+	require.True(t, isFeeDenom)
+
+	isFeeDenom, err = input.FeeAbsKeeper.IsFeeDenom(ctx, "uatom")
+	require.NoError(t, err)
+	require.False(t, isFeeDenom)
+}
+
+func Test_ConvertToBaseDenom_InvalidPrice(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	setFeeDenoms(t, ctx, input, math.LegacyZeroDec(), types.FeeDenom{
+		Denom:         "uusdc",
+		BaseDenom:     "umin",
+		CurrencyPair:  "USDC/MIN",
+		DenomExponent: 6,
+		BaseExponent:  6,
+	})
+
+	// no price
+	_, err := input.FeeAbsKeeper.ConvertToBaseDenom(ctx, sdk.NewInt64Coin("uusdc", 1_000_000))
+	require.ErrorIs(t, err, types.ErrInvalidPrice)
+
+	// zero price
+	input.OracleKeeper.SetPrice("USDC/MIN", 0, 8, blockTime)
+	_, err = input.FeeAbsKeeper.ConvertToBaseDenom(ctx, sdk.NewInt64Coin("uusdc", 1_000_000))
+	require.ErrorIs(t, err, types.ErrInvalidPrice)
+
+	// stale price
+	input.OracleKeeper.SetPrice("USDC/MIN", 100000000, 8, blockTime.Add(-types.DefaultMaxPriceAge-time.Second))
+	_, err = input.FeeAbsKeeper.ConvertToBaseDenom(ctx, sdk.NewInt64Coin("uusdc", 1_000_000))
+	require.ErrorIs(t, err, types.ErrStalePrice)
+
+	// exactly max age is fine
+	input.OracleKeeper.SetPrice("USDC/MIN", 100000000, 8, blockTime.Add(-types.DefaultMaxPriceAge))
+	converted, err := input.FeeAbsKeeper.ConvertToBaseDenom(ctx, sdk.NewInt64Coin("uusdc", 1_000_000))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("umin", 1_000_000), converted)
+}
@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/initia-labs/miniwasm/x/feeabs/types"
+)
+
+// InitGenesis initializes the feeabs module's state from a provided genesis
+// state.
+func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) {
+	if err := k.SetParams(ctx, genState.Params); err != nil {
+		panic(err)
+	}
+}
+
+// ExportGenesis returns the feeabs module's exported genesis.
+func (k Keeper) ExportGenesis(ctx context.Context) *types.GenesisState {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	return &types.GenesisState{
+		Params: params,
+	}
+}
@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/initia-labs/miniwasm/x/feeabs/types"
+)
+
+type Querier struct {
+	*Keeper
+}
+
+var _ types.QueryServer = Querier{}
+
+func (q Querier) Params(ctx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	params, err := q.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+func (q Querier) ConvertedFee(ctx context.Context, req *types.QueryConvertedFeeRequest) (*types.QueryConvertedFeeResponse, error) {
+	amount, ok := math.NewIntFromString(req.Amount)
+	if !ok || amount.IsNegative() {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid amount: %s", req.Amount)
+	}
+
+	converted, err := q.ConvertToBaseDenom(ctx, sdk.NewCoin(req.Denom, amount))
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryConvertedFeeResponse{Converted: converted}, nil
+}
@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+	corestoretypes "cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/miniwasm/x/feeabs/types"
+)
+
+type Keeper struct {
+	ac           address.Codec
+	cdc          codec.Codec
+	storeService corestoretypes.KVStoreService
+
+	oracleKeeper types.OracleKeeper
+
+	Schema collections.Schema
+	Params collections.Item[types.Params]
+
+	authority string
+}
+
+// NewKeeper returns a new instance of the x/feeabs keeper
+func NewKeeper(
+	ac address.Codec,
+	cdc codec.Codec,
+	storeService corestoretypes.KVStoreService,
+	oracleKeeper types.OracleKeeper,
+	authority string,
+) *Keeper {
+	// ensure that authority is a valid AccAddress
+	if _, err := ac.StringToBytes(authority); err != nil {
+		panic("authority is not a valid acc address")
+	}
+
+	sb := collections.NewSchemaBuilder(storeService)
+	k := &Keeper{
+		ac:           ac,
+		cdc:          cdc,
+		storeService: storeService,
+
+		oracleKeeper: oracleKeeper,
+
+		Params: collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+
+		authority: authority,
+	}
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
+}
+
+// GetAuthority returns the x/feeabs module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a logger for the x/feeabs module
+func (k Keeper) Logger(ctx context.Context) log.Logger {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return sdkCtx.Logger().With("module", "x/"+types.ModuleName)
+}
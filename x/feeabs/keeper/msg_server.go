@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/initia-labs/miniwasm/x/feeabs/types"
+)
+
+type msgServer struct {
+	*Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper *Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+func (server msgServer) UpdateParams(ctx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if server.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", server.GetAuthority(), req.Authority)
+	}
+
+	if err := req.Params.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := server.SetParams(ctx, req.Params); err != nil {
+		return nil, err
+	}
+
+	denoms := make([]string, len(req.Params.FeeDenoms))
+	for i, feeDenom := range req.Params.FeeDenoms {
+		denoms[i] = feeDenom.Denom
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeUpdateParams,
+		sdk.NewAttribute(types.AttributeKeyFeeDenoms, strings.Join(denoms, ",")),
+		sdk.NewAttribute(types.AttributeKeyMaxPriceAge, req.Params.MaxPriceAge.String()),
+		sdk.NewAttribute(types.AttributeKeyConversionSpread, req.Params.ConversionSpread.String()),
+	))
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
@@ -0,0 +1,47 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/initia-labs/miniwasm/x/feeabs/keeper"
+	"github.com/initia-labs/miniwasm/x/feeabs/types"
+)
+
+func Test_UpdateParams(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	ms := keeper.NewMsgServerImpl(input.FeeAbsKeeper)
+
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	params := types.DefaultParams()
+	params.FeeDenoms = []types.FeeDenom{{
+		Denom:         "uusdc",
+		BaseDenom:     "umin",
+		CurrencyPair:  "USDC/MIN",
+		DenomExponent: 6,
+		BaseExponent:  6,
+	}}
+
+	// invalid authority
+	_, err := ms.UpdateParams(ctx, types.NewMsgUpdateParams(authtypes.NewModuleAddress("invalid").String(), params))
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+
+	// invalid params
+	invalidParams := params
+	invalidParams.ConversionSpread = math.LegacyOneDec()
+	_, err = ms.UpdateParams(ctx, types.NewMsgUpdateParams(authority, invalidParams))
+	require.ErrorIs(t, err, types.ErrInvalidSpread)
+
+	_, err = ms.UpdateParams(ctx, types.NewMsgUpdateParams(authority, params))
+	require.NoError(t, err)
+
+	res, err := input.FeeAbsKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	require.Equal(t, params, res)
+}
@@ -0,0 +1,17 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/initia-labs/miniwasm/x/feeabs/types"
+)
+
+// GetParams returns the total set params.
+func (k Keeper) GetParams(ctx context.Context) (types.Params, error) {
+	return k.Params.Get(ctx)
+}
+
+// SetParams sets the total set of params.
+func (k Keeper) SetParams(ctx context.Context, params types.Params) error {
+	return k.Params.Set(ctx, params)
+}
@@ -0,0 +1,21 @@
+package types
+
+// DONTCOVER
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// x/feeabs module sentinel errors
+var (
+	ErrInvalidFeeDenom    = errorsmod.Register(ModuleName, 2, "invalid fee denom")
+	ErrDuplicateFeeDenom  = errorsmod.Register(ModuleName, 3, "duplicate fee denom")
+	ErrFeeDenomNotAllowed = errorsmod.Register(ModuleName, 4, "fee denom is not allowed")
+	ErrInvalidSpread      = errorsmod.Register(ModuleName, 5, "invalid conversion spread")
+	ErrInvalidMaxPriceAge = errorsmod.Register(ModuleName, 6, "invalid max price age")
+	ErrStalePrice         = errorsmod.Register(ModuleName, 7, "stale oracle price")
+	ErrInvalidPrice       = errorsmod.Register(ModuleName, 8, "invalid oracle price")
+	ErrInvalidSponsor     = errorsmod.Register(ModuleName, 9, "invalid fee sponsor")
+	ErrSponsorRejected    = errorsmod.Register(ModuleName, 10, "fee sponsorship rejected")
+	ErrSponsorOutOfGas    = errorsmod.Register(ModuleName, 11, "fee sponsor out of gas")
+)
@@ -0,0 +1,13 @@
+package types
+
+const (
+	EventTypeUpdateParams = "update_params"
+	EventTypeSponsorFee   = "sponsor_fee"
+
+	AttributeKeyFeeDenoms        = "fee_denoms"
+	AttributeKeyMaxPriceAge      = "max_price_age"
+	AttributeKeyConversionSpread = "conversion_spread"
+	AttributeKeyContract         = "contract"
+	AttributeKeySigner           = "signer"
+	AttributeKeyFee              = "fee"
+)
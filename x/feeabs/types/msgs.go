@@ -0,0 +1,27 @@
+package types
+
+import (
+	"cosmossdk.io/core/address"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ sdk.Msg = &MsgUpdateParams{}
+)
+
+// NewMsgUpdateParams creates a msg to update the feeabs params
+func NewMsgUpdateParams(authority string, params Params) *MsgUpdateParams {
+	return &MsgUpdateParams{
+		Authority: authority,
+		Params:    params,
+	}
+}
+
+func (m MsgUpdateParams) Validate(accAddrCodec address.Codec) error {
+	if _, err := accAddrCodec.StringToBytes(m.Authority); err != nil {
+		return err
+	}
+
+	return m.Params.Validate()
+}
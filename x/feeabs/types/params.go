@@ -0,0 +1,107 @@
+package types
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+)
+
+var (
+	// DefaultMaxPriceAge is the default max age of the oracle prices.
+	DefaultMaxPriceAge = time.Minute
+
+	// DefaultConversionSpread is the default conversion spread, 1%.
+	DefaultConversionSpread = math.LegacyNewDecWithPrec(1, 2)
+)
+
+// MaxExponent is the max exponent of the display unit of a fee denom, which keeps
+// the conversion within the precision of the decimals.
+const MaxExponent = 18
+
+func NewParams(feeDenoms []FeeDenom, maxPriceAge time.Duration, conversionSpread math.LegacyDec) Params {
+	return Params{
+		FeeDenoms:        feeDenoms,
+		MaxPriceAge:      maxPriceAge,
+		ConversionSpread: conversionSpread,
+	}
+}
+
+// DefaultParams returns default feeabs module parameters.
+func DefaultParams() Params {
+	return NewParams([]FeeDenom{}, DefaultMaxPriceAge, DefaultConversionSpread)
+}
+
+// Validate performs basic validation on feeabs parameters.
+func (p Params) Validate() error {
+	if p.MaxPriceAge <= 0 {
+		return errorsmod.Wrapf(ErrInvalidMaxPriceAge, "max price age must be positive: %s", p.MaxPriceAge)
+	}
+
+	if p.ConversionSpread.IsNil() || p.ConversionSpread.IsNegative() || p.ConversionSpread.GTE(math.LegacyOneDec()) {
+		return errorsmod.Wrapf(ErrInvalidSpread, "conversion spread must be in [0, 1): %s", p.ConversionSpread)
+	}
+
+	seen := make(map[string]bool, len(p.FeeDenoms))
+	for _, feeDenom := range p.FeeDenoms {
+		if err := feeDenom.Validate(); err != nil {
+			return err
+		}
+
+		if seen[feeDenom.Denom] {
+			return errorsmod.Wrap(ErrDuplicateFeeDenom, feeDenom.Denom)
+		}
+		seen[feeDenom.Denom] = true
+	}
+
+	return nil
+}
+
+// GetFeeDenom returns the fee denom config of the denom.
+func (p Params) GetFeeDenom(denom string) (FeeDenom, bool) {
+	for _, feeDenom := range p.FeeDenoms {
+		if feeDenom.Denom == denom {
+			return feeDenom, true
+		}
+	}
+
+	return FeeDenom{}, false
+}
+
+// Validate performs basic validation on a fee denom.
+func (fd FeeDenom) Validate() error {
+	if err := sdk.ValidateDenom(fd.Denom); err != nil {
+		return errorsmod.Wrapf(ErrInvalidFeeDenom, "invalid denom: %s", err)
+	}
+
+	if err := sdk.ValidateDenom(fd.BaseDenom); err != nil {
+		return errorsmod.Wrapf(ErrInvalidFeeDenom, "invalid base denom: %s", err)
+	}
+
+	if fd.Denom == fd.BaseDenom {
+		return errorsmod.Wrapf(ErrInvalidFeeDenom, "denom and base denom are the same: %s", fd.Denom)
+	}
+
+	if _, err := fd.ParseCurrencyPair(); err != nil {
+		return errorsmod.Wrapf(ErrInvalidFeeDenom, "invalid currency pair: %s", err)
+	}
+
+	if fd.DenomExponent > MaxExponent {
+		return errorsmod.Wrapf(ErrInvalidFeeDenom, "denom exponent must not exceed %d: %d", MaxExponent, fd.DenomExponent)
+	}
+
+	if fd.BaseExponent > MaxExponent {
+		return errorsmod.Wrapf(ErrInvalidFeeDenom, "base exponent must not exceed %d: %d", MaxExponent, fd.BaseExponent)
+	}
+
+	return nil
+}
+
+// ParseCurrencyPair returns the parsed currency pair of the fee denom.
+func (fd FeeDenom) ParseCurrencyPair() (connecttypes.CurrencyPair, error) {
+	return connecttypes.CurrencyPairFromString(fd.CurrencyPair)
+}
@@ -0,0 +1,815 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: miniwasm/feeabs/v1/params.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters for the feeabs module.
+type Params struct {
+	// fee_denoms are the alternative fee denoms which can be used to pay the
+	// tx fees in place of the base fee denoms.
+	FeeDenoms []FeeDenom `protobuf:"bytes,1,rep,name=fee_denoms,json=feeDenoms,proto3" json:"fee_denoms" yaml:"fee_denoms"`
+	// max_price_age is the maximum age of an oracle price, measured from the
+	// block time of the price update. Older prices are considered stale and
+	// can't be used for the fee conversion.
+	MaxPriceAge time.Duration `protobuf:"bytes,2,opt,name=max_price_age,json=maxPriceAge,proto3,stdduration" json:"max_price_age" yaml:"max_price_age"`
+	// conversion_spread is the fraction deducted from the converted fee amount
+	// to cover the price movements, so an alternative fee is always worth a bit
+	// more than the base fee it replaces.
+	ConversionSpread cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=conversion_spread,json=conversionSpread,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"conversion_spread" yaml:"conversion_spread"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_375b9b8770070d39, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetFeeDenoms() []FeeDenom {
+	if m != nil {
+		return m.FeeDenoms
+	}
+	return nil
+}
+
+func (m *Params) GetMaxPriceAge() time.Duration {
+	if m != nil {
+		return m.MaxPriceAge
+	}
+	return 0
+}
+
+// FeeDenom defines an alternative fee denom and the oracle price used to
+// convert it to a base fee denom.
+type FeeDenom struct {
+	// denom is the alternative fee denom, e.g. a factory or an ibc denom.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	// base_denom is the base fee denom of the min gas prices, which the
+	// currency pair is quoted in.
+	BaseDenom string `protobuf:"bytes,2,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty" yaml:"base_denom"`
+	// currency_pair is the connect oracle currency pair which prices the
+	// denom in the base denom, e.g. "USDC/INIT".
+	CurrencyPair string `protobuf:"bytes,3,opt,name=currency_pair,json=currencyPair,proto3" json:"currency_pair,omitempty" yaml:"currency_pair"`
+	// denom_exponent is the exponent of the display unit of the denom.
+	DenomExponent uint32 `protobuf:"varint,4,opt,name=denom_exponent,json=denomExponent,proto3" json:"denom_exponent,omitempty" yaml:"denom_exponent"`
+	// base_exponent is the exponent of the display unit of the base denom.
+	BaseExponent uint32 `protobuf:"varint,5,opt,name=base_exponent,json=baseExponent,proto3" json:"base_exponent,omitempty" yaml:"base_exponent"`
+}
+
+func (m *FeeDenom) Reset()         { *m = FeeDenom{} }
+func (m *FeeDenom) String() string { return proto.CompactTextString(m) }
+func (*FeeDenom) ProtoMessage()    {}
+func (*FeeDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_375b9b8770070d39, []int{1}
+}
+func (m *FeeDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeDenom.Merge(m, src)
+}
+func (m *FeeDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeDenom proto.InternalMessageInfo
+
+func (m *FeeDenom) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *FeeDenom) GetBaseDenom() string {
+	if m != nil {
+		return m.BaseDenom
+	}
+	return ""
+}
+
+func (m *FeeDenom) GetCurrencyPair() string {
+	if m != nil {
+		return m.CurrencyPair
+	}
+	return ""
+}
+
+func (m *FeeDenom) GetDenomExponent() uint32 {
+	if m != nil {
+		return m.DenomExponent
+	}
+	return 0
+}
+
+func (m *FeeDenom) GetBaseExponent() uint32 {
+	if m != nil {
+		return m.BaseExponent
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "miniwasm.feeabs.v1.Params")
+	proto.RegisterType((*FeeDenom)(nil), "miniwasm.feeabs.v1.FeeDenom")
+}
+
+func init() { proto.RegisterFile("miniwasm/feeabs/v1/params.proto", fileDescriptor_375b9b8770070d39) }
+
+var fileDescriptor_375b9b8770070d39 = []byte{
+	// 532 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x53, 0x31, 0x6f, 0xd3, 0x40,
+	0x18, 0x8d, 0x53, 0x5a, 0x91, 0x4b, 0x82, 0x1a, 0xab, 0x95, 0x9c, 0x82, 0xec, 0xc8, 0x12, 0x28,
+	0x42, 0xea, 0x59, 0x2d, 0x4c, 0x48, 0x48, 0x60, 0x05, 0xc4, 0xc0, 0x10, 0x99, 0x0d, 0x06, 0xeb,
+	0xe2, 0x7c, 0x71, 0x4f, 0xe4, 0x7c, 0x96, 0xcf, 0x09, 0xc9, 0xc4, 0x5f, 0x60, 0xe4, 0x27, 0x74,
+	0x64, 0xe0, 0x47, 0x74, 0x60, 0xa8, 0x98, 0x10, 0x83, 0x41, 0xc9, 0xc0, 0x9e, 0x5f, 0x80, 0x7c,
+	0x67, 0xc7, 0x8d, 0xba, 0x58, 0x7e, 0xdf, 0x7b, 0xf7, 0xee, 0xf9, 0xc9, 0x1f, 0xb2, 0x18, 0x8d,
+	0xe8, 0x27, 0x22, 0x98, 0x33, 0x01, 0x20, 0x23, 0xe1, 0xcc, 0xcf, 0x9c, 0x98, 0x24, 0x84, 0x09,
+	0x1c, 0x27, 0x3c, 0xe5, 0xba, 0x5e, 0x0a, 0xb0, 0x12, 0xe0, 0xf9, 0xd9, 0x49, 0x87, 0x30, 0x1a,
+	0x71, 0x47, 0x3e, 0x95, 0xec, 0xa4, 0x1b, 0x70, 0xc1, 0xb8, 0xf0, 0x25, 0x72, 0x14, 0x28, 0xa8,
+	0xa3, 0x90, 0x87, 0x5c, 0xcd, 0xf3, 0xb7, 0x62, 0x6a, 0x86, 0x9c, 0x87, 0x53, 0x70, 0x24, 0x1a,
+	0xcd, 0x26, 0xce, 0x78, 0x96, 0x90, 0x94, 0xf2, 0x48, 0xf1, 0xf6, 0x8f, 0x3a, 0x3a, 0x18, 0xca,
+	0x20, 0xfa, 0x07, 0x84, 0x26, 0x00, 0xfe, 0x18, 0x22, 0xce, 0x84, 0xa1, 0xf5, 0xf6, 0xfa, 0xcd,
+	0xf3, 0x07, 0xf8, 0x76, 0x2e, 0xfc, 0x1a, 0x60, 0x90, 0x8b, 0x5c, 0xf3, 0x2a, 0xb3, 0x6a, 0x9b,
+	0xcc, 0xea, 0x2c, 0x09, 0x9b, 0x3e, 0xb3, 0xab, 0xd3, 0xf6, 0xe5, 0xbf, 0x6f, 0x8f, 0x35, 0xaf,
+	0x31, 0x29, 0x94, 0x42, 0x1f, 0xa3, 0x36, 0x23, 0x0b, 0x3f, 0x4e, 0x68, 0x00, 0x3e, 0x09, 0xc1,
+	0xa8, 0xf7, 0xb4, 0x7e, 0xf3, 0xbc, 0x8b, 0x55, 0x3e, 0x5c, 0xe6, 0xc3, 0x83, 0x22, 0x9f, 0xfb,
+	0xb0, 0x30, 0x3f, 0x52, 0xe6, 0x3b, 0xa7, 0xed, 0xaf, 0x7f, 0x2c, 0x4d, 0xdd, 0xd1, 0x64, 0x64,
+	0x31, 0xcc, 0xe7, 0x2f, 0x43, 0xd0, 0x3f, 0xa3, 0x4e, 0xc0, 0xa3, 0x39, 0x24, 0x82, 0xf2, 0xc8,
+	0x17, 0x71, 0x02, 0x64, 0x6c, 0xec, 0xf5, 0xb4, 0x7e, 0xc3, 0xf5, 0x72, 0xbb, 0xdf, 0x99, 0x75,
+	0x5f, 0x95, 0x26, 0xc6, 0x1f, 0x31, 0xe5, 0x0e, 0x23, 0xe9, 0x05, 0x7e, 0x0b, 0x21, 0x09, 0x96,
+	0x03, 0x08, 0x36, 0x99, 0x65, 0xa8, 0xdb, 0x6e, 0xb9, 0xd8, 0x3f, 0xbf, 0x9f, 0xa2, 0xa2, 0xef,
+	0x01, 0x04, 0xea, 0xee, 0xc3, 0x4a, 0xf6, 0x4e, 0xa9, 0x2e, 0xeb, 0xe8, 0x6e, 0x59, 0x8f, 0xfe,
+	0x08, 0xed, 0xcb, 0x3a, 0x0c, 0x4d, 0x26, 0x38, 0xdc, 0x64, 0x56, 0x4b, 0xd9, 0xcb, 0xb1, 0xed,
+	0x29, 0x5a, 0x7f, 0x8a, 0xd0, 0x88, 0x88, 0xa2, 0x3b, 0x59, 0x4c, 0xc3, 0x3d, 0xae, 0x6a, 0xad,
+	0x38, 0xdb, 0x6b, 0xe4, 0x40, 0xb9, 0x3f, 0x47, 0xed, 0x60, 0x96, 0x24, 0x10, 0x05, 0x4b, 0x3f,
+	0x26, 0x34, 0x29, 0xbe, 0xd3, 0xa8, 0x2a, 0xdb, 0xa1, 0x6d, 0xaf, 0x55, 0xe2, 0x21, 0xa1, 0x89,
+	0xfe, 0x02, 0xdd, 0x93, 0x9e, 0x3e, 0x2c, 0x62, 0x1e, 0x41, 0x94, 0x1a, 0x77, 0x7a, 0x5a, 0xbf,
+	0xed, 0x76, 0x37, 0x99, 0x75, 0x7c, 0x23, 0xe5, 0x96, 0xb7, 0xbd, 0xb6, 0x1c, 0xbc, 0x2a, 0x70,
+	0x1e, 0x40, 0x46, 0xdb, 0x1a, 0xec, 0x4b, 0x83, 0x1b, 0x01, 0x76, 0x68, 0xdb, 0x6b, 0xe5, 0xb8,
+	0x3c, 0xee, 0xbe, 0xb9, 0x5a, 0x99, 0xda, 0xf5, 0xca, 0xd4, 0xfe, 0xae, 0x4c, 0xed, 0xcb, 0xda,
+	0xac, 0x5d, 0xaf, 0xcd, 0xda, 0xaf, 0xb5, 0x59, 0x7b, 0x8f, 0x43, 0x9a, 0x5e, 0xcc, 0x46, 0x38,
+	0xe0, 0xcc, 0xa1, 0x11, 0x4d, 0x29, 0x39, 0x9d, 0xe6, 0x3b, 0xb3, 0xdd, 0xa1, 0x45, 0xb9, 0x45,
+	0xe9, 0x32, 0x06, 0x31, 0x3a, 0x90, 0x3f, 0xcf, 0x93, 0xff, 0x03, 0x00, 0x63, 0x43, 0x32, 0xc9,
+	0x65, 0x03, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ConversionSpread.Size()
+		i -= size
+		if _, err := m.ConversionSpread.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxPriceAge, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxPriceAge):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x12
+	if len(m.FeeDenoms) > 0 {
+		for iNdEx := len(m.FeeDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BaseExponent != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.BaseExponent))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.DenomExponent != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DenomExponent))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.CurrencyPair) > 0 {
+		i -= len(m.CurrencyPair)
+		copy(dAtA[i:], m.CurrencyPair)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.CurrencyPair)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FeeDenoms) > 0 {
+		for _, e := range m.FeeDenoms {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxPriceAge)
+	n += 1 + l + sovParams(uint64(l))
+	l = m.ConversionSpread.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *FeeDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.CurrencyPair)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.DenomExponent != 0 {
+		n += 1 + sovParams(uint64(m.DenomExponent))
+	}
+	if m.BaseExponent != 0 {
+		n += 1 + sovParams(uint64(m.BaseExponent))
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeDenoms = append(m.FeeDenoms, FeeDenom{})
+			if err := m.FeeDenoms[len(m.FeeDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceAge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MaxPriceAge, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConversionSpread", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ConversionSpread.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrencyPair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomExponent", wireType)
+			}
+			m.DenomExponent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DenomExponent |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseExponent", wireType)
+			}
+			m.BaseExponent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseExponent |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
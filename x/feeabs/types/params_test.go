@@ -0,0 +1,70 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	"github.com/initia-labs/miniwasm/x/feeabs/types"
+)
+
+func Test_ParamsValidate(t *testing.T) {
+	feeDenom := types.FeeDenom{
+		Denom:         "uusdc",
+		BaseDenom:     "umin",
+		CurrencyPair:  "USDC/MIN",
+		DenomExponent: 6,
+		BaseExponent:  6,
+	}
+
+	params := types.DefaultParams()
+	require.NoError(t, params.Validate())
+
+	params.FeeDenoms = []types.FeeDenom{feeDenom}
+	require.NoError(t, params.Validate())
+
+	// duplicate fee denom
+	params.FeeDenoms = []types.FeeDenom{feeDenom, feeDenom}
+	require.ErrorIs(t, params.Validate(), types.ErrDuplicateFeeDenom)
+
+	// same denom and base denom
+	invalid := feeDenom
+	invalid.BaseDenom = invalid.Denom
+	params.FeeDenoms = []types.FeeDenom{invalid}
+	require.ErrorIs(t, params.Validate(), types.ErrInvalidFeeDenom)
+
+	// invalid currency pair
+	invalid = feeDenom
+	invalid.CurrencyPair = "USDC"
+	params.FeeDenoms = []types.FeeDenom{invalid}
+	require.ErrorIs(t, params.Validate(), types.ErrInvalidFeeDenom)
+
+	// too large exponents
+	invalid = feeDenom
+	invalid.DenomExponent = types.MaxExponent + 1
+	params.FeeDenoms = []types.FeeDenom{invalid}
+	require.ErrorIs(t, params.Validate(), types.ErrInvalidFeeDenom)
+
+	invalid = feeDenom
+	invalid.BaseExponent = types.MaxExponent + 1
+	params.FeeDenoms = []types.FeeDenom{invalid}
+	require.ErrorIs(t, params.Validate(), types.ErrInvalidFeeDenom)
+
+	invalid = feeDenom
+	invalid.DenomExponent = types.MaxExponent
+	invalid.BaseExponent = types.MaxExponent
+	params.FeeDenoms = []types.FeeDenom{invalid}
+	require.NoError(t, params.Validate())
+
+	// invalid spread
+	params = types.DefaultParams()
+	params.ConversionSpread = math.LegacyNewDec(-1)
+	require.ErrorIs(t, params.Validate(), types.ErrInvalidSpread)
+
+	// invalid max price age
+	params = types.DefaultParams()
+	params.MaxPriceAge = 0
+	require.ErrorIs(t, params.Validate(), types.ErrInvalidMaxPriceAge)
+}
@@ -0,0 +1,26 @@
+package types
+
+import (
+	"encoding/json"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+)
+
+// SponsorFeeGasLimit is the gas limit of the sponsor_fee sudo call, so a
+// sponsoring contract can't consume more than this from the tx.
+const SponsorFeeGasLimit = uint64(200_000)
+
+type SponsorFeeSudoMsg struct {
+	SponsorFee SponsorFeeMsg `json:"sponsor_fee"`
+}
+
+// SponsorFeeMsg is the sudo msg the sponsoring contract receives for each tx
+// it sponsors. The contract rejects the sponsorship by returning an error.
+type SponsorFeeMsg struct {
+	// TxMsgs are the JSON encoded messages of the tx.
+	TxMsgs []json.RawMessage `json:"tx_msgs"`
+	// Signer is the fee payer of the tx, which is the first signer.
+	Signer string `json:"signer"`
+	// Fee is the fee to be deducted from the contract balance.
+	Fee wasmvmtypes.Array[wasmvmtypes.Coin] `json:"fee"`
+}
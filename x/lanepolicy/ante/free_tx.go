@@ -0,0 +1,91 @@
+package ante
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// FreeTxKeeper defines the expected lanepolicy keeper used to rate limit the
+// free contract execution txs.
+type FreeTxKeeper interface {
+	IsFreeContractTx(ctx context.Context, tx sdk.Tx) (bool, error)
+	RemainingFreeTxs(ctx context.Context, addr []byte) (uint64, uint64, error)
+	ConsumeFreeTx(ctx context.Context, addr []byte) error
+}
+
+// FreeLane defines the expected free lane used to find the txs which are
+// processed in the free lane.
+type FreeLane interface {
+	Match(ctx sdk.Context, tx sdk.Tx) bool
+}
+
+// FreeTxDecorator counts the free contract execution txs of the fee payer in
+// the current rate limit window.
+//
+// The free lane match is stateless, so the txs of the fee payers over the rate
+// limit still match the free lane. They are not counted here, and the fee
+// checker of the free lane falls back to the normal fee check for them, see
+// IsFreeTxLimited.
+//
+// The free contract txs are counted only if the free lane is enabled and
+// matches the tx, as the others never skip the fee check.
+//
+// CONTRACT: it must run after the fee deduction, which checks the fees with
+// IsFreeTxLimited, and after the signature verification.
+type FreeTxDecorator struct {
+	keeper   FreeTxKeeper
+	freeLane FreeLane
+}
+
+// NewFreeTxDecorator create FreeTxDecorator instance; the free lane is nil if
+// disabled.
+func NewFreeTxDecorator(keeper FreeTxKeeper, freeLane FreeLane) FreeTxDecorator {
+	return FreeTxDecorator{
+		keeper:   keeper,
+		freeLane: freeLane,
+	}
+}
+
+func (d FreeTxDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if d.freeLane == nil || !d.freeLane.Match(ctx, tx) {
+		return next(ctx, tx, simulate)
+	}
+
+	if isFree, remaining, err := freeTxAllowance(ctx, d.keeper, tx); err != nil {
+		return ctx, err
+	} else if isFree && remaining > 0 {
+		if err := d.keeper.ConsumeFreeTx(ctx, tx.(sdk.FeeTx).FeePayer()); err != nil {
+			return ctx, err
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// IsFreeTxLimited returns true if the tx is a free contract tx, but its fee
+// payer has no free txs left in the current window, so the tx must pay the
+// fees.
+func IsFreeTxLimited(ctx context.Context, keeper FreeTxKeeper, tx sdk.Tx) (bool, error) {
+	isFree, remaining, err := freeTxAllowance(ctx, keeper, tx)
+	if err != nil {
+		return false, err
+	}
+
+	return isFree && remaining == 0, nil
+}
+
+// freeTxAllowance returns whether the tx is a free contract tx, and the number
+// of free txs its fee payer can send in the current window.
+func freeTxAllowance(ctx context.Context, keeper FreeTxKeeper, tx sdk.Tx) (bool, uint64, error) {
+	if isFree, err := keeper.IsFreeContractTx(ctx, tx); err != nil || !isFree {
+		return false, 0, err
+	}
+
+	remaining, _, err := keeper.RemainingFreeTxs(ctx, tx.(sdk.FeeTx).FeePayer())
+	if err != nil {
+		return false, 0, err
+	}
+
+	return true, remaining, nil
+}
@@ -0,0 +1,101 @@
+package ante_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/miniwasm/x/lanepolicy/ante"
+)
+
+type mockTx struct {
+	sdk.FeeTx
+
+	payer sdk.AccAddress
+}
+
+func (tx mockTx) FeePayer() []byte { return tx.payer }
+
+type mockFreeTxKeeper struct {
+	free  bool
+	limit uint64
+	usage map[string]uint64
+}
+
+func (k mockFreeTxKeeper) IsFreeContractTx(context.Context, sdk.Tx) (bool, error) {
+	return k.free, nil
+}
+
+func (k mockFreeTxKeeper) RemainingFreeTxs(_ context.Context, addr []byte) (uint64, uint64, error) {
+	if k.usage[string(addr)] >= k.limit {
+		return 0, 0, nil
+	}
+
+	return k.limit - k.usage[string(addr)], 0, nil
+}
+
+func (k mockFreeTxKeeper) ConsumeFreeTx(_ context.Context, addr []byte) error {
+	k.usage[string(addr)]++
+	return nil
+}
+
+type mockFreeLane struct {
+	match bool
+}
+
+func (l mockFreeLane) Match(sdk.Context, sdk.Tx) bool {
+	return l.match
+}
+
+func Test_FreeTxDecorator(t *testing.T) {
+	ctx := sdk.Context{}
+	keeper := mockFreeTxKeeper{free: true, limit: 2, usage: map[string]uint64{}}
+	decorator := ante.NewFreeTxDecorator(keeper, mockFreeLane{match: true})
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+	tx := mockTx{payer: sdk.AccAddress("payer")}
+
+	for i := 0; i < 2; i++ {
+		limited, err := ante.IsFreeTxLimited(ctx, keeper, tx)
+		require.NoError(t, err)
+		require.False(t, limited)
+
+		_, err = decorator.AnteHandle(ctx, tx, false, next)
+		require.NoError(t, err)
+	}
+	require.Equal(t, uint64(2), keeper.usage["payer"])
+
+	// the fee payer is over the limit, so the tx pays the fees and is not counted
+	limited, err := ante.IsFreeTxLimited(ctx, keeper, tx)
+	require.NoError(t, err)
+	require.True(t, limited)
+
+	_, err = decorator.AnteHandle(ctx, tx, false, next)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), keeper.usage["payer"])
+
+	// the other txs are neither limited nor counted
+	keeper.free = false
+	decorator = ante.NewFreeTxDecorator(keeper, mockFreeLane{match: true})
+	limited, err = ante.IsFreeTxLimited(ctx, keeper, mockTx{payer: sdk.AccAddress("other")})
+	require.NoError(t, err)
+	require.False(t, limited)
+
+	_, err = decorator.AnteHandle(ctx, mockTx{payer: sdk.AccAddress("other")}, false, next)
+	require.NoError(t, err)
+	require.Zero(t, keeper.usage["other"])
+
+	// the free contract txs are not counted if the free lane is disabled or
+	// doesn't match the tx, as they pay the fees
+	keeper.free = true
+	for _, decorator := range []ante.FreeTxDecorator{
+		ante.NewFreeTxDecorator(keeper, nil),
+		ante.NewFreeTxDecorator(keeper, mockFreeLane{match: false}),
+	} {
+		_, err = decorator.AnteHandle(ctx, mockTx{payer: sdk.AccAddress("other")}, false, next)
+		require.NoError(t, err)
+		require.Zero(t, keeper.usage["other"])
+	}
+}
@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+
+	"github.com/initia-labs/miniwasm/x/lanepolicy/types"
+)
+
+// IsFreeContractTx returns true if all the messages of the tx execute the free
+// contracts. It only reads the params, so the free lane match is stateless and
+// agrees between the mempool, PrepareProposal and ProcessProposal; the rate
+// limit of the fee payer is enforced by the ante handler.
+func (k Keeper) IsFreeContractTx(ctx context.Context, tx sdk.Tx) (bool, error) {
+	if len(tx.GetMsgs()) == 0 {
+		return false, nil
+	} else if _, ok := tx.(sdk.FeeTx); !ok {
+		return false, nil
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return false, err
+	} else if len(params.FreeContracts) == 0 {
+		return false, nil
+	}
+
+	for _, msg := range tx.GetMsgs() {
+		executeMsg, ok := msg.(*wasmtypes.MsgExecuteContract)
+		if !ok || !params.IsFreeExecution(executeMsg.Contract, executeMsg.Msg) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// RemainingFreeTxs returns the number of free txs the account can send in the
+// current window, and the last height of the window.
+func (k Keeper) RemainingFreeTxs(ctx context.Context, addr []byte) (uint64, uint64, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return k.remainingFreeTxs(ctx, params, addr)
+}
+
+func (k Keeper) remainingFreeTxs(ctx context.Context, params types.Params, addr []byte) (uint64, uint64, error) {
+	usage, windowStart, err := k.getFreeTxUsage(ctx, params, addr)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	windowEnd := windowStart + params.FreeTxWindow - 1
+	if usage.Count >= params.MaxFreeTxsPerWindow {
+		return 0, windowEnd, nil
+	}
+
+	return params.MaxFreeTxsPerWindow - usage.Count, windowEnd, nil
+}
+
+// ConsumeFreeTx counts a free tx of the account in the current window.
+func (k Keeper) ConsumeFreeTx(ctx context.Context, addr []byte) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	usage, windowStart, err := k.getFreeTxUsage(ctx, params, addr)
+	if err != nil {
+		return err
+	}
+
+	return k.FreeTxUsages.Set(ctx, addr, types.FreeTxUsage{
+		WindowStart: windowStart,
+		Count:       usage.Count + 1,
+	})
+}
+
+// getFreeTxUsage returns the usage of the account in the current window and
+// the start height of the window. The usage of a past window is reset.
+func (k Keeper) getFreeTxUsage(ctx context.Context, params types.Params, addr []byte) (types.FreeTxUsage, uint64, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	windowStart := params.WindowStart(uint64(sdkCtx.BlockHeight()))
+
+	usage, err := k.FreeTxUsages.Get(ctx, addr)
+	if err != nil && errors.Is(err, collections.ErrNotFound) {
+		return types.FreeTxUsage{WindowStart: windowStart}, windowStart, nil
+	} else if err != nil {
+		return types.FreeTxUsage{}, 0, err
+	}
+
+	if usage.WindowStart != windowStart {
+		usage = types.FreeTxUsage{WindowStart: windowStart}
+	}
+
+	return usage, windowStart, nil
+}
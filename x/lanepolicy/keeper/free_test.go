@@ -0,0 +1,113 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+
+	"github.com/initia-labs/miniwasm/x/lanepolicy/types"
+)
+
+type mockTx struct {
+	sdk.FeeTx
+
+	msgs  []sdk.Msg
+	payer sdk.AccAddress
+}
+
+func (tx mockTx) GetMsgs() []sdk.Msg { return tx.msgs }
+func (tx mockTx) FeePayer() []byte   { return tx.payer }
+
+func executeMsg(contract, msg string) *wasmtypes.MsgExecuteContract {
+	return &wasmtypes.MsgExecuteContract{Contract: contract, Msg: []byte(msg)}
+}
+
+func Test_IsFreeContractTx(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	faucet, err := input.AddressCodec.BytesToString(sdk.AccAddress([]byte("faucet______________")))
+	require.NoError(t, err)
+	game, err := input.AddressCodec.BytesToString(sdk.AccAddress([]byte("game________________")))
+	require.NoError(t, err)
+
+	params := types.DefaultParams()
+	params.FreeContracts = []types.FreeContract{
+		{Contract: faucet},
+		{Contract: game, MsgPrefix: "first_"},
+	}
+	params.FreeTxWindow = 10
+	params.MaxFreeTxsPerWindow = 2
+	require.NoError(t, input.LanePolicyKeeper.SetParams(ctx, params))
+
+	isFree := func(msgs ...sdk.Msg) bool {
+		free, err := input.LanePolicyKeeper.IsFreeContractTx(ctx, mockTx{msgs: msgs, payer: addrs[0]})
+		require.NoError(t, err)
+		return free
+	}
+
+	// any msg of a contract without prefix
+	require.True(t, isFree(executeMsg(faucet, `{"claim":{}}`)))
+
+	// msg name prefix
+	require.True(t, isFree(executeMsg(game, `{"first_move":{"x":1}}`)))
+	require.False(t, isFree(executeMsg(game, `{"move":{"x":1}}`)))
+	require.False(t, isFree(executeMsg(game, `{"first_move":{},"move":{}}`)))
+
+	// all msgs must be free
+	require.True(t, isFree(executeMsg(faucet, `{"claim":{}}`), executeMsg(game, `{"first_move":{}}`)))
+	require.False(t, isFree(executeMsg(faucet, `{"claim":{}}`), &banktypes.MsgSend{}))
+	require.False(t, isFree())
+
+	// not whitelisted contract
+	require.False(t, isFree(executeMsg(addrs[1].String(), `{"claim":{}}`)))
+}
+
+func Test_FreeTxRateLimit(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	faucet, err := input.AddressCodec.BytesToString(sdk.AccAddress([]byte("faucet______________")))
+	require.NoError(t, err)
+
+	params := types.DefaultParams()
+	params.FreeContracts = []types.FreeContract{{Contract: faucet}}
+	params.FreeTxWindow = 10
+	params.MaxFreeTxsPerWindow = 2
+	require.NoError(t, input.LanePolicyKeeper.SetParams(ctx, params))
+
+	ctx = ctx.WithBlockHeight(25)
+	tx := mockTx{msgs: []sdk.Msg{executeMsg(faucet, `{"claim":{}}`)}, payer: addrs[0]}
+
+	for i := 0; i < 2; i++ {
+		remaining, _, err := input.LanePolicyKeeper.RemainingFreeTxs(ctx, addrs[0])
+		require.NoError(t, err)
+		require.Equal(t, uint64(2-i), remaining)
+		require.NoError(t, input.LanePolicyKeeper.ConsumeFreeTx(ctx, addrs[0]))
+	}
+
+	// the limit is reached, but the tx still matches, as the match is stateless
+	isFree, err := input.LanePolicyKeeper.IsFreeContractTx(ctx, tx)
+	require.NoError(t, err)
+	require.True(t, isFree)
+
+	remaining, windowEnd, err := input.LanePolicyKeeper.RemainingFreeTxs(ctx, addrs[0])
+	require.NoError(t, err)
+	require.Zero(t, remaining)
+	require.Equal(t, uint64(29), windowEnd)
+
+	// the other accounts are not limited
+	remaining, _, err = input.LanePolicyKeeper.RemainingFreeTxs(ctx, addrs[1])
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), remaining)
+
+	// the usage is reset in the next window
+	ctx = ctx.WithBlockHeight(30)
+	remaining, windowEnd, err = input.LanePolicyKeeper.RemainingFreeTxs(ctx, addrs[0])
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), remaining)
+	require.Equal(t, uint64(39), windowEnd)
+}
@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/initia-labs/miniwasm/x/lanepolicy/types"
+)
+
+type Querier struct {
+	*Keeper
+}
+
+var _ types.QueryServer = Querier{}
+
+func (q Querier) Params(ctx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	params, err := q.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+func (q Querier) FreeTxUsage(ctx context.Context, req *types.QueryFreeTxUsageRequest) (*types.QueryFreeTxUsageResponse, error) {
+	addr, err := q.ac.StringToBytes(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	remaining, windowEnd, err := q.RemainingFreeTxs(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryFreeTxUsageResponse{Remaining: remaining, WindowEnd: windowEnd}, nil
+}
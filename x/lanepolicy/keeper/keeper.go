@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+	corestoretypes "cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/miniwasm/x/lanepolicy/types"
+)
+
+type Keeper struct {
+	ac           address.Codec
+	cdc          codec.Codec
+	storeService corestoretypes.KVStoreService
+
+	Schema       collections.Schema
+	Params       collections.Item[types.Params]
+	FreeTxUsages collections.Map[[]byte, types.FreeTxUsage]
+
+	authority string
+}
+
+// NewKeeper returns a new instance of the x/lanepolicy keeper
+func NewKeeper(
+	ac address.Codec,
+	cdc codec.Codec,
+	storeService corestoretypes.KVStoreService,
+	authority string,
+) *Keeper {
+	// ensure that authority is a valid AccAddress
+	if _, err := ac.StringToBytes(authority); err != nil {
+		panic("authority is not a valid acc address")
+	}
+
+	sb := collections.NewSchemaBuilder(storeService)
+	k := &Keeper{
+		ac:           ac,
+		cdc:          cdc,
+		storeService: storeService,
+
+		Params:       collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		FreeTxUsages: collections.NewMap(sb, types.FreeTxUsagePrefix, "free_tx_usages", collections.BytesKey, codec.CollValue[types.FreeTxUsage](cdc)),
+
+		authority: authority,
+	}
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
+}
+
+// GetAuthority returns the x/lanepolicy module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a logger for the x/lanepolicy module
+func (k Keeper) Logger(ctx context.Context) log.Logger {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return sdkCtx.Logger().With("module", "x/"+types.ModuleName)
+}
@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/initia-labs/miniwasm/x/lanepolicy/types"
+)
+
+type msgServer struct {
+	*Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper *Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+func (server msgServer) UpdateParams(ctx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if server.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", server.GetAuthority(), req.Authority)
+	}
+
+	if err := req.Params.Validate(server.ac); err != nil {
+		return nil, err
+	}
+
+	if err := server.SetParams(ctx, req.Params); err != nil {
+		return nil, err
+	}
+
+	freeContracts := make([]string, len(req.Params.FreeContracts))
+	for i, freeContract := range req.Params.FreeContracts {
+		freeContracts[i] = freeContract.Contract
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeUpdateParams,
+		sdk.NewAttribute(types.AttributeKeyRelayers, strings.Join(req.Params.Relayers, ",")),
+		sdk.NewAttribute(types.AttributeKeyFreeContracts, strings.Join(freeContracts, ",")),
+		sdk.NewAttribute(types.AttributeKeyFreeTxWindow, strconv.FormatUint(req.Params.FreeTxWindow, 10)),
+		sdk.NewAttribute(types.AttributeKeyMaxFreeTxsPerWindow, strconv.FormatUint(req.Params.MaxFreeTxsPerWindow, 10)),
+	))
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
@@ -0,0 +1,56 @@
+package keeper_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/initia-labs/miniwasm/x/lanepolicy/keeper"
+	"github.com/initia-labs/miniwasm/x/lanepolicy/types"
+)
+
+func Test_UpdateParams(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	ms := keeper.NewMsgServerImpl(input.LanePolicyKeeper)
+
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	relayer, err := input.AddressCodec.BytesToString(addrs[0])
+	require.NoError(t, err)
+	params := types.NewParams([]string{relayer}, nil, types.DefaultFreeTxWindow, types.DefaultMaxFreeTxsPerWindow)
+
+	// invalid authority
+	_, err = ms.UpdateParams(ctx, types.NewMsgUpdateParams(authtypes.NewModuleAddress("invalid").String(), params))
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+
+	// duplicate relayer
+	_, err = ms.UpdateParams(ctx, types.NewMsgUpdateParams(authority, types.NewParams([]string{relayer, relayer}, nil, types.DefaultFreeTxWindow, types.DefaultMaxFreeTxsPerWindow)))
+	require.ErrorIs(t, err, types.ErrDuplicateAddress)
+
+	_, err = ms.UpdateParams(ctx, types.NewMsgUpdateParams(authority, params))
+	require.NoError(t, err)
+
+	isRelayer, err := input.LanePolicyKeeper.IsRelayer(ctx, addrs[0])
+	require.NoError(t, err)
+	require.True(t, isRelayer)
+
+	isRelayer, err = input.LanePolicyKeeper.IsRelayer(ctx, addrs[1])
+	require.NoError(t, err)
+	require.False(t, isRelayer)
+
+	// the upper case relayer is the same address
+	params.Relayers = []string{strings.ToUpper(relayer)}
+	_, err = ms.UpdateParams(ctx, types.NewMsgUpdateParams(authority, params))
+	require.NoError(t, err)
+
+	isRelayer, err = input.LanePolicyKeeper.IsRelayer(ctx, addrs[0])
+	require.NoError(t, err)
+	require.True(t, isRelayer)
+
+	// duplicate relayer in the other case
+	_, err = ms.UpdateParams(ctx, types.NewMsgUpdateParams(authority, types.NewParams([]string{relayer, strings.ToUpper(relayer)}, nil, types.DefaultFreeTxWindow, types.DefaultMaxFreeTxsPerWindow)))
+	require.ErrorIs(t, err, types.ErrDuplicateAddress)
+}
@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+)
+
+// IsRelayer returns true if the address is a registered relayer. The relayers
+// are compared by the address bytes, as a bech32 address can be written in
+// the upper case too.
+func (k Keeper) IsRelayer(ctx context.Context, addr []byte) (bool, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	for _, relayer := range params.Relayers {
+		relayerAddr, err := k.ac.StringToBytes(relayer)
+		if err != nil {
+			return false, err
+		}
+
+		if bytes.Equal(relayerAddr, addr) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
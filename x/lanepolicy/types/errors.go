@@ -0,0 +1,14 @@
+package types
+
+// DONTCOVER
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// x/lanepolicy module sentinel errors
+var (
+	ErrDuplicateAddress  = errorsmod.Register(ModuleName, 2, "duplicate address")
+	ErrInvalidFreeTxRate = errorsmod.Register(ModuleName, 3, "invalid free tx rate limit")
+	ErrInvalidMsgPrefix  = errorsmod.Register(ModuleName, 4, "invalid msg prefix")
+)
@@ -0,0 +1,10 @@
+package types
+
+const (
+	EventTypeUpdateParams = "update_params"
+
+	AttributeKeyRelayers            = "relayers"
+	AttributeKeyFreeContracts       = "free_contracts"
+	AttributeKeyFreeTxWindow        = "free_tx_window"
+	AttributeKeyMaxFreeTxsPerWindow = "max_free_txs_per_window"
+)
@@ -0,0 +1,18 @@
+package types
+
+import (
+	"cosmossdk.io/core/address"
+)
+
+// DefaultGenesis returns the default lanepolicy genesis state
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate(ac address.Codec) error {
+	return gs.Params.Validate(ac)
+}
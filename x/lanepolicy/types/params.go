@@ -0,0 +1,134 @@
+package types
+
+import (
+	"encoding/json"
+	"strings"
+
+	"cosmossdk.io/core/address"
+	errorsmod "cosmossdk.io/errors"
+)
+
+const (
+	// DefaultFreeTxWindow is the default size of the free tx window in blocks.
+	DefaultFreeTxWindow = uint64(100)
+
+	// DefaultMaxFreeTxsPerWindow is the default max number of free txs an
+	// account can send in a window.
+	DefaultMaxFreeTxsPerWindow = uint64(5)
+
+	// MaxMsgPrefixLength is the max length of the msg prefix of a free contract.
+	MaxMsgPrefixLength = 128
+)
+
+func NewParams(relayers []string, freeContracts []FreeContract, freeTxWindow, maxFreeTxsPerWindow uint64) Params {
+	return Params{
+		Relayers:            relayers,
+		FreeContracts:       freeContracts,
+		FreeTxWindow:        freeTxWindow,
+		MaxFreeTxsPerWindow: maxFreeTxsPerWindow,
+	}
+}
+
+// DefaultParams returns default lanepolicy module parameters.
+func DefaultParams() Params {
+	return NewParams([]string{}, []FreeContract{}, DefaultFreeTxWindow, DefaultMaxFreeTxsPerWindow)
+}
+
+// Validate performs basic validation on lanepolicy parameters.
+func (p Params) Validate(ac address.Codec) error {
+	seen := make(map[string]bool, len(p.Relayers))
+	for _, relayer := range p.Relayers {
+		bz, err := ac.StringToBytes(relayer)
+		if err != nil {
+			return err
+		}
+
+		key := string(bz)
+		if seen[key] {
+			return errorsmod.Wrap(ErrDuplicateAddress, relayer)
+		}
+		seen[key] = true
+	}
+
+	for _, freeContract := range p.FreeContracts {
+		if _, err := ac.StringToBytes(freeContract.Contract); err != nil {
+			return err
+		}
+
+		if err := validateMsgPrefix(freeContract.MsgPrefix); err != nil {
+			return err
+		}
+	}
+
+	if p.FreeTxWindow == 0 {
+		return errorsmod.Wrap(ErrInvalidFreeTxRate, "free tx window must be positive")
+	}
+
+	if p.MaxFreeTxsPerWindow == 0 {
+		return errorsmod.Wrap(ErrInvalidFreeTxRate, "max free txs per window must be positive")
+	}
+
+	return nil
+}
+
+// validateMsgPrefix checks the msg prefix can be a prefix of an execute message
+// name, which is a snake case json key by the cosmwasm convention.
+func validateMsgPrefix(prefix string) error {
+	if len(prefix) > MaxMsgPrefixLength {
+		return errorsmod.Wrapf(ErrInvalidMsgPrefix, "msg prefix is longer than %d", MaxMsgPrefixLength)
+	}
+
+	for _, c := range prefix {
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '_') {
+			return errorsmod.Wrapf(ErrInvalidMsgPrefix, "invalid character %q in %s", c, prefix)
+		}
+	}
+
+	return nil
+}
+
+// IsFreeExecution returns true if the execute message of the contract matches
+// one of the free contracts.
+func (p Params) IsFreeExecution(contract string, msg []byte) bool {
+	var name string
+	for _, freeContract := range p.FreeContracts {
+		if freeContract.Contract != contract {
+			continue
+		}
+
+		if freeContract.MsgPrefix == "" {
+			return true
+		}
+
+		if name == "" {
+			name = executeMsgName(msg)
+		}
+
+		if name != "" && strings.HasPrefix(name, freeContract.MsgPrefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// executeMsgName returns the top level key of the execute message, or an empty
+// string if the message is not a single key object.
+func executeMsgName(msg []byte) string {
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal(msg, &obj); err != nil || len(obj) != 1 {
+		return ""
+	}
+
+	for name := range obj {
+		return name
+	}
+
+	return ""
+}
+
+// WindowStart returns the start height of the free tx window the height
+// belongs to.
+func (p Params) WindowStart(height uint64) uint64 {
+	return height - height%p.FreeTxWindow
+}
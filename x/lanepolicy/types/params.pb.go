@@ -0,0 +1,706 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: miniwasm/lanepolicy/v1/params.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters for the lanepolicy module.
+type Params struct {
+	// relayers are the registered ibc relayers, whose ibc relay txs go to the
+	// relayer lane.
+	Relayers []string `protobuf:"bytes,1,rep,name=relayers,proto3" json:"relayers,omitempty" yaml:"relayers"`
+	// free_contracts are the contracts whose executions go to the free lane.
+	FreeContracts []FreeContract `protobuf:"bytes,2,rep,name=free_contracts,json=freeContracts,proto3" json:"free_contracts" yaml:"free_contracts"`
+	// free_tx_window is the size of the free tx rate limit window in blocks.
+	FreeTxWindow uint64 `protobuf:"varint,3,opt,name=free_tx_window,json=freeTxWindow,proto3" json:"free_tx_window,omitempty" yaml:"free_tx_window"`
+	// max_free_txs_per_window is the max number of free contract execution txs
+	// an account can send in a window. Once the limit is reached, the txs of the
+	// account pay the fees in the default lane until the next window.
+	MaxFreeTxsPerWindow uint64 `protobuf:"varint,4,opt,name=max_free_txs_per_window,json=maxFreeTxsPerWindow,proto3" json:"max_free_txs_per_window,omitempty" yaml:"max_free_txs_per_window"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_568587599b46ea89, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetRelayers() []string {
+	if m != nil {
+		return m.Relayers
+	}
+	return nil
+}
+
+func (m *Params) GetFreeContracts() []FreeContract {
+	if m != nil {
+		return m.FreeContracts
+	}
+	return nil
+}
+
+func (m *Params) GetFreeTxWindow() uint64 {
+	if m != nil {
+		return m.FreeTxWindow
+	}
+	return 0
+}
+
+func (m *Params) GetMaxFreeTxsPerWindow() uint64 {
+	if m != nil {
+		return m.MaxFreeTxsPerWindow
+	}
+	return 0
+}
+
+// FreeContract defines a contract whose executions go to the free lane.
+type FreeContract struct {
+	// contract is the address of the contract.
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// msg_prefix is the optional prefix of the execute message name, which is
+	// the top level key of the execute message, e.g. "claim" for
+	// {"claim": {}}. Empty prefix matches all the messages.
+	MsgPrefix string `protobuf:"bytes,2,opt,name=msg_prefix,json=msgPrefix,proto3" json:"msg_prefix,omitempty"`
+}
+
+func (m *FreeContract) Reset()         { *m = FreeContract{} }
+func (m *FreeContract) String() string { return proto.CompactTextString(m) }
+func (*FreeContract) ProtoMessage()    {}
+func (*FreeContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_568587599b46ea89, []int{1}
+}
+func (m *FreeContract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FreeContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FreeContract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FreeContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FreeContract.Merge(m, src)
+}
+func (m *FreeContract) XXX_Size() int {
+	return m.Size()
+}
+func (m *FreeContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_FreeContract.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FreeContract proto.InternalMessageInfo
+
+func (m *FreeContract) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *FreeContract) GetMsgPrefix() string {
+	if m != nil {
+		return m.MsgPrefix
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "miniwasm.lanepolicy.v1.Params")
+	proto.RegisterType((*FreeContract)(nil), "miniwasm.lanepolicy.v1.FreeContract")
+}
+
+func init() {
+	proto.RegisterFile("miniwasm/lanepolicy/v1/params.proto", fileDescriptor_568587599b46ea89)
+}
+
+var fileDescriptor_568587599b46ea89 = []byte{
+	// 427 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xb1, 0x8e, 0xd3, 0x30,
+	0x1c, 0xc6, 0xe3, 0xf6, 0x74, 0xba, 0x9a, 0x03, 0x44, 0x38, 0x20, 0x77, 0x12, 0x49, 0x64, 0x18,
+	0x22, 0xd0, 0x25, 0xba, 0x83, 0x89, 0x05, 0x11, 0xa4, 0x63, 0x61, 0xa8, 0x02, 0x12, 0x88, 0x25,
+	0x72, 0x53, 0x37, 0x58, 0x8a, 0xe3, 0xc8, 0x36, 0xd7, 0xe4, 0x2d, 0x78, 0x0c, 0x46, 0x06, 0x1e,
+	0xa2, 0x63, 0xc5, 0xc4, 0x42, 0x84, 0xda, 0x81, 0xbd, 0x4f, 0x80, 0xea, 0x24, 0xa5, 0xa0, 0xeb,
+	0x12, 0xc5, 0x9f, 0x7f, 0xdf, 0xf7, 0xe9, 0x6f, 0xfd, 0xe1, 0x03, 0x46, 0x73, 0x3a, 0xc5, 0x92,
+	0x05, 0x19, 0xce, 0x49, 0xc1, 0x33, 0x9a, 0x54, 0xc1, 0xe5, 0x59, 0x50, 0x60, 0x81, 0x99, 0xf4,
+	0x0b, 0xc1, 0x15, 0x37, 0xef, 0x76, 0x90, 0xff, 0x17, 0xf2, 0x2f, 0xcf, 0x4e, 0x8e, 0x13, 0x2e,
+	0x19, 0x97, 0xb1, 0xa6, 0x82, 0xe6, 0xd0, 0x58, 0x4e, 0x6e, 0x61, 0x46, 0x73, 0x1e, 0xe8, 0x6f,
+	0x2b, 0x1d, 0xa5, 0x3c, 0xe5, 0x0d, 0xba, 0xfe, 0x6b, 0x54, 0xf4, 0xb3, 0x07, 0xf7, 0x87, 0xba,
+	0xcc, 0x7c, 0x05, 0x0f, 0x04, 0xc9, 0x70, 0x45, 0x84, 0xb4, 0x80, 0xdb, 0xf7, 0x06, 0xe1, 0xe3,
+	0x55, 0xed, 0xdc, 0xac, 0x30, 0xcb, 0x9e, 0xa1, 0xee, 0x06, 0x7d, 0xff, 0x76, 0x7a, 0xd4, 0x56,
+	0xbd, 0x18, 0x8f, 0x05, 0x91, 0xf2, 0x8d, 0x12, 0x34, 0x4f, 0xa3, 0x8d, 0xd9, 0xe4, 0xf0, 0xc6,
+	0x44, 0x10, 0x12, 0x27, 0x3c, 0x57, 0x02, 0x27, 0x4a, 0x5a, 0x3d, 0xb7, 0xef, 0x5d, 0x3b, 0x7f,
+	0xe8, 0x5f, 0x3d, 0x88, 0x7f, 0x21, 0x08, 0x79, 0xd9, 0xc2, 0x21, 0x9a, 0xd5, 0x8e, 0xb1, 0xaa,
+	0x9d, 0x3b, 0x4d, 0xf1, 0xbf, 0x49, 0xe8, 0xcb, 0xef, 0xaf, 0x8f, 0x40, 0x74, 0x7d, 0xb2, 0xe5,
+	0x90, 0xe6, 0xf3, 0xb6, 0x50, 0x95, 0xf1, 0x94, 0xe6, 0x63, 0x3e, 0xb5, 0xfa, 0x2e, 0xf0, 0xf6,
+	0xc2, 0xe3, 0xff, 0x62, 0x36, 0xf7, 0x28, 0x3a, 0x5c, 0x0b, 0x6f, 0xcb, 0x77, 0xfa, 0x68, 0xbe,
+	0x87, 0xf7, 0x18, 0x2e, 0xe3, 0x16, 0x92, 0x71, 0x41, 0x44, 0x97, 0xb4, 0xa7, 0x93, 0xd0, 0xaa,
+	0x76, 0xec, 0x26, 0x69, 0x07, 0x88, 0xa2, 0xdb, 0x0c, 0x97, 0x17, 0x3a, 0x55, 0x0e, 0x89, 0x68,
+	0x92, 0x51, 0x02, 0x0f, 0xb7, 0xa7, 0x33, 0x9f, 0xc2, 0x83, 0x6e, 0x18, 0x0b, 0xb8, 0xc0, 0x1b,
+	0x84, 0xd6, 0xee, 0x17, 0xed, 0x48, 0xf3, 0x3e, 0x84, 0x4c, 0xa6, 0x71, 0x21, 0xc8, 0x84, 0x96,
+	0x56, 0x6f, 0xed, 0x8b, 0x06, 0x4c, 0xa6, 0x43, 0x2d, 0x84, 0xaf, 0x67, 0x0b, 0x1b, 0xcc, 0x17,
+	0x36, 0xf8, 0xb5, 0xb0, 0xc1, 0xe7, 0xa5, 0x6d, 0xcc, 0x97, 0xb6, 0xf1, 0x63, 0x69, 0x1b, 0x1f,
+	0xce, 0x53, 0xaa, 0x3e, 0x7e, 0x1a, 0xf9, 0x09, 0x67, 0x01, 0xcd, 0xa9, 0xa2, 0xf8, 0x34, 0xc3,
+	0x23, 0x19, 0x6c, 0xd6, 0xae, 0xdc, 0x5e, 0x3c, 0x55, 0x15, 0x44, 0x8e, 0xf6, 0xf5, 0x66, 0x3c,
+	0xf9, 0x33, 0x00, 0x0f, 0x4d, 0xa5, 0xee, 0x9c, 0x02, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxFreeTxsPerWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxFreeTxsPerWindow))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.FreeTxWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.FreeTxWindow))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.FreeContracts) > 0 {
+		for iNdEx := len(m.FreeContracts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FreeContracts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Relayers) > 0 {
+		for iNdEx := len(m.Relayers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Relayers[iNdEx])
+			copy(dAtA[i:], m.Relayers[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.Relayers[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FreeContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FreeContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FreeContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgPrefix) > 0 {
+		i -= len(m.MsgPrefix)
+		copy(dAtA[i:], m.MsgPrefix)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.MsgPrefix)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Relayers) > 0 {
+		for _, s := range m.Relayers {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.FreeContracts) > 0 {
+		for _, e := range m.FreeContracts {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.FreeTxWindow != 0 {
+		n += 1 + sovParams(uint64(m.FreeTxWindow))
+	}
+	if m.MaxFreeTxsPerWindow != 0 {
+		n += 1 + sovParams(uint64(m.MaxFreeTxsPerWindow))
+	}
+	return n
+}
+
+func (m *FreeContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.MsgPrefix)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relayers = append(m.Relayers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FreeContracts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FreeContracts = append(m.FreeContracts, FreeContract{})
+			if err := m.FreeContracts[len(m.FreeContracts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FreeTxWindow", wireType)
+			}
+			m.FreeTxWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FreeTxWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFreeTxsPerWindow", wireType)
+			}
+			m.MaxFreeTxsPerWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxFreeTxsPerWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FreeContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FreeContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FreeContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,59 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+
+	"github.com/initia-labs/miniwasm/x/lanepolicy/types"
+)
+
+func Test_IsFreeExecution(t *testing.T) {
+	params := types.DefaultParams()
+	params.FreeContracts = []types.FreeContract{
+		{Contract: "faucet"},
+		{Contract: "game", MsgPrefix: "first_"},
+	}
+
+	require.True(t, params.IsFreeExecution("faucet", []byte(`{"claim":{}}`)))
+	require.True(t, params.IsFreeExecution("faucet", []byte(`invalid`)))
+	require.True(t, params.IsFreeExecution("game", []byte(`{"first_move":{}}`)))
+	require.False(t, params.IsFreeExecution("game", []byte(`{"move":{}}`)))
+	require.False(t, params.IsFreeExecution("game", []byte(`{"first_move":{},"move":{}}`)))
+	require.False(t, params.IsFreeExecution("game", []byte(`invalid`)))
+	require.False(t, params.IsFreeExecution("other", []byte(`{"claim":{}}`)))
+}
+
+func Test_WindowStart(t *testing.T) {
+	params := types.DefaultParams()
+	params.FreeTxWindow = 10
+
+	require.Equal(t, uint64(0), params.WindowStart(9))
+	require.Equal(t, uint64(10), params.WindowStart(10))
+	require.Equal(t, uint64(20), params.WindowStart(25))
+}
+
+func Test_ValidateParams(t *testing.T) {
+	ac := address.NewBech32Codec("init")
+	contract := "init1c584m4lq25h83yp6ag8hh4htjr92d9542mvp0d"
+
+	params := types.DefaultParams()
+	params.FreeContracts = []types.FreeContract{{Contract: contract, MsgPrefix: "first_Move2"}}
+	require.NoError(t, params.Validate(ac))
+
+	params.MaxFreeTxsPerWindow = 0
+	require.ErrorIs(t, params.Validate(ac), types.ErrInvalidFreeTxRate)
+
+	params = types.DefaultParams()
+	params.FreeTxWindow = 0
+	require.ErrorIs(t, params.Validate(ac), types.ErrInvalidFreeTxRate)
+
+	for _, prefix := range []string{"claim{", "first move", `"claim"`, strings.Repeat("a", types.MaxMsgPrefixLength+1)} {
+		params = types.DefaultParams()
+		params.FreeContracts = []types.FreeContract{{Contract: contract, MsgPrefix: prefix}}
+		require.ErrorIs(t, params.Validate(ac), types.ErrInvalidMsgPrefix, prefix)
+	}
+}
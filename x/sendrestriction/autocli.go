@@ -0,0 +1,65 @@
+package sendrestriction
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	sendrestrictionv1 "github.com/initia-labs/miniwasm/api/miniwasm/sendrestriction/v1"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: sendrestrictionv1.Query_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Denylist",
+					Use:       "denylist",
+					Short:     "Returns the addresses which cannot send or receive funds",
+				},
+				{
+					RpcMethod: "Allowlist",
+					Use:       "allowlist",
+					Short:     "Returns the addresses which can send or receive funds in the allowlist only mode",
+				},
+				{
+					RpcMethod: "AddressStatus",
+					Use:       "address-status [address]",
+					Short:     "Get the send restriction status of an address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "address"},
+					},
+				},
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Returns the sendrestriction module's parameters",
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: sendrestrictionv1.Msg_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "UpdateParams",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "AddToDenylist",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "RemoveFromDenylist",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "AddToAllowlist",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "RemoveFromAllowlist",
+					Skip:      true, // skipped because authority gated
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,88 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/log"
+	"cosmossdk.io/store"
+	"cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+	dbm "github.com/cosmos/cosmos-db"
+
+	codecaddress "github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/initia-labs/miniwasm/x/sendrestriction/keeper"
+	"github.com/initia-labs/miniwasm/x/sendrestriction/types"
+)
+
+var (
+	addrs = []sdk.AccAddress{
+		sdk.AccAddress([]byte("addr1_______________")),
+		sdk.AccAddress([]byte("addr2_______________")),
+		sdk.AccAddress([]byte("addr3_______________")),
+	}
+
+	moduleAddr = authtypes.NewModuleAddress("module")
+)
+
+// mockAccountKeeper returns a module account for the module address and a base
+// account for the others.
+type mockAccountKeeper struct{}
+
+func (mockAccountKeeper) GetAccount(_ context.Context, addr sdk.AccAddress) sdk.AccountI {
+	if addr.Equals(moduleAddr) {
+		return authtypes.NewEmptyModuleAccount("module")
+	}
+
+	return authtypes.NewBaseAccountWithAddress(addr)
+}
+
+type TestKeepers struct {
+	AddressCodec          address.Codec
+	SendRestrictionKeeper *keeper.Keeper
+}
+
+func createDefaultTestInput(t testing.TB) (sdk.Context, TestKeepers) {
+	encodingConfig := moduletestutil.MakeTestEncodingConfig()
+	types.RegisterInterfaces(encodingConfig.InterfaceRegistry)
+
+	db := dbm.NewMemDB()
+	keys := storetypes.NewKVStoreKeys(types.StoreKey)
+	ms := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+	for _, v := range keys {
+		ms.MountStoreWithDB(v, storetypes.StoreTypeIAVL, db)
+	}
+	require.NoError(t, ms.LoadLatestVersion())
+
+	ctx := sdk.NewContext(ms, tmproto.Header{
+		Height: 1234567,
+		Time:   time.Date(2020, time.April, 22, 12, 0, 0, 0, time.UTC),
+	}, false, log.NewNopLogger())
+
+	ac := codecaddress.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	sendRestrictionKeeper := keeper.NewKeeper(
+		ac,
+		encodingConfig.Codec,
+		runtime.NewKVStoreService(keys[types.StoreKey]),
+		mockAccountKeeper{},
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+	sendRestrictionKeeper.InitGenesis(ctx, *types.DefaultGenesis())
+
+	return ctx, TestKeepers{
+		AddressCodec:          ac,
+		SendRestrictionKeeper: sendRestrictionKeeper,
+	}
+}
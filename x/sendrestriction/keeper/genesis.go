@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/initia-labs/miniwasm/x/sendrestriction/types"
+)
+
+// InitGenesis initializes the sendrestriction module's state from a provided genesis
+// state.
+func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) {
+	if err := k.SetParams(ctx, genState.Params); err != nil {
+		panic(err)
+	}
+
+	for _, addr := range genState.Denylist {
+		addrBz, err := k.ac.StringToBytes(addr)
+		if err != nil {
+			panic(err)
+		}
+
+		if err := k.Denylist.Set(ctx, addrBz); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, addr := range genState.Allowlist {
+		addrBz, err := k.ac.StringToBytes(addr)
+		if err != nil {
+			panic(err)
+		}
+
+		if err := k.Allowlist.Set(ctx, addrBz); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// ExportGenesis returns the sendrestriction module's exported genesis.
+func (k Keeper) ExportGenesis(ctx context.Context) *types.GenesisState {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	denylist := []string{}
+	err = k.Denylist.Walk(ctx, nil, func(addrBz []byte) (stop bool, err error) {
+		addr, err := k.ac.BytesToString(addrBz)
+		if err != nil {
+			return true, err
+		}
+
+		denylist = append(denylist, addr)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	allowlist := []string{}
+	err = k.Allowlist.Walk(ctx, nil, func(addrBz []byte) (stop bool, err error) {
+		addr, err := k.ac.BytesToString(addrBz)
+		if err != nil {
+			return true, err
+		}
+
+		allowlist = append(allowlist, addr)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return &types.GenesisState{
+		Params:    params,
+		Denylist:  denylist,
+		Allowlist: allowlist,
+	}
+}
@@ -0,0 +1,27 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/initia-labs/miniwasm/x/sendrestriction/types"
+)
+
+func TestGenesis(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	genesisState := types.GenesisState{
+		Params:    types.NewParams(true),
+		Denylist:  []string{addrs[0].String()},
+		Allowlist: []string{addrs[1].String(), addrs[2].String()},
+	}
+	require.NoError(t, genesisState.Validate(input.AddressCodec))
+
+	input.SendRestrictionKeeper.InitGenesis(ctx, genesisState)
+
+	exportedGenesis := input.SendRestrictionKeeper.ExportGenesis(ctx)
+	require.Equal(t, genesisState.Params, exportedGenesis.Params)
+	require.Equal(t, genesisState.Denylist, exportedGenesis.Denylist)
+	require.ElementsMatch(t, genesisState.Allowlist, exportedGenesis.Allowlist)
+}
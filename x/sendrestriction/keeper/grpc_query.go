@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/initia-labs/miniwasm/x/sendrestriction/types"
+)
+
+type Querier struct {
+	*Keeper
+}
+
+var _ types.QueryServer = Querier{}
+
+func (q Querier) Params(ctx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	params, err := q.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+func (q Querier) Denylist(ctx context.Context, req *types.QueryDenylistRequest) (*types.QueryDenylistResponse, error) {
+	addrs, pageRes, err := query.CollectionPaginate(ctx, q.Keeper.Denylist, req.Pagination,
+		func(addr []byte, _ collections.NoValue) (string, error) {
+			return q.ac.BytesToString(addr)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDenylistResponse{Addresses: addrs, Pagination: pageRes}, nil
+}
+
+func (q Querier) Allowlist(ctx context.Context, req *types.QueryAllowlistRequest) (*types.QueryAllowlistResponse, error) {
+	addrs, pageRes, err := query.CollectionPaginate(ctx, q.Keeper.Allowlist, req.Pagination,
+		func(addr []byte, _ collections.NoValue) (string, error) {
+			return q.ac.BytesToString(addr)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAllowlistResponse{Addresses: addrs, Pagination: pageRes}, nil
+}
+
+func (q Querier) AddressStatus(ctx context.Context, req *types.QueryAddressStatusRequest) (*types.QueryAddressStatusResponse, error) {
+	addr, err := q.ac.StringToBytes(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	denylisted, err := q.Keeper.Denylist.Has(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	allowlisted, err := q.Keeper.Allowlist.Has(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	restricted, err := q.IsRestricted(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	exempt, err := q.IsExempt(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAddressStatusResponse{
+		Denylisted:  denylisted,
+		Allowlisted: allowlisted,
+		Exempt:      exempt,
+		Restricted:  restricted,
+	}, nil
+}
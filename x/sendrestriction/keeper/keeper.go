@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+	corestoretypes "cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/miniwasm/x/sendrestriction/types"
+)
+
+type Keeper struct {
+	ac           address.Codec
+	cdc          codec.Codec
+	storeService corestoretypes.KVStoreService
+
+	accountKeeper types.AccountKeeper
+	exemptions    []ExemptionFn
+
+	Schema    collections.Schema
+	Params    collections.Item[types.Params]
+	Denylist  collections.KeySet[[]byte]
+	Allowlist collections.KeySet[[]byte]
+
+	authority string
+}
+
+// NewKeeper returns a new instance of the x/sendrestriction keeper
+func NewKeeper(
+	ac address.Codec,
+	cdc codec.Codec,
+	storeService corestoretypes.KVStoreService,
+	accountKeeper types.AccountKeeper,
+	authority string,
+) *Keeper {
+	// ensure that authority is a valid AccAddress
+	if _, err := ac.StringToBytes(authority); err != nil {
+		panic("authority is not a valid acc address")
+	}
+
+	sb := collections.NewSchemaBuilder(storeService)
+	k := &Keeper{
+		ac:           ac,
+		cdc:          cdc,
+		storeService: storeService,
+
+		accountKeeper: accountKeeper,
+
+		Params:    collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		Denylist:  collections.NewKeySet(sb, types.DenylistPrefix, "denylist", collections.BytesKey),
+		Allowlist: collections.NewKeySet(sb, types.AllowlistPrefix, "allowlist", collections.BytesKey),
+
+		authority: authority,
+	}
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
+}
+
+// GetAuthority returns the x/sendrestriction module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a logger for the x/sendrestriction module
+func (k Keeper) Logger(ctx context.Context) log.Logger {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return sdkCtx.Logger().With("module", "x/"+types.ModuleName)
+}
@@ -0,0 +1,127 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/initia-labs/miniwasm/x/sendrestriction/types"
+)
+
+type msgServer struct {
+	*Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper *Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+func (server msgServer) UpdateParams(ctx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if server.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", server.GetAuthority(), req.Authority)
+	}
+
+	if err := req.Params.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := server.SetParams(ctx, req.Params); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeUpdateParams,
+		sdk.NewAttribute(types.AttributeKeyAllowlistOnly, strconv.FormatBool(req.Params.AllowlistOnly)),
+	))
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+func (server msgServer) AddToDenylist(ctx context.Context, req *types.MsgAddToDenylist) (*types.MsgAddToDenylistResponse, error) {
+	if err := req.Validate(server.ac); err != nil {
+		return nil, err
+	}
+
+	if err := server.updateList(ctx, req.Authority, req.Addresses, server.Keeper.Denylist.Set, types.EventTypeAddToDenylist); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAddToDenylistResponse{}, nil
+}
+
+func (server msgServer) RemoveFromDenylist(ctx context.Context, req *types.MsgRemoveFromDenylist) (*types.MsgRemoveFromDenylistResponse, error) {
+	if err := req.Validate(server.ac); err != nil {
+		return nil, err
+	}
+
+	if err := server.updateList(ctx, req.Authority, req.Addresses, server.Keeper.Denylist.Remove, types.EventTypeRemoveFromDenylist); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveFromDenylistResponse{}, nil
+}
+
+func (server msgServer) AddToAllowlist(ctx context.Context, req *types.MsgAddToAllowlist) (*types.MsgAddToAllowlistResponse, error) {
+	if err := req.Validate(server.ac); err != nil {
+		return nil, err
+	}
+
+	if err := server.updateList(ctx, req.Authority, req.Addresses, server.Keeper.Allowlist.Set, types.EventTypeAddToAllowlist); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAddToAllowlistResponse{}, nil
+}
+
+func (server msgServer) RemoveFromAllowlist(ctx context.Context, req *types.MsgRemoveFromAllowlist) (*types.MsgRemoveFromAllowlistResponse, error) {
+	if err := req.Validate(server.ac); err != nil {
+		return nil, err
+	}
+
+	if err := server.updateList(ctx, req.Authority, req.Addresses, server.Keeper.Allowlist.Remove, types.EventTypeRemoveFromAllowlist); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveFromAllowlistResponse{}, nil
+}
+
+// updateList checks the authority and applies the update to each address, emitting an
+// event per address.
+func (server msgServer) updateList(
+	ctx context.Context,
+	authority string,
+	addresses []string,
+	update func(ctx context.Context, addr []byte) error,
+	eventType string,
+) error {
+	if server.GetAuthority() != authority {
+		return errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", server.GetAuthority(), authority)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	for _, addr := range addresses {
+		addrBz, err := server.ac.StringToBytes(addr)
+		if err != nil {
+			return err
+		}
+
+		if err := update(ctx, addrBz); err != nil {
+			return err
+		}
+
+		sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(types.AttributeKeyAddress, addr),
+		))
+	}
+
+	return nil
+}
@@ -0,0 +1,116 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/initia-labs/miniwasm/x/sendrestriction/keeper"
+	"github.com/initia-labs/miniwasm/x/sendrestriction/types"
+)
+
+func TestMsgUpdateParams(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	msgServer := keeper.NewMsgServerImpl(input.SendRestrictionKeeper)
+
+	params := types.NewParams(true)
+
+	// invalid authority
+	_, err := msgServer.UpdateParams(ctx, &types.MsgUpdateParams{
+		Authority: addrs[0].String(),
+		Params:    params,
+	})
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+
+	_, err = msgServer.UpdateParams(ctx, &types.MsgUpdateParams{
+		Authority: input.SendRestrictionKeeper.GetAuthority(),
+		Params:    params,
+	})
+	require.NoError(t, err)
+
+	res, err := input.SendRestrictionKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	require.Equal(t, params, res)
+}
+
+func TestMsgDenylist(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	msgServer := keeper.NewMsgServerImpl(input.SendRestrictionKeeper)
+	authority := input.SendRestrictionKeeper.GetAuthority()
+	addresses := []string{addrs[0].String(), addrs[1].String()}
+
+	// invalid authority
+	_, err := msgServer.AddToDenylist(ctx, types.NewMsgAddToDenylist(addrs[0].String(), addresses))
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+
+	// empty addresses
+	_, err = msgServer.AddToDenylist(ctx, types.NewMsgAddToDenylist(authority, nil))
+	require.ErrorIs(t, err, types.ErrEmptyAddresses)
+
+	// duplicate addresses
+	_, err = msgServer.AddToDenylist(ctx, types.NewMsgAddToDenylist(authority, []string{addresses[0], addresses[0]}))
+	require.ErrorIs(t, err, types.ErrDuplicateAddress)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err = msgServer.AddToDenylist(ctx, types.NewMsgAddToDenylist(authority, addresses))
+	require.NoError(t, err)
+
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 2)
+	require.Equal(t, types.EventTypeAddToDenylist, events[0].Type)
+	require.Equal(t, addresses[1], events[1].Attributes[0].Value)
+
+	res, err := keeper.Querier{Keeper: input.SendRestrictionKeeper}.Denylist(ctx, &types.QueryDenylistRequest{})
+	require.NoError(t, err)
+	require.ElementsMatch(t, addresses, res.Addresses)
+
+	_, err = msgServer.RemoveFromDenylist(ctx, types.NewMsgRemoveFromDenylist(authority, addresses[:1]))
+	require.NoError(t, err)
+
+	denylisted, err := input.SendRestrictionKeeper.Denylist.Has(ctx, addrs[0])
+	require.NoError(t, err)
+	require.False(t, denylisted)
+
+	denylisted, err = input.SendRestrictionKeeper.Denylist.Has(ctx, addrs[1])
+	require.NoError(t, err)
+	require.True(t, denylisted)
+}
+
+func TestMsgAllowlist(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	msgServer := keeper.NewMsgServerImpl(input.SendRestrictionKeeper)
+	querier := keeper.Querier{Keeper: input.SendRestrictionKeeper}
+	authority := input.SendRestrictionKeeper.GetAuthority()
+
+	// invalid authority
+	_, err := msgServer.AddToAllowlist(ctx, types.NewMsgAddToAllowlist(addrs[0].String(), []string{addrs[0].String()}))
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+
+	_, err = msgServer.AddToAllowlist(ctx, types.NewMsgAddToAllowlist(authority, []string{addrs[0].String()}))
+	require.NoError(t, err)
+
+	_, err = msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: authority, Params: types.NewParams(true)})
+	require.NoError(t, err)
+
+	status, err := querier.AddressStatus(ctx, &types.QueryAddressStatusRequest{Address: addrs[0].String()})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryAddressStatusResponse{Allowlisted: true}, status)
+
+	status, err = querier.AddressStatus(ctx, &types.QueryAddressStatusRequest{Address: addrs[1].String()})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryAddressStatusResponse{Restricted: true}, status)
+
+	status, err = querier.AddressStatus(ctx, &types.QueryAddressStatusRequest{Address: moduleAddr.String()})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryAddressStatusResponse{Exempt: true}, status)
+
+	_, err = msgServer.RemoveFromAllowlist(ctx, types.NewMsgRemoveFromAllowlist(authority, []string{addrs[0].String()}))
+	require.NoError(t, err)
+
+	status, err = querier.AddressStatus(ctx, &types.QueryAddressStatusRequest{Address: addrs[0].String()})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryAddressStatusResponse{Restricted: true}, status)
+}
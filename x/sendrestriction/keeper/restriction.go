@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/miniwasm/x/sendrestriction/types"
+)
+
+// ExemptionFn returns true if the address is a system address, e.g. an ibc transfer
+// escrow address, which is exempt from the restrictions like the module accounts.
+type ExemptionFn func(ctx context.Context, addr sdk.AccAddress) (bool, error)
+
+// AppendExemption adds the exemption to the keeper. The exemptions must be added
+// before the send restriction is registered to the bank keeper, which holds a copy
+// of the keeper.
+func (k *Keeper) AppendExemption(fn ExemptionFn) {
+	k.exemptions = append(k.exemptions, fn)
+}
+
+// SendRestrictionFn implements banktypes.SendRestrictionFn. It rejects the transfer if
+// the sender or the recipient is denylisted, or, in the allowlist only mode, is not
+// allowlisted. Module accounts and the other exempt addresses are exempt from both lists.
+func (k Keeper) SendRestrictionFn(ctx context.Context, fromAddr, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.checkAddress(ctx, params, fromAddr); err != nil {
+		return nil, err
+	}
+
+	if err := k.checkAddress(ctx, params, toAddr); err != nil {
+		return nil, err
+	}
+
+	return toAddr, nil
+}
+
+// checkAddress returns an error if the address is not allowed to send or receive funds.
+func (k Keeper) checkAddress(ctx context.Context, params types.Params, addr sdk.AccAddress) error {
+	denylisted, err := k.Denylist.Has(ctx, addr)
+	if err != nil {
+		return err
+	}
+
+	allowlisted := false
+	if !denylisted && params.AllowlistOnly {
+		allowlisted, err = k.Allowlist.Has(ctx, addr)
+		if err != nil {
+			return err
+		}
+	}
+
+	// the common case; skip the account lookup
+	if !denylisted && (!params.AllowlistOnly || allowlisted) {
+		return nil
+	}
+
+	if exempt, err := k.IsExempt(ctx, addr); err != nil {
+		return err
+	} else if exempt {
+		return nil
+	}
+
+	addrStr, err := k.ac.BytesToString(addr)
+	if err != nil {
+		return err
+	}
+
+	if denylisted {
+		return types.ErrDenylisted.Wrap(addrStr)
+	}
+
+	return types.ErrNotAllowlisted.Wrap(addrStr)
+}
+
+// IsExempt returns true if the address is never restricted; a module account, the
+// exempt address of the context or an address exempted by the exemptions.
+func (k Keeper) IsExempt(ctx context.Context, addr sdk.AccAddress) (bool, error) {
+	if _, ok := k.accountKeeper.GetAccount(ctx, addr).(sdk.ModuleAccountI); ok {
+		return true, nil
+	}
+
+	if exemptAddr, ok := types.ExemptAddressFromContext(ctx); ok && exemptAddr.Equals(addr) {
+		return true, nil
+	}
+
+	for _, fn := range k.exemptions {
+		if exempt, err := fn(ctx, addr); err != nil {
+			return false, err
+		} else if exempt {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// IsRestricted returns true if the address cannot send or receive funds.
+func (k Keeper) IsRestricted(ctx context.Context, addr sdk.AccAddress) (bool, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	err = k.checkAddress(ctx, params, addr)
+	if types.ErrDenylisted.Is(err) || types.ErrNotAllowlisted.Is(err) {
+		return true, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	return false, nil
+}
@@ -0,0 +1,150 @@
+package keeper_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/miniwasm/x/sendrestriction/types"
+)
+
+func Test_SendRestrictionFn_Denylist(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	k := input.SendRestrictionKeeper
+	amount := sdk.NewCoins(sdk.NewInt64Coin("foo", 100))
+
+	toAddr, err := k.SendRestrictionFn(ctx, addrs[0], addrs[1], amount)
+	require.NoError(t, err)
+	require.Equal(t, addrs[1], toAddr)
+
+	require.NoError(t, k.Denylist.Set(ctx, addrs[0]))
+
+	// cannot send
+	_, err = k.SendRestrictionFn(ctx, addrs[0], addrs[1], amount)
+	require.ErrorIs(t, err, types.ErrDenylisted)
+
+	// cannot receive
+	_, err = k.SendRestrictionFn(ctx, addrs[1], addrs[0], amount)
+	require.ErrorIs(t, err, types.ErrDenylisted)
+
+	// module accounts can still send to the others
+	_, err = k.SendRestrictionFn(ctx, moduleAddr, addrs[1], amount)
+	require.NoError(t, err)
+
+	// module accounts are exempt even if denylisted
+	require.NoError(t, k.Denylist.Set(ctx, moduleAddr))
+	_, err = k.SendRestrictionFn(ctx, moduleAddr, addrs[1], amount)
+	require.NoError(t, err)
+
+	// the denylist wins over the allowlist
+	require.NoError(t, k.Allowlist.Set(ctx, addrs[0]))
+	require.NoError(t, k.SetParams(ctx, types.NewParams(true)))
+	_, err = k.SendRestrictionFn(ctx, addrs[0], moduleAddr, amount)
+	require.ErrorIs(t, err, types.ErrDenylisted)
+}
+
+func Test_SendRestrictionFn_AllowlistOnly(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	k := input.SendRestrictionKeeper
+	amount := sdk.NewCoins(sdk.NewInt64Coin("foo", 100))
+
+	require.NoError(t, k.SetParams(ctx, types.NewParams(true)))
+	require.NoError(t, k.Allowlist.Set(ctx, addrs[0]))
+	require.NoError(t, k.Allowlist.Set(ctx, addrs[1]))
+
+	_, err := k.SendRestrictionFn(ctx, addrs[0], addrs[1], amount)
+	require.NoError(t, err)
+
+	// not allowlisted recipient
+	_, err = k.SendRestrictionFn(ctx, addrs[0], addrs[2], amount)
+	require.ErrorIs(t, err, types.ErrNotAllowlisted)
+
+	// not allowlisted sender
+	_, err = k.SendRestrictionFn(ctx, addrs[2], addrs[0], amount)
+	require.ErrorIs(t, err, types.ErrNotAllowlisted)
+
+	// module accounts are exempt
+	_, err = k.SendRestrictionFn(ctx, addrs[0], moduleAddr, amount)
+	require.NoError(t, err)
+	_, err = k.SendRestrictionFn(ctx, moduleAddr, addrs[1], amount)
+	require.NoError(t, err)
+	_, err = k.SendRestrictionFn(ctx, moduleAddr, addrs[2], amount)
+	require.ErrorIs(t, err, types.ErrNotAllowlisted)
+
+	// disabling the mode lifts the allowlist restriction
+	require.NoError(t, k.SetParams(ctx, types.NewParams(false)))
+	_, err = k.SendRestrictionFn(ctx, addrs[0], addrs[2], amount)
+	require.NoError(t, err)
+}
+
+func Test_IsRestricted(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	k := input.SendRestrictionKeeper
+
+	restricted, err := k.IsRestricted(ctx, addrs[0])
+	require.NoError(t, err)
+	require.False(t, restricted)
+
+	require.NoError(t, k.Denylist.Set(ctx, addrs[0]))
+	restricted, err = k.IsRestricted(ctx, addrs[0])
+	require.NoError(t, err)
+	require.True(t, restricted)
+
+	exempt, err := k.IsExempt(ctx, moduleAddr)
+	require.NoError(t, err)
+	require.True(t, exempt)
+
+	exempt, err = k.IsExempt(ctx, addrs[0])
+	require.NoError(t, err)
+	require.False(t, exempt)
+}
+
+func Test_SendRestrictionFn_Exemptions(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	k := input.SendRestrictionKeeper
+	amount := sdk.NewCoins(sdk.NewInt64Coin("foo", 100))
+
+	require.NoError(t, k.SetParams(ctx, types.NewParams(true)))
+	require.NoError(t, k.Allowlist.Set(ctx, addrs[0]))
+
+	_, err := k.SendRestrictionFn(ctx, addrs[0], addrs[1], amount)
+	require.ErrorIs(t, err, types.ErrNotAllowlisted)
+
+	// the exempt address of the context, e.g. the intermediate sender of the ibc hooks
+	exemptCtx := types.WithExemptAddress(ctx, addrs[1])
+	_, err = k.SendRestrictionFn(exemptCtx, addrs[0], addrs[1], amount)
+	require.NoError(t, err)
+	_, err = k.SendRestrictionFn(exemptCtx, addrs[1], addrs[0], amount)
+	require.NoError(t, err)
+	_, err = k.SendRestrictionFn(exemptCtx, addrs[1], addrs[2], amount)
+	require.ErrorIs(t, err, types.ErrNotAllowlisted)
+
+	// the address exempted by an exemption, e.g. an ibc transfer escrow address
+	errExemption := errors.New("exemption error")
+	errAddr := sdk.AccAddress([]byte("error_______________"))
+	k.AppendExemption(func(_ context.Context, addr sdk.AccAddress) (bool, error) {
+		if addr.Equals(errAddr) {
+			return false, errExemption
+		}
+
+		return addr.Equals(addrs[2]), nil
+	})
+	_, err = k.SendRestrictionFn(ctx, addrs[0], addrs[2], amount)
+	require.NoError(t, err)
+	_, err = k.SendRestrictionFn(ctx, addrs[2], addrs[0], amount)
+	require.NoError(t, err)
+	_, err = k.SendRestrictionFn(ctx, addrs[0], addrs[1], amount)
+	require.ErrorIs(t, err, types.ErrNotAllowlisted)
+	_, err = k.SendRestrictionFn(ctx, addrs[0], errAddr, amount)
+	require.ErrorIs(t, err, errExemption)
+
+	// the exempt addresses are exempt from the denylist too
+	require.NoError(t, k.Denylist.Set(ctx, addrs[2]))
+	restricted, err := k.IsRestricted(ctx, addrs[2])
+	require.NoError(t, err)
+	require.False(t, restricted)
+}
@@ -0,0 +1,29 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "sendrestriction/MsgUpdateParams")
+	legacy.RegisterAminoMsg(cdc, &MsgAddToDenylist{}, "sendrestriction/MsgAddToDenylist")
+	legacy.RegisterAminoMsg(cdc, &MsgRemoveFromDenylist{}, "sendrestriction/MsgRemoveFromDenylist")
+	legacy.RegisterAminoMsg(cdc, &MsgAddToAllowlist{}, "sendrestriction/MsgAddToAllowlist")
+	legacy.RegisterAminoMsg(cdc, &MsgRemoveFromAllowlist{}, "sendrestriction/MsgRemoveFromAllowlist")
+}
+
+func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgUpdateParams{},
+		&MsgAddToDenylist{},
+		&MsgRemoveFromDenylist{},
+		&MsgAddToAllowlist{},
+		&MsgRemoveFromAllowlist{},
+	)
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
@@ -0,0 +1,22 @@
+package types
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type exemptAddressKey struct{}
+
+// WithExemptAddress returns the context in which the address is exempt from the
+// restrictions. It is used for the system addresses which only exist for a single
+// execution, e.g. the intermediate sender of the ibc hooks.
+func WithExemptAddress(ctx sdk.Context, addr sdk.AccAddress) sdk.Context {
+	return ctx.WithValue(exemptAddressKey{}, addr)
+}
+
+// ExemptAddressFromContext returns the exempt address of the context.
+func ExemptAddressFromContext(ctx context.Context) (sdk.AccAddress, bool) {
+	addr, ok := ctx.Value(exemptAddressKey{}).(sdk.AccAddress)
+	return addr, ok
+}
@@ -0,0 +1,16 @@
+package types
+
+// DONTCOVER
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// x/sendrestriction module sentinel errors
+var (
+	ErrInvalidGenesis   = errorsmod.Register(ModuleName, 2, "invalid genesis")
+	ErrEmptyAddresses   = errorsmod.Register(ModuleName, 3, "empty addresses")
+	ErrDuplicateAddress = errorsmod.Register(ModuleName, 4, "duplicate address")
+	ErrDenylisted       = errorsmod.Register(ModuleName, 5, "address is denylisted")
+	ErrNotAllowlisted   = errorsmod.Register(ModuleName, 6, "address is not allowlisted")
+)
@@ -0,0 +1,13 @@
+package types
+
+// event types
+const (
+	EventTypeAddToDenylist       = "add_to_denylist"
+	EventTypeRemoveFromDenylist  = "remove_from_denylist"
+	EventTypeAddToAllowlist      = "add_to_allowlist"
+	EventTypeRemoveFromAllowlist = "remove_from_allowlist"
+	EventTypeUpdateParams        = "update_params"
+
+	AttributeKeyAddress       = "address"
+	AttributeKeyAllowlistOnly = "allowlist_only"
+)
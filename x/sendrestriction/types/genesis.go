@@ -0,0 +1,33 @@
+package types
+
+import (
+	"cosmossdk.io/core/address"
+	errorsmod "cosmossdk.io/errors"
+)
+
+// DefaultGenesis returns the default sendrestriction genesis state
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		Params:    DefaultParams(),
+		Denylist:  []string{},
+		Allowlist: []string{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate(ac address.Codec) error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	if err := ValidateAddressList(ac, gs.Denylist); err != nil {
+		return errorsmod.Wrapf(ErrInvalidGenesis, "invalid denylist: %s", err)
+	}
+
+	if err := ValidateAddressList(ac, gs.Allowlist); err != nil {
+		return errorsmod.Wrapf(ErrInvalidGenesis, "invalid allowlist: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,64 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authcodec "github.com/cosmos/cosmos-sdk/x/auth/codec"
+	"github.com/stretchr/testify/require"
+
+	"github.com/initia-labs/miniwasm/x/sendrestriction/types"
+)
+
+func TestGenesisState_Validate(t *testing.T) {
+	ac := authcodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	addr, err := ac.BytesToString(sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()))
+	require.NoError(t, err, "address bytes encoding error")
+
+	for _, tc := range []struct {
+		desc     string
+		genState *types.GenesisState
+		valid    bool
+	}{
+		{
+			desc:     "default is valid",
+			genState: types.DefaultGenesis(),
+			valid:    true,
+		},
+		{
+			desc: "valid genesis state",
+			genState: &types.GenesisState{
+				Params:    types.NewParams(true),
+				Denylist:  []string{addr},
+				Allowlist: []string{addr},
+			},
+			valid: true,
+		},
+		{
+			desc: "invalid denylist address",
+			genState: &types.GenesisState{
+				Params:   types.DefaultParams(),
+				Denylist: []string{"invalid"},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicate allowlist address",
+			genState: &types.GenesisState{
+				Params:    types.DefaultParams(),
+				Allowlist: []string{addr, addr},
+			},
+			valid: false,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.genState.Validate(ac)
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
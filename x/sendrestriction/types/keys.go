@@ -0,0 +1,21 @@
+package types
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "sendrestriction"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the sendrestriction module
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+)
+
+var (
+	ParamsKey       = []byte{0x11}
+	DenylistPrefix  = []byte{0x12}
+	AllowlistPrefix = []byte{0x13}
+)
@@ -0,0 +1,96 @@
+package types
+
+import (
+	"cosmossdk.io/core/address"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgAddToDenylist{}
+	_ sdk.Msg = &MsgRemoveFromDenylist{}
+	_ sdk.Msg = &MsgAddToAllowlist{}
+	_ sdk.Msg = &MsgRemoveFromAllowlist{}
+)
+
+// NewMsgAddToDenylist creates a msg to add addresses to the denylist
+func NewMsgAddToDenylist(authority string, addresses []string) *MsgAddToDenylist {
+	return &MsgAddToDenylist{
+		Authority: authority,
+		Addresses: addresses,
+	}
+}
+
+func (m MsgAddToDenylist) Validate(accAddrCodec address.Codec) error {
+	return validateAddresses(accAddrCodec, m.Authority, m.Addresses)
+}
+
+// NewMsgRemoveFromDenylist creates a msg to remove addresses from the denylist
+func NewMsgRemoveFromDenylist(authority string, addresses []string) *MsgRemoveFromDenylist {
+	return &MsgRemoveFromDenylist{
+		Authority: authority,
+		Addresses: addresses,
+	}
+}
+
+func (m MsgRemoveFromDenylist) Validate(accAddrCodec address.Codec) error {
+	return validateAddresses(accAddrCodec, m.Authority, m.Addresses)
+}
+
+// NewMsgAddToAllowlist creates a msg to add addresses to the allowlist
+func NewMsgAddToAllowlist(authority string, addresses []string) *MsgAddToAllowlist {
+	return &MsgAddToAllowlist{
+		Authority: authority,
+		Addresses: addresses,
+	}
+}
+
+func (m MsgAddToAllowlist) Validate(accAddrCodec address.Codec) error {
+	return validateAddresses(accAddrCodec, m.Authority, m.Addresses)
+}
+
+// NewMsgRemoveFromAllowlist creates a msg to remove addresses from the allowlist
+func NewMsgRemoveFromAllowlist(authority string, addresses []string) *MsgRemoveFromAllowlist {
+	return &MsgRemoveFromAllowlist{
+		Authority: authority,
+		Addresses: addresses,
+	}
+}
+
+func (m MsgRemoveFromAllowlist) Validate(accAddrCodec address.Codec) error {
+	return validateAddresses(accAddrCodec, m.Authority, m.Addresses)
+}
+
+// validateAddresses checks the authority and the addresses of a list msg.
+func validateAddresses(accAddrCodec address.Codec, authority string, addresses []string) error {
+	if _, err := accAddrCodec.StringToBytes(authority); err != nil {
+		return err
+	}
+
+	if len(addresses) == 0 {
+		return ErrEmptyAddresses
+	}
+
+	return ValidateAddressList(accAddrCodec, addresses)
+}
+
+// ValidateAddressList checks the addresses are valid and unique.
+func ValidateAddressList(accAddrCodec address.Codec, addresses []string) error {
+	seen := make(map[string]bool, len(addresses))
+	for _, addr := range addresses {
+		bz, err := accAddrCodec.StringToBytes(addr)
+		if err != nil {
+			return err
+		}
+
+		key := string(bz)
+		if seen[key] {
+			return errorsmod.Wrap(ErrDuplicateAddress, addr)
+		}
+		seen[key] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,17 @@
+package types
+
+func NewParams(allowlistOnly bool) Params {
+	return Params{
+		AllowlistOnly: allowlistOnly,
+	}
+}
+
+// DefaultParams returns default sendrestriction module parameters.
+func DefaultParams() Params {
+	return NewParams(false)
+}
+
+// Validate performs basic validation on sendrestriction parameters.
+func (p Params) Validate() error {
+	return nil
+}
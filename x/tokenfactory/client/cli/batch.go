@@ -0,0 +1,238 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"cosmossdk.io/core/address"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+
+	"github.com/initia-labs/miniwasm/x/tokenfactory/types"
+)
+
+// BatchFile is the file of the batch command, whose operations are signed in
+// order as a single transaction.
+type BatchFile struct {
+	Operations []BatchOperation `json:"operations"`
+}
+
+// BatchOperation is a tokenfactory operation of the batch file. Exactly one
+// of the fields must be set.
+type BatchOperation struct {
+	CreateDenom       *CreateDenomOperation       `json:"create_denom,omitempty"`
+	Mint              *MintOperation              `json:"mint,omitempty"`
+	SetBeforeSendHook *SetBeforeSendHookOperation `json:"set_before_send_hook,omitempty"`
+	ChangeAdmin       *ChangeAdminOperation       `json:"change_admin,omitempty"`
+
+	// SetDenomMetadata is the bank metadata in the format of the bank
+	// denom-metadata query.
+	SetDenomMetadata json.RawMessage `json:"set_denom_metadata,omitempty"`
+}
+
+// CreateDenomOperation creates the factory denom of the subdenom.
+type CreateDenomOperation struct {
+	Subdenom string `json:"subdenom"`
+}
+
+// MintOperation mints the amount to the address, or to the sender if the
+// address is empty.
+type MintOperation struct {
+	Amount        string `json:"amount"`
+	MintToAddress string `json:"mint_to_address,omitempty"`
+}
+
+// SetBeforeSendHookOperation sets the before send hook contract of the denom.
+type SetBeforeSendHookOperation struct {
+	Denom           string `json:"denom"`
+	CosmwasmAddress string `json:"cosmwasm_address"`
+}
+
+// ChangeAdminOperation changes the admin of the denom.
+type ChangeAdminOperation struct {
+	Denom    string `json:"denom"`
+	NewAdmin string `json:"new_admin"`
+}
+
+// NewBatchCmd broadcast the tokenfactory msgs of a batch file as a single tx.
+func NewBatchCmd(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batch --file [batch-file] [flags]",
+		Short: "Sign the tokenfactory operations of a json or yaml file as a single transaction.",
+		Long: `Sign the tokenfactory operations of a json or yaml file as a single transaction.
+The operations are executed in order, and the transaction fails if any of them fails.
+A file with the .yaml or .yml extension is read as yaml, and any other file as json.
+
+Example:
+$ minitiad tx tokenfactory batch --file launch.yaml --from mykey
+
+Where launch.yaml contains:
+operations:
+  - create_denom:
+      subdenom: ufoo
+  - mint:
+      amount: 1000000factory/init1.../ufoo
+      mint_to_address: init1...
+  - set_denom_metadata:
+      base: factory/init1.../ufoo
+      display: foo
+      name: Foo
+      symbol: FOO
+      denom_units:
+        - denom: factory/init1.../ufoo
+          exponent: 0
+        - denom: foo
+          exponent: 6
+  - set_before_send_hook:
+      denom: factory/init1.../ufoo
+      cosmwasm_address: init1...
+  - change_admin:
+      denom: factory/init1.../ufoo
+      new_admin: init1...`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			fromAddr, err := ac.BytesToString(clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			path, err := cmd.Flags().GetString(FlagFile)
+			if err != nil {
+				return err
+			}
+
+			batch, err := ReadBatchFile(path)
+			if err != nil {
+				return err
+			}
+
+			msgs, err := batch.Msgs(clientCtx.Codec, ac, fromAddr)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msgs...)
+		},
+	}
+	cmd.Flags().String(FlagFile, "", "The json or yaml file of the tokenfactory operations")
+	flags.AddTxFlagsToCmd(cmd)
+	_ = cmd.MarkFlagRequired(FlagFile)
+	return cmd
+}
+
+// ReadBatchFile reads the batch file of the path. Unknown fields are rejected,
+// so a misspelled operation is not silently dropped.
+func ReadBatchFile(path string) (BatchFile, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return BatchFile{}, err
+	}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		if bz, err = yaml.YAMLToJSON(bz); err != nil {
+			return BatchFile{}, fmt.Errorf("failed to parse batch file %s: %w", path, err)
+		}
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(bz))
+	decoder.DisallowUnknownFields()
+
+	var batch BatchFile
+	if err := decoder.Decode(&batch); err != nil {
+		return BatchFile{}, fmt.Errorf("failed to parse batch file %s: %w", path, err)
+	}
+
+	return batch, nil
+}
+
+// Msgs returns the validated msgs of the operations, which are sent by the
+// sender.
+func (batch BatchFile) Msgs(cdc codec.JSONCodec, ac address.Codec, sender string) ([]sdk.Msg, error) {
+	if len(batch.Operations) == 0 {
+		return nil, errors.New("empty operations")
+	}
+
+	msgs := make([]sdk.Msg, 0, len(batch.Operations))
+	for i, op := range batch.Operations {
+		msg, err := op.msg(cdc, ac, sender)
+		if err != nil {
+			return nil, fmt.Errorf("invalid operation %d: %w", i, err)
+		}
+
+		msgs = append(msgs, msg)
+	}
+
+	return msgs, nil
+}
+
+// msg returns the validated msg of the operation.
+func (op BatchOperation) msg(cdc codec.JSONCodec, ac address.Codec, sender string) (sdk.Msg, error) {
+	var msg interface {
+		sdk.Msg
+		Validate(address.Codec) error
+	}
+
+	set := 0
+	if op.CreateDenom != nil {
+		set++
+		msg = types.NewMsgCreateDenom(sender, op.CreateDenom.Subdenom)
+	}
+	if op.Mint != nil {
+		set++
+		amount, err := sdk.ParseCoinNormalized(op.Mint.Amount)
+		if err != nil {
+			return nil, err
+		}
+
+		msg = types.NewMsgMintTo(sender, amount, op.Mint.MintToAddress)
+	}
+	if op.SetBeforeSendHook != nil {
+		set++
+		msg = types.NewMsgSetBeforeSendHook(sender, op.SetBeforeSendHook.Denom, op.SetBeforeSendHook.CosmwasmAddress)
+	}
+	if op.ChangeAdmin != nil {
+		set++
+		msg = types.NewMsgChangeAdmin(sender, op.ChangeAdmin.Denom, op.ChangeAdmin.NewAdmin)
+	}
+	if len(op.SetDenomMetadata) != 0 {
+		set++
+		var metadata banktypes.Metadata
+		if err := cdc.UnmarshalJSON(op.SetDenomMetadata, &metadata); err != nil {
+			return nil, fmt.Errorf("failed to parse metadata: %w", err)
+		}
+
+		setDenomMetadata := types.NewMsgSetDenomMetadata(sender, metadata)
+		if err := validateDenomMetadata(ac, setDenomMetadata); err != nil {
+			return nil, err
+		}
+
+		msg = setDenomMetadata
+	}
+
+	if set != 1 {
+		return nil, fmt.Errorf("exactly one operation must be set, got %d", set)
+	}
+
+	if err := msg.Validate(ac); err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
@@ -0,0 +1,104 @@
+package cli_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/miniwasm/x/tokenfactory/client/cli"
+	"github.com/initia-labs/miniwasm/x/tokenfactory/types"
+)
+
+const (
+	sender   = "init1c584m4lq25h83yp6ag8hh4htjr92d9542mvp0d"
+	receiver = "init1wlvk4e083pd3nddlfe5quy56e68atra3gu9xfs"
+	denom    = "factory/" + sender + "/ufoo"
+)
+
+func writeFile(t *testing.T, name, content string) string {
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func Test_BatchFile_YAML(t *testing.T) {
+	path := writeFile(t, "batch.yaml", `
+operations:
+  - create_denom:
+      subdenom: ufoo
+  - mint:
+      amount: 1000`+denom+`
+      mint_to_address: `+receiver+`
+  - set_denom_metadata:
+      base: `+denom+`
+      display: foo
+      name: Foo
+      symbol: FOO
+      denom_units:
+        - denom: `+denom+`
+          exponent: 0
+        - denom: foo
+          exponent: 6
+  - change_admin:
+      denom: `+denom+`
+      new_admin: `+receiver+`
+`)
+
+	batch, err := cli.ReadBatchFile(path)
+	require.NoError(t, err)
+
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	msgs, err := batch.Msgs(cdc, addresscodec.NewBech32Codec("init"), sender)
+	require.NoError(t, err)
+	require.Len(t, msgs, 4)
+
+	require.Equal(t, types.NewMsgCreateDenom(sender, "ufoo"), msgs[0])
+	require.Equal(t, types.NewMsgMintTo(sender, sdk.NewInt64Coin(denom, 1000), receiver), msgs[1])
+	require.Equal(t, "foo", msgs[2].(*types.MsgSetDenomMetadata).Metadata.Display)
+	require.Equal(t, types.NewMsgChangeAdmin(sender, denom, receiver), msgs[3])
+}
+
+func Test_BatchFile_JSON(t *testing.T) {
+	path := writeFile(t, "batch.json", `{"operations":[{"create_denom":{"subdenom":"ufoo"}},{"mint":{"amount":"1000`+denom+`"}}]}`)
+
+	batch, err := cli.ReadBatchFile(path)
+	require.NoError(t, err)
+
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	msgs, err := batch.Msgs(cdc, addresscodec.NewBech32Codec("init"), sender)
+	require.NoError(t, err)
+	require.Equal(t, types.NewMsgMintTo(sender, sdk.NewInt64Coin(denom, 1000), ""), msgs[1])
+}
+
+func Test_BatchFile_Invalid(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	ac := addresscodec.NewBech32Codec("init")
+
+	// unknown operation
+	_, err := cli.ReadBatchFile(writeFile(t, "batch.json", `{"operations":[{"burn":{"amount":"1foo"}}]}`))
+	require.Error(t, err)
+
+	testCases := map[string]string{
+		"empty operations":   `{"operations":[]}`,
+		"no operation":       `{"operations":[{}]}`,
+		"two operations":     `{"operations":[{"create_denom":{"subdenom":"ufoo"},"change_admin":{"denom":"` + denom + `","new_admin":"` + receiver + `"}}]}`,
+		"invalid amount":     `{"operations":[{"mint":{"amount":"foo"}}]}`,
+		"non factory denom":  `{"operations":[{"set_denom_metadata":{"base":"ufoo","display":"ufoo","name":"foo","symbol":"FOO","denom_units":[{"denom":"ufoo","exponent":0}]}}]}`,
+		"invalid new admin":  `{"operations":[{"change_admin":{"denom":"` + denom + `","new_admin":"invalid"}}]}`,
+		"invalid hook denom": `{"operations":[{"set_before_send_hook":{"denom":"ufoo","cosmwasm_address":"` + receiver + `"}}]}`,
+	}
+	for name, content := range testCases {
+		batch, err := cli.ReadBatchFile(writeFile(t, "batch.json", content))
+		require.NoError(t, err, name)
+
+		_, err = batch.Msgs(cdc, ac, sender)
+		require.Error(t, err, name)
+	}
+}
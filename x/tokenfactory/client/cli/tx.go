@@ -1,16 +1,23 @@
 package cli
 
 import (
+	"fmt"
+	"os"
+
 	"cosmossdk.io/core/address"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/spf13/cobra"
 
 	"github.com/initia-labs/miniwasm/x/tokenfactory/types"
 )
 
+// FlagFile is the flag of the json or yaml file read by the tx commands.
+const FlagFile = "file"
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd(ac address.Codec) *cobra.Command {
 	txCmd := &cobra.Command{
@@ -27,6 +34,8 @@ func GetTxCmd(ac address.Codec) *cobra.Command {
 		// NewForceTransferCmd(),
 		NewChangeAdminCmd(ac),
 		NewSetBeforeSendHookCmd(ac),
+		NewSetDenomMetadataCmd(ac),
+		NewBatchCmd(ac),
 	)
 	return txCmd
 }
@@ -215,3 +224,82 @@ func NewSetBeforeSendHookCmd(ac address.Codec) *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// NewSetDenomMetadataCmd broadcast MsgSetDenomMetadata with the bank metadata
+// read from a json file.
+func NewSetDenomMetadataCmd(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-denom-metadata --file [metadata-file] [flags]",
+		Short: "Set the bank metadata of a factory-created denom from a json file. Must have admin authority to do so.",
+		Long: `Set the bank metadata of a factory-created denom from a json file, which is
+in the format of the bank denom-metadata query.
+
+Example:
+$ minitiad tx tokenfactory set-denom-metadata --file metadata.json --from mykey
+
+Where metadata.json contains:
+{
+  "description": "The foo token",
+  "denom_units": [
+    {"denom": "factory/init1.../ufoo", "exponent": 0},
+    {"denom": "foo", "exponent": 6}
+  ],
+  "base": "factory/init1.../ufoo",
+  "display": "foo",
+  "name": "Foo",
+  "symbol": "FOO"
+}`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			fromAddr, err := ac.BytesToString(clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			path, err := cmd.Flags().GetString(FlagFile)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(path)
+			if err != nil {
+				return err
+			}
+
+			var metadata banktypes.Metadata
+			if err := clientCtx.Codec.UnmarshalJSON(bz, &metadata); err != nil {
+				return fmt.Errorf("failed to parse metadata file %s: %w", path, err)
+			}
+
+			msg := types.NewMsgSetDenomMetadata(
+				fromAddr,
+				metadata,
+			)
+
+			if err = validateDenomMetadata(ac, msg); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().String(FlagFile, "", "The json file of the denom metadata")
+	flags.AddTxFlagsToCmd(cmd)
+	_ = cmd.MarkFlagRequired(FlagFile)
+	return cmd
+}
+
+// validateDenomMetadata validates the metadata of the msg, whose base must be
+// a factory denom.
+func validateDenomMetadata(ac address.Codec, msg *types.MsgSetDenomMetadata) error {
+	if _, _, err := types.DeconstructDenom(ac, msg.Metadata.Base); err != nil {
+		return fmt.Errorf("metadata base %q is not a factory denom: %w", msg.Metadata.Base, err)
+	}
+
+	return msg.Validate(ac)
+}
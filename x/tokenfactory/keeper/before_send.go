@@ -3,6 +3,8 @@ package keeper
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"time"
 
 	storetypes "cosmossdk.io/store/types"
 
@@ -132,7 +134,7 @@ func (k Keeper) callBeforeSendListener(ctx context.Context, from, to sdk.AccAddr
 
 			sdkCtx := sdk.UnwrapSDKContext(ctx)
 			childCtx := sdkCtx.WithGasMeter(storetypes.NewGasMeter(types.BeforeSendHookGasLimit))
-			_, err = k.contractKeeper.Sudo(childCtx.WithEventManager(em), cwAddr, msgBz)
+			err = k.sudoBeforeSendHook(childCtx.WithEventManager(em), cwAddr, msgBz, blockBeforeSend)
 			if err != nil {
 				return errorsmod.Wrapf(err, "failed to call before send hook for denom %s", coin.Denom)
 			}
@@ -143,3 +145,31 @@ func (k Keeper) callBeforeSendListener(ctx context.Context, from, to sdk.AccAddr
 	}
 	return nil
 }
+
+// sudoBeforeSendHook calls the before send hook contract and records the invocation
+// in the hook metrics. A panic, e.g. out of gas, is recorded as a failure and
+// re-panicked.
+func (k Keeper) sudoBeforeSendHook(ctx sdk.Context, cwAddr sdk.AccAddress, msgBz []byte, blockBeforeSend bool) (err error) {
+	if k.hookMetrics == nil {
+		_, err = k.contractKeeper.Sudo(ctx, cwAddr, msgBz)
+		return err
+	}
+
+	hook := "TrackBeforeSend"
+	if blockBeforeSend {
+		hook = "BlockBeforeSend"
+	}
+
+	start := time.Now()
+	defer func() {
+		if r := recover(); r != nil {
+			k.hookMetrics.ObserveHook(ctx, types.ModuleName, hook, start, fmt.Errorf("%v", r))
+			panic(r)
+		}
+
+		k.hookMetrics.ObserveHook(ctx, types.ModuleName, hook, start, err)
+	}()
+
+	_, err = k.contractKeeper.Sudo(ctx, cwAddr, msgBz)
+	return err
+}
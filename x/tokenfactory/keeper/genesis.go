@@ -33,6 +33,12 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 		if err != nil {
 			panic(err)
 		}
+		if genDenom.HookAddress != "" {
+			err = k.setBeforeSendHook(ctx, genDenom.GetDenom(), genDenom.GetHookAddress())
+			if err != nil {
+				panic(err)
+			}
+		}
 	}
 }
 
@@ -50,6 +56,7 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		genDenoms = append(genDenoms, types.GenesisDenom{
 			Denom:             denom,
 			AuthorityMetadata: authorityMetadata,
+			HookAddress:       k.GetBeforeSendHook(ctx, denom),
 		})
 		return false, nil
 	})
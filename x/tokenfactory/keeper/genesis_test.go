@@ -43,6 +43,7 @@ func TestGenesis(t *testing.T) {
 				AuthorityMetadata: types.DenomAuthorityMetadata{
 					Admin: creator,
 				},
+				HookAddress: another,
 			},
 		},
 	}
@@ -27,6 +27,9 @@ type Keeper struct {
 
 	communityPoolKeeper types.CommunityPoolKeeper
 
+	// hookMetrics records the before send hook invocations, if set.
+	hookMetrics types.HookMetrics
+
 	Schema collections.Schema
 	//  key = [creator,denom], value = metadata
 	CreatorDenoms  collections.KeySet[collections.Pair[string, string]]
@@ -90,6 +93,12 @@ func (k *Keeper) SetContractKeeper(contractKeeper types.ContractKeeper) {
 	k.contractKeeper = contractKeeper
 }
 
+// SetHookMetrics sets the metrics of the before send hook invocations. It must be
+// called before the hooks are registered to the bank keeper.
+func (k *Keeper) SetHookMetrics(hookMetrics types.HookMetrics) {
+	k.hookMetrics = hookMetrics
+}
+
 // CreateModuleAccount creates a module account with minting and burning capabilities
 // This account isn't intended to store any coins,
 // it purely mints and burns them on behalf of the admin of respective denoms,
@@ -45,6 +45,13 @@ func (gs GenesisState) Validate(ac address.Codec) error {
 				return errorsmod.Wrapf(ErrInvalidAuthorityMetadata, "Invalid admin address (%s)", err)
 			}
 		}
+
+		if denom.HookAddress != "" {
+			_, err = ac.StringToBytes(denom.HookAddress)
+			if err != nil {
+				return errorsmod.Wrapf(ErrInvalidGenesis, "Invalid hook address (%s)", err)
+			}
+		}
 	}
 
 	return nil
@@ -79,10 +79,11 @@ func (m *GenesisState) GetFactoryDenoms() []GenesisDenom {
 
 // GenesisDenom defines a tokenfactory denom that is defined within genesis
 // state. The structure contains DenomAuthorityMetadata which defines the
-// denom's admin.
+// denom's admin, and the address of the before send hook contract.
 type GenesisDenom struct {
 	Denom             string                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
 	AuthorityMetadata DenomAuthorityMetadata `protobuf:"bytes,2,opt,name=authority_metadata,json=authorityMetadata,proto3" json:"authority_metadata" yaml:"authority_metadata"`
+	HookAddress       string                 `protobuf:"bytes,3,opt,name=hook_address,json=hookAddress,proto3" json:"hook_address,omitempty" yaml:"hook_address"`
 }
 
 func (m *GenesisDenom) Reset()         { *m = GenesisDenom{} }
@@ -132,6 +133,13 @@ func (m *GenesisDenom) GetAuthorityMetadata() DenomAuthorityMetadata {
 	return DenomAuthorityMetadata{}
 }
 
+func (m *GenesisDenom) GetHookAddress() string {
+	if m != nil {
+		return m.HookAddress
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "miniwasm.tokenfactory.v1.GenesisState")
 	proto.RegisterType((*GenesisDenom)(nil), "miniwasm.tokenfactory.v1.GenesisDenom")
@@ -142,30 +150,32 @@ func init() {
 }
 
 var fileDescriptor_529283f7a70aeb23 = []byte{
-	// 361 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xcb, 0xcd, 0xcc, 0xcb,
-	0x2c, 0x4f, 0x2c, 0xce, 0xd5, 0x2f, 0xc9, 0xcf, 0x4e, 0xcd, 0x4b, 0x4b, 0x4c, 0x2e, 0xc9, 0x2f,
-	0xaa, 0xd4, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca,
-	0x2f, 0xc9, 0x17, 0x92, 0x80, 0xa9, 0xd3, 0x43, 0x56, 0xa7, 0x57, 0x66, 0x28, 0x25, 0x92, 0x9e,
-	0x9f, 0x9e, 0x0f, 0x56, 0xa4, 0x0f, 0x62, 0x41, 0xd4, 0x4b, 0x19, 0xe2, 0x34, 0x37, 0xb1, 0xb4,
-	0x24, 0x23, 0xbf, 0x28, 0xb3, 0xa4, 0x32, 0x3e, 0x37, 0xb5, 0x24, 0x31, 0x25, 0xb1, 0x24, 0x11,
-	0xaa, 0x45, 0x15, 0xa7, 0x96, 0x82, 0xc4, 0xa2, 0xc4, 0x5c, 0xa8, 0x4b, 0x94, 0xf6, 0x30, 0x72,
-	0xf1, 0xb8, 0x43, 0xdc, 0x16, 0x5c, 0x92, 0x58, 0x92, 0x2a, 0x64, 0xc7, 0xc5, 0x06, 0x51, 0x20,
-	0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x6d, 0xa4, 0xa0, 0x87, 0xcb, 0xad, 0x7a, 0x01, 0x60, 0x75, 0x4e,
-	0x2c, 0x27, 0xee, 0xc9, 0x33, 0x04, 0x41, 0x75, 0x09, 0xe5, 0x70, 0xf1, 0x41, 0x95, 0xc4, 0xa7,
-	0xa4, 0xe6, 0xe5, 0xe7, 0x16, 0x4b, 0x30, 0x29, 0x30, 0x6b, 0x70, 0x1b, 0xa9, 0xe1, 0x36, 0x07,
-	0x6a, 0xbf, 0x0b, 0x48, 0xb9, 0x93, 0x2c, 0xc8, 0xb4, 0x4f, 0xf7, 0xe4, 0x45, 0x2b, 0x13, 0x73,
-	0x73, 0xac, 0x94, 0x50, 0xcd, 0x52, 0x0a, 0xe2, 0x85, 0x0a, 0xb8, 0x40, 0xf8, 0x07, 0x11, 0xce,
-	0x07, 0x8b, 0x08, 0xa9, 0x71, 0xb1, 0x82, 0x95, 0x82, 0x5d, 0xcf, 0xe9, 0x24, 0xf0, 0xe9, 0x9e,
-	0x3c, 0x0f, 0xc4, 0x24, 0xb0, 0xb0, 0x52, 0x10, 0x44, 0x5a, 0xa8, 0x89, 0x91, 0x4b, 0x08, 0x33,
-	0xec, 0x24, 0x98, 0xc0, 0x7e, 0x36, 0xc0, 0xed, 0x56, 0xb0, 0x2d, 0x8e, 0x30, 0x8d, 0xbe, 0x50,
-	0x7d, 0x4e, 0x8a, 0x50, 0x57, 0x4b, 0x42, 0xec, 0xc2, 0x34, 0x59, 0x29, 0x48, 0x30, 0x11, 0x5d,
-	0x97, 0x15, 0xcb, 0x8b, 0x05, 0xf2, 0x8c, 0x4e, 0x7e, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24,
-	0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78,
-	0x2c, 0xc7, 0x10, 0x65, 0x92, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x9f,
-	0x99, 0x97, 0x59, 0x92, 0x99, 0xa8, 0x9b, 0x93, 0x98, 0x54, 0xac, 0x0f, 0x8f, 0xda, 0x0a, 0xd4,
-	0xc8, 0x2d, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0xc7, 0xac, 0x31, 0x20, 0x00, 0x00, 0xff,
-	0xff, 0xfc, 0xc2, 0x79, 0x63, 0x8d, 0x02, 0x00, 0x00,
+	// 397 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x31, 0x6f, 0xda, 0x40,
+	0x14, 0xc7, 0x7d, 0x40, 0x91, 0x6a, 0x68, 0xd5, 0xba, 0xad, 0xea, 0x22, 0xd5, 0xa6, 0x96, 0x8a,
+	0x58, 0x6a, 0x17, 0xda, 0x89, 0xa1, 0x12, 0x16, 0x52, 0xa7, 0x44, 0x91, 0xb3, 0x65, 0x41, 0x07,
+	0xbe, 0x98, 0x13, 0xd8, 0x87, 0x7c, 0x07, 0x89, 0xd7, 0x7c, 0x82, 0x7c, 0x84, 0x7c, 0x90, 0x7c,
+	0x00, 0x46, 0xc6, 0x4c, 0x56, 0x04, 0x4b, 0x66, 0xf6, 0x48, 0x91, 0xef, 0x8e, 0x04, 0x82, 0xbc,
+	0xf9, 0xbd, 0xf7, 0xfb, 0xff, 0xdf, 0xdf, 0xa7, 0xa7, 0x36, 0x42, 0x1c, 0xe1, 0x0b, 0x48, 0x43,
+	0x87, 0x91, 0x31, 0x8a, 0xce, 0xe1, 0x90, 0x91, 0x38, 0x71, 0xe6, 0x2d, 0x27, 0x40, 0x11, 0xa2,
+	0x98, 0xda, 0xd3, 0x98, 0x30, 0xa2, 0xe9, 0x5b, 0xce, 0xde, 0xe5, 0xec, 0x79, 0xab, 0xf6, 0x39,
+	0x20, 0x01, 0xe1, 0x90, 0x93, 0x7d, 0x09, 0xbe, 0xd6, 0xca, 0xf5, 0x85, 0x33, 0x36, 0x22, 0x31,
+	0x66, 0x49, 0x3f, 0x44, 0x0c, 0xfa, 0x90, 0x41, 0x29, 0xf9, 0x99, 0x2b, 0x99, 0xc2, 0x18, 0x86,
+	0x32, 0x89, 0x75, 0x0b, 0xd4, 0xea, 0x7f, 0x91, 0xed, 0x94, 0x41, 0x86, 0xb4, 0x7f, 0x6a, 0x59,
+	0x00, 0x3a, 0xa8, 0x83, 0x66, 0xa5, 0x5d, 0xb7, 0xf3, 0xb2, 0xda, 0x27, 0x9c, 0x73, 0x4b, 0x8b,
+	0xd4, 0x54, 0x3c, 0xa9, 0xd2, 0x26, 0xea, 0x7b, 0x89, 0xf4, 0x7d, 0x14, 0x91, 0x90, 0xea, 0x85,
+	0x7a, 0xb1, 0x59, 0x69, 0x37, 0xf2, 0x7d, 0xe4, 0xfe, 0x5e, 0x86, 0xbb, 0xdf, 0x33, 0xb7, 0x4d,
+	0x6a, 0x7e, 0x49, 0x60, 0x38, 0xe9, 0x58, 0xfb, 0x5e, 0x96, 0xf7, 0x4e, 0x36, 0x7a, 0xa2, 0x7e,
+	0x7c, 0x89, 0xcf, 0x3b, 0x5a, 0x43, 0x7d, 0xc3, 0x51, 0x9e, 0xfe, 0xad, 0xfb, 0x61, 0x93, 0x9a,
+	0x55, 0xe1, 0xc4, 0xdb, 0x96, 0x27, 0xc6, 0xda, 0x15, 0x50, 0xb5, 0xc3, 0xb7, 0xd3, 0x0b, 0xfc,
+	0x9f, 0x7f, 0xe7, 0x67, 0xe5, 0x5b, 0xba, 0x5b, 0xe1, 0x91, 0xd4, 0xb9, 0x3f, 0x64, 0xea, 0x6f,
+	0x62, 0xd7, 0xa1, 0xb3, 0xe5, 0x7d, 0x84, 0xaf, 0x55, 0x5a, 0x47, 0xad, 0x8e, 0x08, 0x19, 0xf7,
+	0xa1, 0xef, 0xc7, 0x88, 0x52, 0xbd, 0xc8, 0x33, 0x7f, 0xdd, 0xa4, 0xe6, 0x27, 0xe1, 0xb3, 0x3b,
+	0xb5, 0xbc, 0x4a, 0x56, 0x76, 0x45, 0xd5, 0x29, 0x3d, 0xdc, 0x98, 0xc0, 0x3d, 0x5e, 0xac, 0x0c,
+	0xb0, 0x5c, 0x19, 0xe0, 0x7e, 0x65, 0x80, 0xeb, 0xb5, 0xa1, 0x2c, 0xd7, 0x86, 0x72, 0xb7, 0x36,
+	0x94, 0xb3, 0xbf, 0x01, 0x66, 0xa3, 0xd9, 0xc0, 0x1e, 0x92, 0xd0, 0xc1, 0x11, 0x66, 0x18, 0xfe,
+	0x9a, 0xc0, 0x01, 0x75, 0x9e, 0xcf, 0xe2, 0x72, 0xff, 0x30, 0x58, 0x32, 0x45, 0x74, 0x50, 0xe6,
+	0x57, 0xf1, 0xe7, 0x69, 0x00, 0xa0, 0x67, 0xaa, 0xc3, 0xc9, 0x02, 0x00, 0x00,
 }
 
 func (this *GenesisDenom) Equal(that interface{}) bool {
@@ -193,6 +203,9 @@ func (this *GenesisDenom) Equal(that interface{}) bool {
 	if !this.AuthorityMetadata.Equal(&that1.AuthorityMetadata) {
 		return false
 	}
+	if this.HookAddress != that1.HookAddress {
+		return false
+	}
 	return true
 }
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -262,6 +275,13 @@ func (m *GenesisDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.HookAddress) > 0 {
+		i -= len(m.HookAddress)
+		copy(dAtA[i:], m.HookAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.HookAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	{
 		size, err := m.AuthorityMetadata.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -322,6 +342,10 @@ func (m *GenesisDenom) Size() (n int) {
 	}
 	l = m.AuthorityMetadata.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	l = len(m.HookAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -542,6 +566,38 @@ func (m *GenesisDenom) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HookAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HookAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
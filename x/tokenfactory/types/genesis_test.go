@@ -98,6 +98,36 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "valid hook address",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: bitcoin,
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: creator,
+						},
+						HookAddress: creator,
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "invalid hook address",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: bitcoin,
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: creator,
+						},
+						HookAddress: "moose",
+					},
+				},
+			},
+			valid: false,
+		},
 		{
 			desc: "multiple denoms",
 			genState: &types.GenesisState{
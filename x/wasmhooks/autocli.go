@@ -0,0 +1,87 @@
+package wasmhooks
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	wasmhooksv1 "github.com/initia-labs/miniwasm/api/miniwasm/wasmhooks/v1"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: wasmhooksv1.Query_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "FailedCallback",
+					Use:       "failed-callback [id]",
+					Short:     "Get a failed async callback by id",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "id"},
+					},
+				},
+				{
+					RpcMethod: "FailedCallbacks",
+					Use:       "failed-callbacks",
+					Short:     "Returns all failed async callbacks waiting in the retry queue",
+				},
+				{
+					RpcMethod: "ContractACL",
+					Use:       "contract-acl [contract]",
+					Short:     "Get the wasm hook acl of a contract",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "contract"},
+					},
+				},
+				{
+					RpcMethod: "ContractACLs",
+					Use:       "contract-acls",
+					Short:     "Returns all contract acls",
+				},
+				{
+					RpcMethod: "SimulateIBCHook",
+					Use:       "simulate-ibc-hook [source-channel] [destination-channel] [denom] [amount] [sender] [receiver] [memo]",
+					Short:     "Simulate the receive of an ics20 packet with a wasm hook memo without committing any state",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "source_channel"},
+						{ProtoField: "destination_channel"},
+						{ProtoField: "denom"},
+						{ProtoField: "amount"},
+						{ProtoField: "sender"},
+						{ProtoField: "receiver"},
+						{ProtoField: "memo"},
+					},
+				},
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Returns the wasmhooks module's parameters",
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: wasmhooksv1.Msg_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "RetryHookCallback",
+					Use:       "retry-callback [id]",
+					Short:     "Retry a failed async callback. The callback is executed with the sender's gas.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "id"},
+					},
+				},
+				{
+					RpcMethod: "UpdateParams",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "SetContractACL",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "RemoveContractACL",
+					Skip:      true, // skipped because authority gated
+				},
+			},
+		},
+	}
+}
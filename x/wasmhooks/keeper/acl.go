@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/miniwasm/x/wasmhooks/types"
+)
+
+// SetContractACL stores the acl of the contract.
+func (k Keeper) SetContractACL(ctx context.Context, acl types.ContractACL) error {
+	contractAddr, err := k.ac.StringToBytes(acl.Contract)
+	if err != nil {
+		return err
+	}
+
+	return k.ContractACLs.Set(ctx, contractAddr, acl)
+}
+
+// RemoveContractACL removes the acl of the contract.
+func (k Keeper) RemoveContractACL(ctx context.Context, contractAddr sdk.AccAddress) error {
+	if found, err := k.ContractACLs.Has(ctx, contractAddr); err != nil {
+		return err
+	} else if !found {
+		return errorsmod.Wrapf(types.ErrContractACLNotFound, "contract: %s", contractAddr)
+	}
+
+	return k.ContractACLs.Remove(ctx, contractAddr)
+}
+
+// GetContractACL returns the acl of the contract.
+func (k Keeper) GetContractACL(ctx context.Context, contractAddr sdk.AccAddress) (types.ContractACL, error) {
+	acl, err := k.ContractACLs.Get(ctx, contractAddr)
+	if err != nil && errors.Is(err, collections.ErrNotFound) {
+		return types.ContractACL{}, errorsmod.Wrapf(types.ErrContractACLNotFound, "contract: %s", contractAddr)
+	}
+
+	return acl, err
+}
+
+// IsReceiveAllowed returns true if the contract can be executed by a packet received
+// on the local channel from the counterparty sender.
+func (k Keeper) IsReceiveAllowed(ctx context.Context, contractAddr sdk.AccAddress, channel, sender string) (bool, error) {
+	return k.isAllowed(ctx, contractAddr, func(acl types.ContractACL) bool {
+		return acl.AllowsReceive(channel, sender)
+	})
+}
+
+// IsCallbackAllowed returns true if the contract can receive the async callbacks of a
+// packet sent on the local channel.
+func (k Keeper) IsCallbackAllowed(ctx context.Context, contractAddr sdk.AccAddress, channel string) (bool, error) {
+	return k.isAllowed(ctx, contractAddr, func(acl types.ContractACL) bool {
+		return acl.AllowsCallback(channel)
+	})
+}
+
+// isAllowed resolves the permission of the contract in the following order:
+//  1. every contract is allowed in the open acl mode.
+//  2. the contract acl decides if the contract has one.
+//  3. the ibchooks allowed flag decides otherwise.
+func (k Keeper) isAllowed(ctx context.Context, contractAddr sdk.AccAddress, allows func(types.ContractACL) bool) (bool, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	if params.AclMode == types.AclModeOpen {
+		return true, nil
+	}
+
+	acl, err := k.ContractACLs.Get(ctx, contractAddr)
+	if err == nil {
+		return allows(acl), nil
+	} else if !errors.Is(err, collections.ErrNotFound) {
+		return false, err
+	}
+
+	return k.aclKeeper.GetAllowed(ctx, contractAddr)
+}
@@ -0,0 +1,100 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/initia-labs/miniwasm/x/wasmhooks/keeper"
+	"github.com/initia-labs/miniwasm/x/wasmhooks/types"
+)
+
+func Test_ContractACL(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	k := input.WasmHooksKeeper
+
+	// falls back to the ibchooks acl
+	allowed, err := k.IsReceiveAllowed(ctx, addrs[2], "channel-0", "sender")
+	require.NoError(t, err)
+	require.True(t, allowed)
+
+	input.ACLKeeper.denied[addrs[2].String()] = true
+	allowed, err = k.IsReceiveAllowed(ctx, addrs[2], "channel-0", "sender")
+	require.NoError(t, err)
+	require.False(t, allowed)
+	allowed, err = k.IsCallbackAllowed(ctx, addrs[2], "channel-0")
+	require.NoError(t, err)
+	require.False(t, allowed)
+
+	// contract acl overrides the ibchooks acl
+	require.NoError(t, k.SetContractACL(ctx, types.NewContractACL(addrs[2].String(), true, false, []string{"channel-0"}, []string{"sender"})))
+
+	allowed, err = k.IsReceiveAllowed(ctx, addrs[2], "channel-0", "sender")
+	require.NoError(t, err)
+	require.True(t, allowed)
+	allowed, err = k.IsReceiveAllowed(ctx, addrs[2], "channel-1", "sender")
+	require.NoError(t, err)
+	require.False(t, allowed)
+	allowed, err = k.IsReceiveAllowed(ctx, addrs[2], "channel-0", "another")
+	require.NoError(t, err)
+	require.False(t, allowed)
+	allowed, err = k.IsCallbackAllowed(ctx, addrs[2], "channel-0")
+	require.NoError(t, err)
+	require.False(t, allowed)
+
+	// open mode allows everything
+	params, err := k.GetParams(ctx)
+	require.NoError(t, err)
+	params.AclMode = types.AclModeOpen
+	require.NoError(t, k.SetParams(ctx, params))
+
+	allowed, err = k.IsReceiveAllowed(ctx, addrs[2], "channel-1", "another")
+	require.NoError(t, err)
+	require.True(t, allowed)
+	allowed, err = k.IsCallbackAllowed(ctx, addrs[2], "channel-1")
+	require.NoError(t, err)
+	require.True(t, allowed)
+}
+
+func TestMsgSetContractACL(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	msgServer := keeper.NewMsgServerImpl(input.WasmHooksKeeper)
+	queryServer := keeper.Querier{Keeper: input.WasmHooksKeeper}
+
+	acl := types.NewContractACL(addrs[2].String(), false, true, []string{"channel-0"}, nil)
+
+	// invalid authority
+	_, err := msgServer.SetContractACL(ctx, types.NewMsgSetContractACL(addrs[0].String(), acl))
+	require.Error(t, err)
+
+	// invalid acl
+	_, err = msgServer.SetContractACL(ctx, types.NewMsgSetContractACL(
+		input.WasmHooksKeeper.GetAuthority(),
+		types.NewContractACL(addrs[2].String(), false, true, []string{"channel-0", "channel-0"}, nil),
+	))
+	require.ErrorIs(t, err, types.ErrInvalidContractACL)
+
+	_, err = msgServer.SetContractACL(ctx, types.NewMsgSetContractACL(input.WasmHooksKeeper.GetAuthority(), acl))
+	require.NoError(t, err)
+
+	res, err := queryServer.ContractACL(ctx, &types.QueryContractACLRequest{Contract: addrs[2].String()})
+	require.NoError(t, err)
+	require.Equal(t, acl, res.ContractAcl)
+
+	resAll, err := queryServer.ContractACLs(ctx, &types.QueryContractACLsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.ContractACL{acl}, resAll.ContractAcls)
+
+	// remove
+	_, err = msgServer.RemoveContractACL(ctx, types.NewMsgRemoveContractACL(addrs[0].String(), addrs[2].String()))
+	require.Error(t, err)
+
+	_, err = msgServer.RemoveContractACL(ctx, types.NewMsgRemoveContractACL(input.WasmHooksKeeper.GetAuthority(), addrs[2].String()))
+	require.NoError(t, err)
+
+	_, err = queryServer.ContractACL(ctx, &types.QueryContractACLRequest{Contract: addrs[2].String()})
+	require.ErrorIs(t, err, types.ErrContractACLNotFound)
+
+	_, err = msgServer.RemoveContractACL(ctx, types.NewMsgRemoveContractACL(input.WasmHooksKeeper.GetAuthority(), addrs[2].String()))
+	require.ErrorIs(t, err, types.ErrContractACLNotFound)
+}
@@ -0,0 +1,182 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"math"
+	"strconv"
+	"time"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/miniwasm/x/wasmhooks/types"
+)
+
+// ExecuteCallback delivers the sudo message to the callback contract in a cached
+// context, which is written only when the execution succeeds.
+//
+// If gasLimit is non-zero the callback is executed with its own gas meter and the
+// consumed gas is charged to the parent context afterwards, so the callback can never
+// consume more than gasLimit from the caller. Otherwise the parent gas meter is used.
+func (k Keeper) ExecuteCallback(ctx sdk.Context, contractAddr sdk.AccAddress, msg []byte, gasLimit uint64) (err error) {
+	cacheCtx, write := ctx.CacheContext()
+	if gasLimit != 0 {
+		cacheCtx = cacheCtx.WithGasMeter(storetypes.NewGasMeter(gasLimit))
+		defer func() {
+			// consume gas used for calling contract to the parent ctx
+			ctx.GasMeter().ConsumeGas(cacheCtx.GasMeter().GasConsumedToLimit(), "wasm hook callback")
+		}()
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			oog, ok := r.(storetypes.ErrorOutOfGas)
+			if !ok || gasLimit == 0 {
+				panic(r)
+			}
+
+			err = errorsmod.Wrapf(types.ErrCallbackOutOfGas, "out of gas in location: %s; gasLimit: %d", oog.Descriptor, gasLimit)
+		}
+	}()
+
+	if _, err := k.contractKeeper.Sudo(cacheCtx, contractAddr, msg); err != nil {
+		return err
+	}
+
+	// write the cache context only if the callback execution was successful
+	write()
+
+	return nil
+}
+
+// EnqueueFailedCallback stores the failed callback in the retry queue and returns
+// the id assigned to it. The callback is pruned after the failed callback expiry.
+func (k Keeper) EnqueueFailedCallback(ctx context.Context, callback types.FailedCallback) (uint64, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	id, err := k.NextFailedCallbackId.Next(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	callback.Id = id
+	callback.ExpireTime = sdkCtx.BlockTime().Add(params.FailedCallbackExpiry)
+	if err := k.setFailedCallback(ctx, callback); err != nil {
+		return 0, err
+	}
+
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeCallbackQueued,
+		sdk.NewAttribute(types.AttributeKeyCallbackId, strconv.FormatUint(id, 10)),
+		sdk.NewAttribute(types.AttributeKeyContract, callback.Contract),
+		sdk.NewAttribute(types.AttributeKeyCallbackType, callback.CallbackType.ShortName()),
+		sdk.NewAttribute(types.AttributeKeySourceChannel, callback.SourceChannel),
+		sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(callback.Sequence, 10)),
+	))
+
+	return id, nil
+}
+
+// GetFailedCallback returns the failed callback with the given id.
+func (k Keeper) GetFailedCallback(ctx context.Context, id uint64) (types.FailedCallback, error) {
+	callback, err := k.FailedCallbacks.Get(ctx, id)
+	if err != nil && errors.Is(err, collections.ErrNotFound) {
+		return types.FailedCallback{}, errorsmod.Wrapf(types.ErrFailedCallbackNotFound, "id: %d", id)
+	}
+
+	return callback, err
+}
+
+// RetryFailedCallback executes the failed callback again with the gas meter of the
+// given context and removes it from the retry queue on success. On failure the
+// callback is kept in the queue and the error is returned. An expired callback is
+// not executed, even if it is not pruned yet.
+func (k Keeper) RetryFailedCallback(ctx sdk.Context, id uint64) error {
+	callback, err := k.GetFailedCallback(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	// same as the pruning condition of PruneExpiredFailedCallbacks
+	if !ctx.BlockTime().Before(callback.ExpireTime) {
+		return errorsmod.Wrapf(types.ErrFailedCallbackExpired, "id: %d, expire time: %s", id, callback.ExpireTime)
+	}
+
+	contractAddr, err := k.ac.StringToBytes(callback.Contract)
+	if err != nil {
+		return err
+	}
+
+	if allowed, err := k.IsCallbackAllowed(ctx, contractAddr, callback.SourceChannel); err != nil {
+		return err
+	} else if !allowed {
+		return errorsmod.Wrapf(types.ErrCallbackNotAllowed, "contract: %s", callback.Contract)
+	}
+
+	if err := k.ExecuteCallback(ctx, contractAddr, callback.Msg, 0); err != nil {
+		return err
+	}
+
+	return k.removeFailedCallback(ctx, callback)
+}
+
+// PruneExpiredFailedCallbacks removes all failed callbacks which are expired at the
+// current block time.
+func (k Keeper) PruneExpiredFailedCallbacks(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	blockTime := sdkCtx.BlockTime()
+
+	var expired []uint64
+	rng := new(collections.Range[collections.Pair[time.Time, uint64]]).
+		EndInclusive(collections.Join(blockTime, uint64(math.MaxUint64)))
+	err := k.FailedCallbackExpiry.Walk(ctx, rng, func(key collections.Pair[time.Time, uint64]) (stop bool, err error) {
+		expired = append(expired, key.K2())
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, id := range expired {
+		callback, err := k.FailedCallbacks.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if err := k.removeFailedCallback(ctx, callback); err != nil {
+			return err
+		}
+
+		sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeCallbackPruned,
+			sdk.NewAttribute(types.AttributeKeyCallbackId, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeyContract, callback.Contract),
+		))
+	}
+
+	return nil
+}
+
+func (k Keeper) setFailedCallback(ctx context.Context, callback types.FailedCallback) error {
+	if err := k.FailedCallbacks.Set(ctx, callback.Id, callback); err != nil {
+		return err
+	}
+
+	return k.FailedCallbackExpiry.Set(ctx, collections.Join(callback.ExpireTime, callback.Id))
+}
+
+func (k Keeper) removeFailedCallback(ctx context.Context, callback types.FailedCallback) error {
+	if err := k.FailedCallbacks.Remove(ctx, callback.Id); err != nil {
+		return err
+	}
+
+	return k.FailedCallbackExpiry.Remove(ctx, collections.Join(callback.ExpireTime, callback.Id))
+}
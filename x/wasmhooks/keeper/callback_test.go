@@ -0,0 +1,148 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/initia-labs/miniwasm/x/wasmhooks/types"
+)
+
+func Test_ExecuteCallback(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	input.ContractKeeper.gasToConsume = 100
+	gasBefore := ctx.GasMeter().GasConsumed()
+	err := input.WasmHooksKeeper.ExecuteCallback(ctx, addrs[2], []byte(`{"foo":{}}`), 100_000)
+	require.NoError(t, err)
+
+	// the gas used by the callback is charged to the parent context
+	gasUsed := ctx.GasMeter().GasConsumed() - gasBefore
+	require.Greater(t, gasUsed, uint64(100))
+	require.Less(t, gasUsed, uint64(100_000))
+	require.Equal(t, []byte(`{"foo":{}}`), ctx.KVStore(testStoreKey).Get(addrs[2]))
+}
+
+func Test_ExecuteCallback_OutOfGas(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	input.ContractKeeper.gasToConsume = 1_001
+	gasBefore := ctx.GasMeter().GasConsumed()
+	err := input.WasmHooksKeeper.ExecuteCallback(ctx, addrs[2], []byte(`{"foo":{}}`), 1_000)
+	require.ErrorIs(t, err, types.ErrCallbackOutOfGas)
+
+	// only the gas limit is charged to the parent context
+	require.Equal(t, uint64(1_000), ctx.GasMeter().GasConsumed()-gasBefore)
+	require.Nil(t, ctx.KVStore(testStoreKey).Get(addrs[2]))
+}
+
+func Test_ExecuteCallback_Error(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	input.ContractKeeper.err = errSudo
+	err := input.WasmHooksKeeper.ExecuteCallback(ctx, addrs[2], []byte(`{"foo":{}}`), 100_000)
+	require.ErrorIs(t, err, errSudo)
+	require.Nil(t, ctx.KVStore(testStoreKey).Get(addrs[2]))
+}
+
+func Test_EnqueueAndRetryFailedCallback(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	id, err := input.WasmHooksKeeper.EnqueueFailedCallback(ctx, types.FailedCallback{
+		Contract:      addrs[2].String(),
+		CallbackType:  types.CallbackTypeAck,
+		SourceChannel: "channel-0",
+		Sequence:      1,
+		Msg:           []byte(`{"foo":{}}`),
+		Error:         "out of gas",
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), id)
+
+	callback, err := input.WasmHooksKeeper.GetFailedCallback(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, ctx.BlockTime().Add(types.DefaultFailedCallbackExpiry), callback.ExpireTime)
+
+	// retry fails; callback is kept
+	input.ContractKeeper.err = errSudo
+	err = input.WasmHooksKeeper.RetryFailedCallback(ctx, id)
+	require.ErrorIs(t, err, errSudo)
+
+	_, err = input.WasmHooksKeeper.GetFailedCallback(ctx, id)
+	require.NoError(t, err)
+
+	// acl denied
+	input.ContractKeeper.err = nil
+	input.ACLKeeper.denied[addrs[2].String()] = true
+	err = input.WasmHooksKeeper.RetryFailedCallback(ctx, id)
+	require.ErrorIs(t, err, types.ErrCallbackNotAllowed)
+
+	// retry succeeds; callback is removed
+	delete(input.ACLKeeper.denied, addrs[2].String())
+	err = input.WasmHooksKeeper.RetryFailedCallback(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, []byte(`{"foo":{}}`), ctx.KVStore(testStoreKey).Get(addrs[2]))
+
+	_, err = input.WasmHooksKeeper.GetFailedCallback(ctx, id)
+	require.ErrorIs(t, err, types.ErrFailedCallbackNotFound)
+
+	err = input.WasmHooksKeeper.RetryFailedCallback(ctx, id)
+	require.ErrorIs(t, err, types.ErrFailedCallbackNotFound)
+}
+
+func Test_RetryExpiredFailedCallback(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	id, err := input.WasmHooksKeeper.EnqueueFailedCallback(ctx, types.FailedCallback{
+		Contract:     addrs[2].String(),
+		CallbackType: types.CallbackTypeAck,
+		Msg:          []byte(`{"foo":{}}`),
+	})
+	require.NoError(t, err)
+
+	// the callback is expired but not pruned yet
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(types.DefaultFailedCallbackExpiry))
+	err = input.WasmHooksKeeper.RetryFailedCallback(ctx, id)
+	require.ErrorIs(t, err, types.ErrFailedCallbackExpired)
+	require.Nil(t, ctx.KVStore(testStoreKey).Get(addrs[2]))
+
+	_, err = input.WasmHooksKeeper.GetFailedCallback(ctx, id)
+	require.NoError(t, err)
+}
+
+func Test_PruneExpiredFailedCallbacks(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	callback := types.FailedCallback{
+		Contract:     addrs[2].String(),
+		CallbackType: types.CallbackTypeTimeout,
+		Msg:          []byte(`{"foo":{}}`),
+	}
+
+	id1, err := input.WasmHooksKeeper.EnqueueFailedCallback(ctx, callback)
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	id2, err := input.WasmHooksKeeper.EnqueueFailedCallback(ctx, callback)
+	require.NoError(t, err)
+
+	// nothing expired yet
+	require.NoError(t, input.WasmHooksKeeper.PruneExpiredFailedCallbacks(ctx))
+	_, err = input.WasmHooksKeeper.GetFailedCallback(ctx, id1)
+	require.NoError(t, err)
+
+	// first callback expired
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(types.DefaultFailedCallbackExpiry - time.Hour))
+	require.NoError(t, input.WasmHooksKeeper.PruneExpiredFailedCallbacks(ctx))
+	_, err = input.WasmHooksKeeper.GetFailedCallback(ctx, id1)
+	require.ErrorIs(t, err, types.ErrFailedCallbackNotFound)
+	_, err = input.WasmHooksKeeper.GetFailedCallback(ctx, id2)
+	require.NoError(t, err)
+
+	// second callback expired
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	require.NoError(t, input.WasmHooksKeeper.PruneExpiredFailedCallbacks(ctx))
+	_, err = input.WasmHooksKeeper.GetFailedCallback(ctx, id2)
+	require.ErrorIs(t, err, types.ErrFailedCallbackNotFound)
+}
@@ -0,0 +1,117 @@
+package keeper_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/log"
+	"cosmossdk.io/store"
+	"cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+	dbm "github.com/cosmos/cosmos-db"
+
+	codecaddress "github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/initia-labs/miniwasm/x/wasmhooks/keeper"
+	"github.com/initia-labs/miniwasm/x/wasmhooks/types"
+)
+
+var (
+	addrs = []sdk.AccAddress{
+		sdk.AccAddress([]byte("addr1_______________")),
+		sdk.AccAddress([]byte("addr2_______________")),
+		sdk.AccAddress([]byte("contract1___________")),
+	}
+)
+
+// mockContractKeeper records the sudo calls and consumes the configured gas
+// before returning the configured error.
+type mockContractKeeper struct {
+	gasToConsume uint64
+	err          error
+	calls        [][]byte
+}
+
+func (m *mockContractKeeper) Sudo(ctx context.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.GasMeter().ConsumeGas(m.gasToConsume, "mock sudo")
+	if m.err != nil {
+		return nil, m.err
+	}
+
+	// write something to check the state is committed only on success
+	sdkCtx.KVStore(testStoreKey).Set(contractAddress, msg)
+	m.calls = append(m.calls, msg)
+	return nil, nil
+}
+
+// mockACLKeeper allows all contracts unless they are denied.
+type mockACLKeeper struct {
+	denied map[string]bool
+}
+
+func (m mockACLKeeper) GetAllowed(_ context.Context, addr sdk.AccAddress) (bool, error) {
+	return !m.denied[addr.String()], nil
+}
+
+var testStoreKey = storetypes.NewKVStoreKey("test")
+
+type TestKeepers struct {
+	AddressCodec    address.Codec
+	WasmHooksKeeper *keeper.Keeper
+	ContractKeeper  *mockContractKeeper
+	ACLKeeper       mockACLKeeper
+}
+
+func createDefaultTestInput(t testing.TB) (sdk.Context, TestKeepers) {
+	encodingConfig := moduletestutil.MakeTestEncodingConfig()
+	types.RegisterInterfaces(encodingConfig.InterfaceRegistry)
+
+	db := dbm.NewMemDB()
+	keys := storetypes.NewKVStoreKeys(types.StoreKey)
+	ms := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+	for _, v := range keys {
+		ms.MountStoreWithDB(v, storetypes.StoreTypeIAVL, db)
+	}
+	ms.MountStoreWithDB(testStoreKey, storetypes.StoreTypeIAVL, db)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	ctx := sdk.NewContext(ms, tmproto.Header{
+		Height: 1234567,
+		Time:   time.Date(2020, time.April, 22, 12, 0, 0, 0, time.UTC),
+	}, false, log.NewNopLogger()).WithGasMeter(storetypes.NewInfiniteGasMeter())
+
+	ac := codecaddress.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	contractKeeper := &mockContractKeeper{}
+	aclKeeper := mockACLKeeper{denied: make(map[string]bool)}
+	wasmHooksKeeper := keeper.NewKeeper(
+		ac,
+		encodingConfig.Codec,
+		runtime.NewKVStoreService(keys[types.StoreKey]),
+		contractKeeper,
+		aclKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+	wasmHooksKeeper.InitGenesis(ctx, *types.DefaultGenesis())
+
+	return ctx, TestKeepers{
+		AddressCodec:    ac,
+		WasmHooksKeeper: wasmHooksKeeper,
+		ContractKeeper:  contractKeeper,
+		ACLKeeper:       aclKeeper,
+	}
+}
+
+var errSudo = errors.New("sudo failed")
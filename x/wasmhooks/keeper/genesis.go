@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/initia-labs/miniwasm/x/wasmhooks/types"
+)
+
+// InitGenesis initializes the wasmhooks module's state from a provided genesis
+// state.
+func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) {
+	if err := k.SetParams(ctx, genState.Params); err != nil {
+		panic(err)
+	}
+
+	for _, callback := range genState.FailedCallbacks {
+		if err := k.setFailedCallback(ctx, callback); err != nil {
+			panic(err)
+		}
+	}
+
+	if err := k.NextFailedCallbackId.Set(ctx, genState.NextFailedCallbackId); err != nil {
+		panic(err)
+	}
+
+	for _, acl := range genState.ContractAcls {
+		if err := k.SetContractACL(ctx, acl); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// ExportGenesis returns the wasmhooks module's exported genesis.
+func (k Keeper) ExportGenesis(ctx context.Context) *types.GenesisState {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	callbacks := []types.FailedCallback{}
+	err = k.FailedCallbacks.Walk(ctx, nil, func(_ uint64, callback types.FailedCallback) (stop bool, err error) {
+		callbacks = append(callbacks, callback)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	nextId, err := k.NextFailedCallbackId.Peek(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	acls := []types.ContractACL{}
+	err = k.ContractACLs.Walk(ctx, nil, func(_ []byte, acl types.ContractACL) (stop bool, err error) {
+		acls = append(acls, acl)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return &types.GenesisState{
+		Params:               params,
+		FailedCallbacks:      callbacks,
+		NextFailedCallbackId: nextId,
+		ContractAcls:         acls,
+	}
+}
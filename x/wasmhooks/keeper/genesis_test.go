@@ -0,0 +1,56 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/initia-labs/miniwasm/x/wasmhooks/types"
+)
+
+func TestGenesis(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	expireTime := time.Date(2020, time.April, 29, 12, 0, 0, 0, time.UTC)
+	genesisState := types.GenesisState{
+		Params: types.NewParams(500_000, time.Hour, types.AclModeOpen),
+		FailedCallbacks: []types.FailedCallback{
+			{
+				Id:            3,
+				Contract:      addrs[2].String(),
+				CallbackType:  types.CallbackTypeAck,
+				SourceChannel: "channel-0",
+				Sequence:      10,
+				Msg:           []byte(`{"foo":{}}`),
+				Error:         "out of gas",
+				ExpireTime:    expireTime,
+			},
+			{
+				Id:            5,
+				Contract:      addrs[2].String(),
+				CallbackType:  types.CallbackTypeTimeout,
+				SourceChannel: "channel-1",
+				Sequence:      11,
+				Msg:           []byte(`{"bar":{}}`),
+				Error:         "failed",
+				ExpireTime:    expireTime.Add(time.Hour),
+			},
+		},
+		NextFailedCallbackId: 6,
+		ContractAcls: []types.ContractACL{
+			types.NewContractACL(addrs[2].String(), true, false, []string{"channel-0"}, []string{"counterparty_sender"}),
+		},
+	}
+	require.NoError(t, genesisState.Validate(input.AddressCodec))
+
+	input.WasmHooksKeeper.InitGenesis(ctx, genesisState)
+
+	exportedGenesis := input.WasmHooksKeeper.ExportGenesis(ctx)
+	require.Equal(t, genesisState, *exportedGenesis)
+
+	// the next callback should use the next id
+	id, err := input.WasmHooksKeeper.EnqueueFailedCallback(ctx, genesisState.FailedCallbacks[0])
+	require.NoError(t, err)
+	require.Equal(t, uint64(6), id)
+}
@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/initia-labs/miniwasm/x/wasmhooks/types"
+)
+
+type Querier struct {
+	*Keeper
+}
+
+var _ types.QueryServer = Querier{}
+
+func (q Querier) Params(ctx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	params, err := q.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+func (q Querier) FailedCallback(ctx context.Context, req *types.QueryFailedCallbackRequest) (*types.QueryFailedCallbackResponse, error) {
+	callback, err := q.GetFailedCallback(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryFailedCallbackResponse{FailedCallback: callback}, nil
+}
+
+func (q Querier) FailedCallbacks(ctx context.Context, req *types.QueryFailedCallbacksRequest) (*types.QueryFailedCallbacksResponse, error) {
+	callbacks, pageRes, err := query.CollectionPaginate(ctx, q.Keeper.FailedCallbacks, req.Pagination,
+		func(_ uint64, callback types.FailedCallback) (types.FailedCallback, error) {
+			return callback, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryFailedCallbacksResponse{FailedCallbacks: callbacks, Pagination: pageRes}, nil
+}
+
+func (q Querier) ContractACL(ctx context.Context, req *types.QueryContractACLRequest) (*types.QueryContractACLResponse, error) {
+	contractAddr, err := q.ac.StringToBytes(req.Contract)
+	if err != nil {
+		return nil, err
+	}
+
+	acl, err := q.GetContractACL(ctx, contractAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryContractACLResponse{ContractAcl: acl}, nil
+}
+
+func (q Querier) ContractACLs(ctx context.Context, req *types.QueryContractACLsRequest) (*types.QueryContractACLsResponse, error) {
+	acls, pageRes, err := query.CollectionPaginate(ctx, q.Keeper.ContractACLs, req.Pagination,
+		func(_ []byte, acl types.ContractACL) (types.ContractACL, error) {
+			return acl, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryContractACLsResponse{ContractAcls: acls, Pagination: pageRes}, nil
+}
+
+func (q Querier) SimulateIBCHook(ctx context.Context, req *types.QuerySimulateIBCHookRequest) (*types.QuerySimulateIBCHookResponse, error) {
+	packet, err := simulationPacket(req)
+	if err != nil {
+		return nil, err
+	}
+
+	return q.SimulateRecvPacket(ctx, packet)
+}
@@ -0,0 +1,115 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+	corestoretypes "cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/miniwasm/x/wasmhooks/types"
+)
+
+type Keeper struct {
+	ac           address.Codec
+	cdc          codec.Codec
+	storeService corestoretypes.KVStoreService
+
+	contractKeeper types.ContractKeeper
+	aclKeeper      types.ACLKeeper
+
+	// packetSimulator is set after the ibc middlewares are built.
+	packetSimulator types.PacketSimulator
+
+	// simulationGasLimit caps the gas of a SimulateIBCHook query, which runs
+	// the contract executions of the hook.
+	simulationGasLimit uint64
+
+	Schema               collections.Schema
+	Params               collections.Item[types.Params]
+	NextFailedCallbackId collections.Sequence
+	FailedCallbacks      collections.Map[uint64, types.FailedCallback]
+	// key = [expire time, id]
+	FailedCallbackExpiry collections.KeySet[collections.Pair[time.Time, uint64]]
+	ContractACLs         collections.Map[[]byte, types.ContractACL]
+
+	authority string
+}
+
+// NewKeeper returns a new instance of the x/wasmhooks keeper
+func NewKeeper(
+	ac address.Codec,
+	cdc codec.Codec,
+	storeService corestoretypes.KVStoreService,
+	contractKeeper types.ContractKeeper,
+	aclKeeper types.ACLKeeper,
+	authority string,
+) *Keeper {
+	// ensure that authority is a valid AccAddress
+	if _, err := ac.StringToBytes(authority); err != nil {
+		panic("authority is not a valid acc address")
+	}
+
+	sb := collections.NewSchemaBuilder(storeService)
+	k := &Keeper{
+		ac:           ac,
+		cdc:          cdc,
+		storeService: storeService,
+
+		contractKeeper: contractKeeper,
+		aclKeeper:      aclKeeper,
+
+		simulationGasLimit: types.DefaultSimulationGasLimit,
+
+		Params:               collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		NextFailedCallbackId: collections.NewSequence(sb, types.NextFailedCallbackIdKey, "next_failed_callback_id"),
+		FailedCallbacks:      collections.NewMap(sb, types.FailedCallbacksPrefix, "failed_callbacks", collections.Uint64Key, codec.CollValue[types.FailedCallback](cdc)),
+		FailedCallbackExpiry: collections.NewKeySet(sb, types.FailedCallbackExpiryPrefix, "failed_callback_expiry", collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key)),
+		ContractACLs:         collections.NewMap(sb, types.ContractACLsPrefix, "contract_acls", collections.BytesKey, codec.CollValue[types.ContractACL](cdc)),
+
+		authority: authority,
+	}
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
+}
+
+// SetPacketSimulator sets the packet simulator used by the SimulateIBCHook query.
+func (k *Keeper) SetPacketSimulator(simulator types.PacketSimulator) *Keeper {
+	if k.packetSimulator != nil {
+		panic("cannot set packet simulator twice")
+	}
+
+	k.packetSimulator = simulator
+	return k
+}
+
+// SetSimulationGasLimit sets the gas limit of the SimulateIBCHook query.
+func (k *Keeper) SetSimulationGasLimit(gasLimit uint64) *Keeper {
+	if gasLimit == 0 {
+		panic("simulation gas limit must be positive")
+	}
+
+	k.simulationGasLimit = gasLimit
+	return k
+}
+
+// GetAuthority returns the x/wasmhooks module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a logger for the x/wasmhooks module
+func (k Keeper) Logger(ctx context.Context) log.Logger {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return sdkCtx.Logger().With("module", "x/"+types.ModuleName)
+}
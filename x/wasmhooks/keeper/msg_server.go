@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/initia-labs/miniwasm/x/wasmhooks/types"
+)
+
+type msgServer struct {
+	*Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper *Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+func (server msgServer) RetryHookCallback(ctx context.Context, msg *types.MsgRetryHookCallback) (*types.MsgRetryHookCallbackResponse, error) {
+	if err := msg.Validate(server.ac); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if err := server.Keeper.RetryFailedCallback(sdkCtx, msg.Id); err != nil {
+		return nil, err
+	}
+
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeCallbackRetried,
+		sdk.NewAttribute(types.AttributeKeyCallbackId, strconv.FormatUint(msg.Id, 10)),
+	))
+
+	return &types.MsgRetryHookCallbackResponse{}, nil
+}
+
+func (server msgServer) UpdateParams(ctx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if server.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", server.GetAuthority(), req.Authority)
+	}
+
+	if err := req.Params.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := server.SetParams(ctx, req.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+func (server msgServer) SetContractACL(ctx context.Context, req *types.MsgSetContractACL) (*types.MsgSetContractACLResponse, error) {
+	if err := req.Validate(server.ac); err != nil {
+		return nil, err
+	}
+
+	if server.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", server.GetAuthority(), req.Authority)
+	}
+
+	if err := server.Keeper.SetContractACL(ctx, req.Acl); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSetContractACL,
+		sdk.NewAttribute(types.AttributeKeyContract, req.Acl.Contract),
+		sdk.NewAttribute(types.AttributeKeyAllowReceive, strconv.FormatBool(req.Acl.AllowReceive)),
+		sdk.NewAttribute(types.AttributeKeyAllowCallback, strconv.FormatBool(req.Acl.AllowCallback)),
+		sdk.NewAttribute(types.AttributeKeyChannels, strings.Join(req.Acl.Channels, ",")),
+		sdk.NewAttribute(types.AttributeKeySenders, strings.Join(req.Acl.Senders, ",")),
+	))
+
+	return &types.MsgSetContractACLResponse{}, nil
+}
+
+func (server msgServer) RemoveContractACL(ctx context.Context, req *types.MsgRemoveContractACL) (*types.MsgRemoveContractACLResponse, error) {
+	if err := req.Validate(server.ac); err != nil {
+		return nil, err
+	}
+
+	if server.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", server.GetAuthority(), req.Authority)
+	}
+
+	contractAddr, err := server.ac.StringToBytes(req.Contract)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := server.Keeper.RemoveContractACL(ctx, contractAddr); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRemoveContractACL,
+		sdk.NewAttribute(types.AttributeKeyContract, req.Contract),
+	))
+
+	return &types.MsgRemoveContractACLResponse{}, nil
+}
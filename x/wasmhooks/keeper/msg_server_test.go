@@ -0,0 +1,68 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/initia-labs/miniwasm/x/wasmhooks/keeper"
+	"github.com/initia-labs/miniwasm/x/wasmhooks/types"
+)
+
+func TestMsgRetryHookCallback(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	msgServer := keeper.NewMsgServerImpl(input.WasmHooksKeeper)
+
+	id, err := input.WasmHooksKeeper.EnqueueFailedCallback(ctx, types.FailedCallback{
+		Contract:     addrs[2].String(),
+		CallbackType: types.CallbackTypeAck,
+		Msg:          []byte(`{"foo":{}}`),
+	})
+	require.NoError(t, err)
+
+	// empty sender
+	_, err = msgServer.RetryHookCallback(ctx, types.NewMsgRetryHookCallback("", id))
+	require.Error(t, err)
+
+	_, err = msgServer.RetryHookCallback(ctx, types.NewMsgRetryHookCallback(addrs[0].String(), id))
+	require.NoError(t, err)
+	require.Len(t, input.ContractKeeper.calls, 1)
+
+	// already retried
+	_, err = msgServer.RetryHookCallback(ctx, types.NewMsgRetryHookCallback(addrs[0].String(), id))
+	require.ErrorIs(t, err, types.ErrFailedCallbackNotFound)
+}
+
+func TestMsgUpdateParams(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	msgServer := keeper.NewMsgServerImpl(input.WasmHooksKeeper)
+
+	params := types.NewParams(123, time.Minute, types.AclModeOpen)
+
+	// invalid authority
+	_, err := msgServer.UpdateParams(ctx, &types.MsgUpdateParams{
+		Authority: addrs[0].String(),
+		Params:    params,
+	})
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+
+	// invalid params
+	_, err = msgServer.UpdateParams(ctx, &types.MsgUpdateParams{
+		Authority: input.WasmHooksKeeper.GetAuthority(),
+		Params:    types.NewParams(123, 0, types.AclModeRestricted),
+	})
+	require.Error(t, err)
+
+	_, err = msgServer.UpdateParams(ctx, &types.MsgUpdateParams{
+		Authority: input.WasmHooksKeeper.GetAuthority(),
+		Params:    params,
+	})
+	require.NoError(t, err)
+
+	res, err := input.WasmHooksKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	require.Equal(t, params, res)
+}
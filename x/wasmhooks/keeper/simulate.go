@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	"github.com/initia-labs/miniwasm/x/wasmhooks/types"
+)
+
+// SimulateRecvPacket simulates the receive of a packet on the wasm hooks middleware in a
+// cached context, so nothing is committed. The full receive path is executed, including
+// the denom derivation, the funding of the intermediate sender, the acl check and the
+// contract execution.
+//
+// The query is public, so the simulation runs with its own gas meter capped by the
+// simulation gas limit, regardless of the query gas limit of the node.
+func (k Keeper) SimulateRecvPacket(ctx context.Context, packet channeltypes.Packet) (res *types.QuerySimulateIBCHookResponse, err error) {
+	if k.packetSimulator == nil {
+		return nil, types.ErrPacketSimulatorNotSet
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	cacheCtx, _ := sdkCtx.CacheContext()
+	cacheCtx = cacheCtx.
+		WithEventManager(sdk.NewEventManager()).
+		WithGasMeter(storetypes.NewGasMeter(k.simulationGasLimit))
+
+	defer func() {
+		if r := recover(); r != nil {
+			oog, ok := r.(storetypes.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+
+			res = nil
+			err = errorsmod.Wrapf(types.ErrSimulationOutOfGas, "out of gas in location: %s; gasLimit: %d", oog.Descriptor, k.simulationGasLimit)
+		}
+	}()
+
+	ack, data := k.packetSimulator.SimulateRecvPacket(cacheCtx, packet, nil)
+
+	res = &types.QuerySimulateIBCHookResponse{
+		GasUsed: cacheCtx.GasMeter().GasConsumed(),
+		Events:  cacheCtx.EventManager().ABCIEvents(),
+		Data:    data,
+	}
+	if ack != nil {
+		res.Acknowledgement = ack.Acknowledgement()
+		res.Success = ack.Success()
+	}
+
+	return res, nil
+}
+
+// simulationPacket builds the packet to be simulated from the request.
+func simulationPacket(req *types.QuerySimulateIBCHookRequest) (channeltypes.Packet, error) {
+	sourcePort := req.SourcePort
+	if sourcePort == "" {
+		sourcePort = transfertypes.PortID
+	}
+
+	destPort := req.DestinationPort
+	if destPort == "" {
+		destPort = transfertypes.PortID
+	}
+
+	if req.SourceChannel == "" || req.DestinationChannel == "" {
+		return channeltypes.Packet{}, types.ErrInvalidSimulation.Wrap("source and destination channels are required")
+	}
+
+	packetData := req.PacketData
+	if len(packetData) == 0 {
+		data := transfertypes.NewFungibleTokenPacketData(req.Denom, req.Amount, req.Sender, req.Receiver, req.Memo)
+		if err := data.ValidateBasic(); err != nil {
+			return channeltypes.Packet{}, types.ErrInvalidSimulation.Wrap(err.Error())
+		}
+
+		packetData = data.GetBytes()
+	}
+
+	return channeltypes.Packet{
+		SourcePort:         sourcePort,
+		SourceChannel:      req.SourceChannel,
+		DestinationPort:    destPort,
+		DestinationChannel: req.DestinationChannel,
+		Data:               packetData,
+	}, nil
+}
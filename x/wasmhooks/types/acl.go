@@ -0,0 +1,69 @@
+package types
+
+import (
+	"slices"
+
+	"cosmossdk.io/core/address"
+	errorsmod "cosmossdk.io/errors"
+
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+// NewContractACL creates a new contract acl.
+func NewContractACL(contract string, allowReceive, allowCallback bool, channels, senders []string) ContractACL {
+	return ContractACL{
+		Contract:      contract,
+		AllowReceive:  allowReceive,
+		AllowCallback: allowCallback,
+		Channels:      channels,
+		Senders:       senders,
+	}
+}
+
+// Validate performs basic validation of a contract acl.
+func (acl ContractACL) Validate(ac address.Codec) error {
+	if _, err := ac.StringToBytes(acl.Contract); err != nil {
+		return errorsmod.Wrapf(ErrInvalidContractACL, "invalid contract address: %s", err)
+	}
+
+	seenChannels := map[string]bool{}
+	for _, channel := range acl.Channels {
+		if err := host.ChannelIdentifierValidator(channel); err != nil {
+			return errorsmod.Wrapf(ErrInvalidContractACL, "invalid channel: %s", err)
+		}
+		if seenChannels[channel] {
+			return errorsmod.Wrapf(ErrInvalidContractACL, "duplicate channel: %s", channel)
+		}
+		seenChannels[channel] = true
+	}
+
+	seenSenders := map[string]bool{}
+	for _, sender := range acl.Senders {
+		if sender == "" {
+			return errorsmod.Wrap(ErrInvalidContractACL, "empty sender")
+		}
+		if seenSenders[sender] {
+			return errorsmod.Wrapf(ErrInvalidContractACL, "duplicate sender: %s", sender)
+		}
+		seenSenders[sender] = true
+	}
+
+	return nil
+}
+
+// AllowsReceive returns true if the contract can be executed by a packet received on
+// the channel from the counterparty sender.
+func (acl ContractACL) AllowsReceive(channel, sender string) bool {
+	return acl.AllowReceive && acl.allowsChannel(channel) &&
+		(len(acl.Senders) == 0 || slices.Contains(acl.Senders, sender))
+}
+
+// AllowsCallback returns true if the contract can receive the async callbacks of a packet
+// sent on the channel.
+func (acl ContractACL) AllowsCallback(channel string) bool {
+	return acl.AllowCallback && acl.allowsChannel(channel)
+}
+
+func (acl ContractACL) allowsChannel(channel string) bool {
+	return len(acl.Channels) == 0 || slices.Contains(acl.Channels, channel)
+}
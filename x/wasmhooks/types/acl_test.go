@@ -0,0 +1,33 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/initia-labs/miniwasm/x/wasmhooks/types"
+)
+
+func TestContractACL_AllowsReceive(t *testing.T) {
+	acl := types.NewContractACL("contract", true, false, nil, nil)
+	require.True(t, acl.AllowsReceive("channel-0", "sender"))
+	require.False(t, acl.AllowsCallback("channel-0"))
+
+	acl = types.NewContractACL("contract", true, true, []string{"channel-0"}, nil)
+	require.True(t, acl.AllowsReceive("channel-0", "sender"))
+	require.False(t, acl.AllowsReceive("channel-1", "sender"))
+	require.True(t, acl.AllowsCallback("channel-0"))
+	require.False(t, acl.AllowsCallback("channel-1"))
+
+	acl = types.NewContractACL("contract", true, true, []string{"channel-0"}, []string{"sender"})
+	require.True(t, acl.AllowsReceive("channel-0", "sender"))
+	require.False(t, acl.AllowsReceive("channel-0", "another"))
+	require.False(t, acl.AllowsReceive("channel-1", "sender"))
+
+	// senders are not applied to callbacks
+	require.True(t, acl.AllowsCallback("channel-0"))
+
+	acl = types.NewContractACL("contract", false, true, nil, []string{"sender"})
+	require.False(t, acl.AllowsReceive("channel-0", "sender"))
+	require.True(t, acl.AllowsCallback("channel-0"))
+}
@@ -0,0 +1,35 @@
+package types
+
+import (
+	"cosmossdk.io/core/address"
+	errorsmod "cosmossdk.io/errors"
+)
+
+// ShortName returns the short name of the callback type which is used in events.
+func (t CallbackType) ShortName() string {
+	switch t {
+	case CallbackTypeAck:
+		return "ack"
+	case CallbackTypeTimeout:
+		return "timeout"
+	default:
+		return "unspecified"
+	}
+}
+
+// Validate performs basic validation of a failed callback.
+func (c FailedCallback) Validate(ac address.Codec) error {
+	if _, err := ac.StringToBytes(c.Contract); err != nil {
+		return errorsmod.Wrapf(ErrInvalidFailedCallback, "invalid contract address: %s", err)
+	}
+
+	if c.CallbackType != CallbackTypeAck && c.CallbackType != CallbackTypeTimeout {
+		return errorsmod.Wrapf(ErrInvalidFailedCallback, "invalid callback type: %s", c.CallbackType)
+	}
+
+	if err := c.Msg.ValidateBasic(); err != nil {
+		return errorsmod.Wrapf(ErrInvalidFailedCallback, "invalid msg: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,27 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	legacy.RegisterAminoMsg(cdc, &MsgRetryHookCallback{}, "wasmhooks/MsgRetryHookCallback")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "wasmhooks/MsgUpdateParams")
+	legacy.RegisterAminoMsg(cdc, &MsgSetContractACL{}, "wasmhooks/MsgSetContractACL")
+	legacy.RegisterAminoMsg(cdc, &MsgRemoveContractACL{}, "wasmhooks/MsgRemoveContractACL")
+}
+
+func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgRetryHookCallback{},
+		&MsgUpdateParams{},
+		&MsgSetContractACL{},
+		&MsgRemoveContractACL{},
+	)
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
@@ -0,0 +1,40 @@
+package types
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/gogoproto/proto"
+)
+
+// WrapPacketData wraps the packet data into a HookPacketData envelope packed
+// into google.protobuf.Any.
+func WrapPacketData(data []byte, asyncCallback string) ([]byte, error) {
+	anyData, err := codectypes.NewAnyWithValue(&HookPacketData{
+		Data:          data,
+		AsyncCallback: asyncCallback,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return proto.Marshal(anyData)
+}
+
+// UnwrapPacketData returns the HookPacketData envelope of the packet data. It returns
+// false if the packet data is not wrapped.
+func UnwrapPacketData(bz []byte) (HookPacketData, bool) {
+	var anyData codectypes.Any
+	if err := proto.Unmarshal(bz, &anyData); err != nil {
+		return HookPacketData{}, false
+	}
+
+	if anyData.TypeUrl != "/"+proto.MessageName(&HookPacketData{}) {
+		return HookPacketData{}, false
+	}
+
+	var data HookPacketData
+	if err := proto.Unmarshal(anyData.Value, &data); err != nil {
+		return HookPacketData{}, false
+	}
+
+	return data, true
+}
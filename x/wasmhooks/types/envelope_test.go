@@ -0,0 +1,26 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/initia-labs/miniwasm/x/wasmhooks/types"
+)
+
+func TestWrapPacketData(t *testing.T) {
+	bz, err := types.WrapPacketData([]byte(`{"foo":"bar"}`), "contract")
+	require.NoError(t, err)
+
+	data, ok := types.UnwrapPacketData(bz)
+	require.True(t, ok)
+	require.Equal(t, []byte(`{"foo":"bar"}`), data.Data)
+	require.Equal(t, "contract", data.AsyncCallback)
+
+	// not wrapped
+	_, ok = types.UnwrapPacketData([]byte(`{"foo":"bar"}`))
+	require.False(t, ok)
+
+	_, ok = types.UnwrapPacketData([]byte{})
+	require.False(t, ok)
+}
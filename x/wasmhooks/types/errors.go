@@ -0,0 +1,33 @@
+package types
+
+// DONTCOVER
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// x/wasmhooks module sentinel errors
+var (
+	ErrEmptySender            = errorsmod.Register(ModuleName, 2, "empty sender")
+	ErrInvalidGenesis         = errorsmod.Register(ModuleName, 3, "invalid genesis")
+	ErrFailedCallbackNotFound = errorsmod.Register(ModuleName, 4, "failed callback not found")
+	ErrCallbackNotAllowed     = errorsmod.Register(ModuleName, 5, "callback contract is not allowed")
+	ErrCallbackOutOfGas       = errorsmod.Register(ModuleName, 6, "callback gas limit exceeded")
+	ErrInvalidFailedCallback  = errorsmod.Register(ModuleName, 7, "invalid failed callback")
+	ErrFailedCallbackExpired  = errorsmod.Register(ModuleName, 8, "failed callback expired")
+	ErrInvalidContractACL     = errorsmod.Register(ModuleName, 9, "invalid contract acl")
+	ErrContractACLNotFound    = errorsmod.Register(ModuleName, 10, "contract acl not found")
+	ErrInvalidAclMode         = errorsmod.Register(ModuleName, 11, "invalid acl mode")
+
+	// wasm hook errors; the codes are written to the error acks and the hook
+	// failed events, so relayers and frontends can tell the failures apart.
+	ErrInvalidMemo      = errorsmod.Register(ModuleName, 12, "invalid wasm hook memo")
+	ErrMissingContract  = errorsmod.Register(ModuleName, 13, "missing wasm hook contract")
+	ErrReceiverMismatch = errorsmod.Register(ModuleName, 14, "receiver does not match the wasm hook contract")
+	ErrHookNotAllowed   = errorsmod.Register(ModuleName, 15, "contract is not allowed to be used in wasm hooks")
+	ErrExecutionFailed  = errorsmod.Register(ModuleName, 16, "wasm hook execution failed")
+
+	ErrPacketSimulatorNotSet = errorsmod.Register(ModuleName, 17, "packet simulator is not set")
+	ErrInvalidSimulation     = errorsmod.Register(ModuleName, 18, "invalid simulation request")
+	ErrSimulationOutOfGas    = errorsmod.Register(ModuleName, 19, "simulation gas limit exceeded")
+)
@@ -0,0 +1,23 @@
+package types
+
+// event types
+const (
+	EventTypeCallbackQueued  = "callback_queued"
+	EventTypeCallbackRetried = "callback_retried"
+	EventTypeCallbackPruned  = "callback_pruned"
+
+	EventTypeSetContractACL    = "set_contract_acl"
+	EventTypeRemoveContractACL = "remove_contract_acl"
+
+	AttributeKeyCallbackId    = "callback_id"
+	AttributeKeyContract      = "contract"
+	AttributeKeyCallbackType  = "callback_type"
+	AttributeKeySourceChannel = "source_channel"
+	AttributeKeySequence      = "sequence"
+	AttributeKeyAllowReceive  = "allow_receive"
+	AttributeKeyAllowCallback = "allow_callback"
+	AttributeKeyChannels      = "channels"
+	AttributeKeySenders       = "senders"
+	AttributeKeyCodespace     = "codespace"
+	AttributeKeyCode          = "code"
+)
@@ -0,0 +1,27 @@
+package types
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+// ContractKeeper defines the contract needed to deliver callbacks to wasm contracts.
+type ContractKeeper interface {
+	Sudo(ctx context.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error)
+}
+
+// ACLKeeper defines the contract needed to check whether a contract is allowed
+// to be used in ibc hooks.
+type ACLKeeper interface {
+	GetAllowed(ctx context.Context, addr sdk.AccAddress) (bool, error)
+}
+
+// PacketSimulator defines the contract needed to simulate the receive of a packet
+// on the wasm hooks middleware. It returns the acknowledgement and the response
+// data of the contract execution.
+type PacketSimulator interface {
+	SimulateRecvPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) (ibcexported.Acknowledgement, []byte)
+}
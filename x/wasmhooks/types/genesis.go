@@ -0,0 +1,54 @@
+package types
+
+import (
+	"cosmossdk.io/core/address"
+	errorsmod "cosmossdk.io/errors"
+)
+
+// DefaultGenesis returns the default wasmhooks genesis state
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		Params:               DefaultParams(),
+		FailedCallbacks:      []FailedCallback{},
+		NextFailedCallbackId: 1,
+		ContractAcls:         []ContractACL{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate(ac address.Codec) error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	seenIds := map[uint64]bool{}
+	for _, callback := range gs.FailedCallbacks {
+		if seenIds[callback.Id] {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "duplicate failed callback id: %d", callback.Id)
+		}
+		seenIds[callback.Id] = true
+
+		if callback.Id >= gs.NextFailedCallbackId {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "failed callback id %d must be less than next id %d", callback.Id, gs.NextFailedCallbackId)
+		}
+
+		if err := callback.Validate(ac); err != nil {
+			return err
+		}
+	}
+
+	seenContracts := map[string]bool{}
+	for _, acl := range gs.ContractAcls {
+		if seenContracts[acl.Contract] {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "duplicate contract acl: %s", acl.Contract)
+		}
+		seenContracts[acl.Contract] = true
+
+		if err := acl.Validate(ac); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,497 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: miniwasm/wasmhooks/v1/genesis.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GenesisState defines the wasmhooks module's genesis state.
+type GenesisState struct {
+	// params defines the parameters of the module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// failed_callbacks defines the callbacks waiting in the retry queue.
+	FailedCallbacks []FailedCallback `protobuf:"bytes,2,rep,name=failed_callbacks,json=failedCallbacks,proto3" json:"failed_callbacks" yaml:"failed_callbacks"`
+	// next_failed_callback_id defines the id assigned to the next failed
+	// callback.
+	NextFailedCallbackId uint64 `protobuf:"varint,3,opt,name=next_failed_callback_id,json=nextFailedCallbackId,proto3" json:"next_failed_callback_id,omitempty" yaml:"next_failed_callback_id"`
+	// contract_acls defines the wasm hook permissions of the contracts.
+	ContractAcls []ContractACL `protobuf:"bytes,4,rep,name=contract_acls,json=contractAcls,proto3" json:"contract_acls" yaml:"contract_acls"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f6c11e59c4185db, []int{0}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetFailedCallbacks() []FailedCallback {
+	if m != nil {
+		return m.FailedCallbacks
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextFailedCallbackId() uint64 {
+	if m != nil {
+		return m.NextFailedCallbackId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetContractAcls() []ContractACL {
+	if m != nil {
+		return m.ContractAcls
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*GenesisState)(nil), "miniwasm.wasmhooks.v1.GenesisState")
+}
+
+func init() {
+	proto.RegisterFile("miniwasm/wasmhooks/v1/genesis.proto", fileDescriptor_2f6c11e59c4185db)
+}
+
+var fileDescriptor_2f6c11e59c4185db = []byte{
+	// 359 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xcd, 0x4e, 0xea, 0x40,
+	0x18, 0x86, 0xdb, 0x03, 0x61, 0x51, 0x38, 0x39, 0x27, 0x0d, 0x86, 0x86, 0xe8, 0x14, 0xc7, 0x98,
+	0xb0, 0xb1, 0x0d, 0xb8, 0xd3, 0x15, 0x25, 0xd1, 0x18, 0x5d, 0x98, 0xba, 0xd2, 0x4d, 0x33, 0x1d,
+	0x86, 0x32, 0x61, 0xda, 0x41, 0x66, 0x44, 0xd8, 0x79, 0x09, 0x5e, 0x16, 0x4b, 0x96, 0xae, 0x88,
+	0x81, 0x3b, 0xe0, 0x0a, 0x4c, 0x7f, 0x20, 0x42, 0x60, 0xd3, 0x74, 0x32, 0xcf, 0xf7, 0xcc, 0xf7,
+	0xe6, 0xd5, 0xce, 0x42, 0x1a, 0xd1, 0x77, 0x24, 0x42, 0x3b, 0xfe, 0xf4, 0x38, 0xef, 0x0b, 0x7b,
+	0xd4, 0xb0, 0x03, 0x12, 0x11, 0x41, 0x85, 0x35, 0x18, 0x72, 0xc9, 0xf5, 0xa3, 0x35, 0x64, 0x6d,
+	0x20, 0x6b, 0xd4, 0xa8, 0x96, 0x03, 0x1e, 0xf0, 0x84, 0xb0, 0xe3, 0xbf, 0x14, 0xae, 0xc2, 0xfd,
+	0xc6, 0x01, 0x1a, 0xa2, 0x30, 0x13, 0x56, 0x4f, 0xf7, 0x33, 0x72, 0x32, 0x20, 0x19, 0x02, 0x3f,
+	0x72, 0x5a, 0xe9, 0x36, 0xdd, 0xe2, 0x49, 0x22, 0x49, 0xf4, 0x6b, 0xad, 0x90, 0x3a, 0x0c, 0xb5,
+	0xa6, 0xd6, 0x8b, 0xcd, 0x13, 0x6b, 0xef, 0x56, 0xd6, 0x63, 0x02, 0x39, 0xf9, 0xe9, 0xdc, 0x54,
+	0xdc, 0x6c, 0x44, 0x7f, 0xd5, 0xfe, 0x77, 0x11, 0x65, 0xa4, 0xe3, 0x61, 0xc4, 0x98, 0x8f, 0x70,
+	0x5f, 0x18, 0x7f, 0x6a, 0xb9, 0x7a, 0xb1, 0x79, 0x7e, 0x40, 0x73, 0x93, 0xe0, 0xed, 0x8c, 0x76,
+	0xcc, 0x58, 0xb7, 0x9a, 0x9b, 0x95, 0x09, 0x0a, 0xd9, 0x15, 0xdc, 0x95, 0x41, 0xf7, 0x5f, 0x77,
+	0x6b, 0x40, 0xe8, 0xcf, 0x5a, 0x25, 0x22, 0x63, 0xe9, 0xed, 0xa0, 0x1e, 0xed, 0x18, 0xb9, 0x9a,
+	0x5a, 0xcf, 0x3b, 0x70, 0x35, 0x37, 0x41, 0xaa, 0x3b, 0x00, 0x42, 0xb7, 0x1c, 0xdf, 0x6c, 0xaf,
+	0x72, 0xd7, 0xd1, 0x89, 0xf6, 0x17, 0xf3, 0x48, 0x0e, 0x11, 0x96, 0x1e, 0xc2, 0x4c, 0x18, 0xf9,
+	0x24, 0x0a, 0x3c, 0x10, 0xa5, 0x9d, 0xb1, 0xad, 0xf6, 0x83, 0x73, 0x9c, 0xe5, 0x28, 0xa7, 0x0f,
+	0x6f, 0x69, 0xa0, 0x5b, 0x5a, 0x9f, 0x5b, 0x98, 0x09, 0xe7, 0x7e, 0xba, 0x00, 0xea, 0x6c, 0x01,
+	0xd4, 0xef, 0x05, 0x50, 0x3f, 0x97, 0x40, 0x99, 0x2d, 0x81, 0xf2, 0xb5, 0x04, 0xca, 0x4b, 0x23,
+	0xa0, 0xb2, 0xf7, 0xe6, 0x5b, 0x98, 0x87, 0x36, 0x8d, 0xa8, 0xa4, 0xe8, 0x82, 0x21, 0x5f, 0xd8,
+	0x9b, 0x5a, 0xc7, 0xbf, 0x8a, 0x4d, 0x5a, 0xf5, 0x0b, 0x49, 0xad, 0x97, 0x3f, 0x03, 0x00, 0x35,
+	0x32, 0x33, 0x9d, 0x71, 0x02, 0x00, 0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractAcls) > 0 {
+		for iNdEx := len(m.ContractAcls) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContractAcls[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.NextFailedCallbackId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextFailedCallbackId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.FailedCallbacks) > 0 {
+		for iNdEx := len(m.FailedCallbacks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FailedCallbacks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.FailedCallbacks) > 0 {
+		for _, e := range m.FailedCallbacks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextFailedCallbackId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextFailedCallbackId))
+	}
+	if len(m.ContractAcls) > 0 {
+		for _, e := range m.ContractAcls {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedCallbacks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailedCallbacks = append(m.FailedCallbacks, FailedCallback{})
+			if err := m.FailedCallbacks[len(m.FailedCallbacks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextFailedCallbackId", wireType)
+			}
+			m.NextFailedCallbackId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextFailedCallbackId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAcls", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAcls = append(m.ContractAcls, ContractACL{})
+			if err := m.ContractAcls[len(m.ContractAcls)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGenesis(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGenesis
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGenesis
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGenesis
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGenesis        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGenesis          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGenesis = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,163 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authcodec "github.com/cosmos/cosmos-sdk/x/auth/codec"
+	"github.com/stretchr/testify/require"
+
+	"github.com/initia-labs/miniwasm/x/wasmhooks/types"
+)
+
+func TestGenesisState_Validate(t *testing.T) {
+	ac := authcodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	bytesAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	contract, err := ac.BytesToString(bytesAddr)
+	require.NoError(t, err, "address bytes encoding error")
+
+	callback := func(id uint64) types.FailedCallback {
+		return types.FailedCallback{
+			Id:           id,
+			Contract:     contract,
+			CallbackType: types.CallbackTypeAck,
+			Msg:          []byte(`{"foo":{}}`),
+		}
+	}
+
+	for _, tc := range []struct {
+		desc     string
+		genState *types.GenesisState
+		valid    bool
+	}{
+		{
+			desc:     "default is valid",
+			genState: types.DefaultGenesis(),
+			valid:    true,
+		},
+		{
+			desc: "valid genesis state",
+			genState: &types.GenesisState{
+				Params:               types.DefaultParams(),
+				FailedCallbacks:      []types.FailedCallback{callback(1), callback(2)},
+				NextFailedCallbackId: 3,
+			},
+			valid: true,
+		},
+		{
+			desc: "invalid params",
+			genState: &types.GenesisState{
+				Params:               types.NewParams(1, 0, types.AclModeRestricted),
+				NextFailedCallbackId: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "zero callback gas limit",
+			genState: &types.GenesisState{
+				Params:               types.NewParams(0, types.DefaultFailedCallbackExpiry, types.AclModeRestricted),
+				NextFailedCallbackId: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicate id",
+			genState: &types.GenesisState{
+				Params:               types.DefaultParams(),
+				FailedCallbacks:      []types.FailedCallback{callback(1), callback(1)},
+				NextFailedCallbackId: 2,
+			},
+			valid: false,
+		},
+		{
+			desc: "id not less than next id",
+			genState: &types.GenesisState{
+				Params:               types.DefaultParams(),
+				FailedCallbacks:      []types.FailedCallback{callback(2)},
+				NextFailedCallbackId: 2,
+			},
+			valid: false,
+		},
+		{
+			desc: "valid contract acls",
+			genState: &types.GenesisState{
+				Params:               types.DefaultParams(),
+				NextFailedCallbackId: 1,
+				ContractAcls: []types.ContractACL{
+					types.NewContractACL(contract, true, true, []string{"channel-0", "channel-1"}, []string{"sender"}),
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicate contract acl",
+			genState: &types.GenesisState{
+				Params:               types.DefaultParams(),
+				NextFailedCallbackId: 1,
+				ContractAcls: []types.ContractACL{
+					types.NewContractACL(contract, true, true, nil, nil),
+					types.NewContractACL(contract, false, true, nil, nil),
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid acl channel",
+			genState: &types.GenesisState{
+				Params:               types.DefaultParams(),
+				NextFailedCallbackId: 1,
+				ContractAcls: []types.ContractACL{
+					types.NewContractACL(contract, true, true, []string{"channel/0"}, nil),
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid acl mode",
+			genState: &types.GenesisState{
+				Params:               types.NewParams(1, 1, types.AclMode(100)),
+				NextFailedCallbackId: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid callback type",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				FailedCallbacks: []types.FailedCallback{{
+					Id:         1,
+					Contract:   contract,
+					Msg:        []byte(`{"foo":{}}`),
+					ExpireTime: time.Now(),
+				}},
+				NextFailedCallbackId: 2,
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid msg",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				FailedCallbacks: []types.FailedCallback{{
+					Id:           1,
+					Contract:     contract,
+					CallbackType: types.CallbackTypeTimeout,
+					Msg:          []byte(`not json`),
+				}},
+				NextFailedCallbackId: 2,
+			},
+			valid: false,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.genState.Validate(ac)
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
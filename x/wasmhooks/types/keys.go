@@ -0,0 +1,24 @@
+package types
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "wasmhooks"
+
+	// StoreKey defines the primary module store key; it must not share
+	// a prefix with the wasm module store key.
+	StoreKey = "hooks-for-wasm"
+
+	// RouterKey is the message route for the wasmhooks module
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+)
+
+var (
+	ParamsKey                  = []byte{0x11}
+	NextFailedCallbackIdKey    = []byte{0x12}
+	FailedCallbacksPrefix      = []byte{0x13}
+	FailedCallbackExpiryPrefix = []byte{0x14}
+	ContractACLsPrefix         = []byte{0x15}
+)
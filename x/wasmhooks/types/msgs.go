@@ -0,0 +1,68 @@
+package types
+
+import (
+	"cosmossdk.io/core/address"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ sdk.Msg = &MsgRetryHookCallback{}
+	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgSetContractACL{}
+	_ sdk.Msg = &MsgRemoveContractACL{}
+)
+
+// NewMsgRetryHookCallback creates a msg to retry a failed callback
+func NewMsgRetryHookCallback(sender string, id uint64) *MsgRetryHookCallback {
+	return &MsgRetryHookCallback{
+		Sender: sender,
+		Id:     id,
+	}
+}
+
+func (m MsgRetryHookCallback) Validate(accAddrCodec address.Codec) error {
+	if addr, err := accAddrCodec.StringToBytes(m.Sender); err != nil {
+		return err
+	} else if len(addr) == 0 {
+		return ErrEmptySender
+	}
+
+	return nil
+}
+
+// NewMsgSetContractACL creates a msg to set the acl of a contract
+func NewMsgSetContractACL(authority string, acl ContractACL) *MsgSetContractACL {
+	return &MsgSetContractACL{
+		Authority: authority,
+		Acl:       acl,
+	}
+}
+
+func (m MsgSetContractACL) Validate(accAddrCodec address.Codec) error {
+	if _, err := accAddrCodec.StringToBytes(m.Authority); err != nil {
+		return err
+	}
+
+	return m.Acl.Validate(accAddrCodec)
+}
+
+// NewMsgRemoveContractACL creates a msg to remove the acl of a contract
+func NewMsgRemoveContractACL(authority string, contract string) *MsgRemoveContractACL {
+	return &MsgRemoveContractACL{
+		Authority: authority,
+		Contract:  contract,
+	}
+}
+
+func (m MsgRemoveContractACL) Validate(accAddrCodec address.Codec) error {
+	if _, err := accAddrCodec.StringToBytes(m.Authority); err != nil {
+		return err
+	}
+
+	if _, err := accAddrCodec.StringToBytes(m.Contract); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	"errors"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+const (
+	// DefaultCallbackGasLimit is the default gas limit for an async callback.
+	DefaultCallbackGasLimit = uint64(1_000_000)
+
+	// DefaultSimulationGasLimit is the default gas limit of the SimulateIBCHook
+	// query, which is used unless the wasm simulation gas limit is configured.
+	DefaultSimulationGasLimit = uint64(3_000_000)
+
+	// DefaultFailedCallbackExpiry is the default retention period of a failed callback.
+	DefaultFailedCallbackExpiry = 7 * 24 * time.Hour
+)
+
+func NewParams(callbackGasLimit uint64, failedCallbackExpiry time.Duration, aclMode AclMode) Params {
+	return Params{
+		CallbackGasLimit:     callbackGasLimit,
+		FailedCallbackExpiry: failedCallbackExpiry,
+		AclMode:              aclMode,
+	}
+}
+
+// DefaultParams returns default wasmhooks module parameters.
+func DefaultParams() Params {
+	return NewParams(DefaultCallbackGasLimit, DefaultFailedCallbackExpiry, AclModeRestricted)
+}
+
+// Validate performs basic validation on wasmhooks parameters.
+func (p Params) Validate() error {
+	// the callback is executed with the gas meter of the parent context when the
+	// gas limit is zero, so it must be bounded by a non-zero limit.
+	if p.CallbackGasLimit == 0 {
+		return errors.New("callback gas limit must be positive")
+	}
+
+	if p.FailedCallbackExpiry <= 0 {
+		return errors.New("failed callback expiry must be positive")
+	}
+
+	if _, ok := AclMode_name[int32(p.AclMode)]; !ok {
+		return errorsmod.Wrapf(ErrInvalidAclMode, "%d", p.AclMode)
+	}
+
+	return nil
+}
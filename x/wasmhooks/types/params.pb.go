@@ -0,0 +1,446 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: miniwasm/wasmhooks/v1/params.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// AclMode defines the global access control mode of wasm hooks.
+type AclMode int32
+
+const (
+	// ACL_MODE_RESTRICTED uses the contract acl of the contract if exists,
+	// and falls back to the ibchooks allowed flag otherwise.
+	AclModeRestricted AclMode = 0
+	// ACL_MODE_OPEN allows all contracts to be used in wasm hooks. This mode
+	// is intended for devnets.
+	AclModeOpen AclMode = 1
+)
+
+var AclMode_name = map[int32]string{
+	0: "ACL_MODE_RESTRICTED",
+	1: "ACL_MODE_OPEN",
+}
+
+var AclMode_value = map[string]int32{
+	"ACL_MODE_RESTRICTED": 0,
+	"ACL_MODE_OPEN":       1,
+}
+
+func (x AclMode) String() string {
+	return proto.EnumName(AclMode_name, int32(x))
+}
+
+func (AclMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_c8635c64b60d01f1, []int{0}
+}
+
+// Params defines the parameters for the wasmhooks module.
+type Params struct {
+	// CallbackGasLimit defines the maximum amount of gas an async callback
+	// (ack or timeout) can consume. The relayer pays for the callback, so the
+	// limit prevents a contract from griefing relayers. Zero disables the limit.
+	CallbackGasLimit uint64 `protobuf:"varint,1,opt,name=callback_gas_limit,json=callbackGasLimit,proto3" json:"callback_gas_limit,omitempty" yaml:"callback_gas_limit"`
+	// FailedCallbackExpiry defines how long a failed callback is kept in the
+	// retry queue before it is pruned.
+	FailedCallbackExpiry time.Duration `protobuf:"bytes,2,opt,name=failed_callback_expiry,json=failedCallbackExpiry,proto3,stdduration" json:"failed_callback_expiry" yaml:"failed_callback_expiry"`
+	// AclMode defines how the hook permissions of contracts are resolved.
+	AclMode AclMode `protobuf:"varint,3,opt,name=acl_mode,json=aclMode,proto3,enum=miniwasm.wasmhooks.v1.AclMode" json:"acl_mode,omitempty" yaml:"acl_mode"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8635c64b60d01f1, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetCallbackGasLimit() uint64 {
+	if m != nil {
+		return m.CallbackGasLimit
+	}
+	return 0
+}
+
+func (m *Params) GetFailedCallbackExpiry() time.Duration {
+	if m != nil {
+		return m.FailedCallbackExpiry
+	}
+	return 0
+}
+
+func (m *Params) GetAclMode() AclMode {
+	if m != nil {
+		return m.AclMode
+	}
+	return AclModeRestricted
+}
+
+func init() {
+	proto.RegisterEnum("miniwasm.wasmhooks.v1.AclMode", AclMode_name, AclMode_value)
+	proto.RegisterType((*Params)(nil), "miniwasm.wasmhooks.v1.Params")
+}
+
+func init() {
+	proto.RegisterFile("miniwasm/wasmhooks/v1/params.proto", fileDescriptor_c8635c64b60d01f1)
+}
+
+var fileDescriptor_c8635c64b60d01f1 = []byte{
+	// 424 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0x4f, 0x6f, 0xd3, 0x30,
+	0x14, 0xc0, 0xe3, 0x31, 0x6d, 0xc8, 0x13, 0xac, 0x64, 0x1b, 0xea, 0x22, 0xcd, 0x89, 0x72, 0x2a,
+	0x48, 0xd8, 0xea, 0xb8, 0x71, 0x5b, 0xdb, 0x08, 0xa1, 0xfd, 0xe9, 0x14, 0x76, 0xe2, 0x12, 0x39,
+	0x89, 0x97, 0x59, 0x73, 0xea, 0x28, 0x76, 0xc7, 0xca, 0x27, 0x40, 0x3b, 0x71, 0xe4, 0xb2, 0x03,
+	0xe2, 0xcb, 0xec, 0xb8, 0x23, 0xa7, 0x82, 0xda, 0x6f, 0xd0, 0x4f, 0x80, 0x9a, 0x7f, 0x42, 0xa2,
+	0x17, 0xcb, 0x7e, 0xbf, 0x9f, 0xdf, 0x7b, 0x7a, 0x7a, 0xd0, 0x4d, 0xf9, 0x88, 0x7f, 0xa6, 0x2a,
+	0x25, 0xcb, 0xe3, 0x4a, 0xca, 0x6b, 0x45, 0x6e, 0xba, 0x24, 0xa3, 0x39, 0x4d, 0x15, 0xce, 0x72,
+	0xa9, 0xa5, 0xb9, 0x57, 0x3b, 0xb8, 0x71, 0xf0, 0x4d, 0xd7, 0xda, 0x4d, 0x64, 0x22, 0x0b, 0x83,
+	0x2c, 0x6f, 0xa5, 0x6c, 0xa1, 0x44, 0xca, 0x44, 0x30, 0x52, 0xbc, 0xc2, 0xf1, 0x25, 0x89, 0xc7,
+	0x39, 0xd5, 0x5c, 0x8e, 0x4a, 0xee, 0xfe, 0x58, 0x83, 0x1b, 0xe7, 0x45, 0x76, 0xf3, 0x18, 0x9a,
+	0x11, 0x15, 0x22, 0xa4, 0xd1, 0x75, 0x90, 0x50, 0x15, 0x08, 0x9e, 0x72, 0xdd, 0x06, 0x0e, 0xe8,
+	0xac, 0xf7, 0x0e, 0x16, 0x53, 0x7b, 0x7f, 0x42, 0x53, 0xf1, 0xce, 0xfd, 0xdf, 0x71, 0xfd, 0x56,
+	0x1d, 0x7c, 0x4f, 0xd5, 0xc9, 0x32, 0x64, 0x7e, 0x81, 0x2f, 0x2f, 0x29, 0x17, 0x2c, 0x0e, 0x1a,
+	0x9f, 0xdd, 0x66, 0x3c, 0x9f, 0xb4, 0xd7, 0x1c, 0xd0, 0xd9, 0x3a, 0xdc, 0xc7, 0x65, 0x63, 0xb8,
+	0x6e, 0x0c, 0x0f, 0xaa, 0xc6, 0x7a, 0xaf, 0x1e, 0xa6, 0xb6, 0xb1, 0x98, 0xda, 0x07, 0x65, 0xbd,
+	0xd5, 0x69, 0xdc, 0xef, 0xbf, 0x6d, 0xe0, 0xef, 0x96, 0xb0, 0x5f, 0x31, 0xaf, 0x40, 0xe6, 0x19,
+	0x7c, 0x4a, 0x23, 0x11, 0xa4, 0x32, 0x66, 0xed, 0x27, 0x0e, 0xe8, 0x3c, 0x3f, 0x44, 0x78, 0xe5,
+	0xcc, 0xf0, 0x51, 0x24, 0x4e, 0x65, 0xcc, 0x7a, 0x3b, 0x8b, 0xa9, 0xbd, 0x5d, 0x96, 0xab, 0x7f,
+	0xba, 0xfe, 0x26, 0x2d, 0xe9, 0xeb, 0x08, 0x6e, 0x56, 0xa2, 0x89, 0xe1, 0xce, 0x51, 0xff, 0x24,
+	0x38, 0x1d, 0x0e, 0xbc, 0xc0, 0xf7, 0x3e, 0x5e, 0xf8, 0x1f, 0xfa, 0x17, 0xde, 0xa0, 0x65, 0x58,
+	0x7b, 0x77, 0xf7, 0xce, 0x8b, 0xca, 0xf2, 0x99, 0xd2, 0x39, 0x8f, 0x34, 0x8b, 0x4d, 0x17, 0x3e,
+	0x6b, 0xfc, 0xe1, 0xb9, 0x77, 0xd6, 0x02, 0xd6, 0xf6, 0xdd, 0xbd, 0xb3, 0x55, 0x99, 0xc3, 0x8c,
+	0x8d, 0xac, 0xf5, 0xaf, 0x3f, 0x91, 0xd1, 0x3b, 0x7e, 0x98, 0x21, 0xf0, 0x38, 0x43, 0xe0, 0xcf,
+	0x0c, 0x81, 0x6f, 0x73, 0x64, 0x3c, 0xce, 0x91, 0xf1, 0x6b, 0x8e, 0x8c, 0x4f, 0xdd, 0x84, 0xeb,
+	0xab, 0x71, 0x88, 0x23, 0x99, 0x12, 0x3e, 0xe2, 0x9a, 0xd3, 0x37, 0x82, 0x86, 0x8a, 0x34, 0xab,
+	0x72, 0xfb, 0xcf, 0xb2, 0xe8, 0x49, 0xc6, 0x54, 0xb8, 0x51, 0x4c, 0xf5, 0xed, 0xdf, 0x01, 0x00,
+	0x32, 0x3e, 0x19, 0x0b, 0x4f, 0x02, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AclMode != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AclMode))
+		i--
+		dAtA[i] = 0x18
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.FailedCallbackExpiry, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.FailedCallbackExpiry):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x12
+	if m.CallbackGasLimit != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.CallbackGasLimit))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CallbackGasLimit != 0 {
+		n += 1 + sovParams(uint64(m.CallbackGasLimit))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.FailedCallbackExpiry)
+	n += 1 + l + sovParams(uint64(l))
+	if m.AclMode != 0 {
+		n += 1 + sovParams(uint64(m.AclMode))
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackGasLimit", wireType)
+			}
+			m.CallbackGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CallbackGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedCallbackExpiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.FailedCallbackExpiry, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AclMode", wireType)
+			}
+			m.AclMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AclMode |= AclMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
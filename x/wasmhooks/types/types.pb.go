@@ -0,0 +1,1315 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: miniwasm/wasmhooks/v1/types.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_CosmWasm_wasmd_x_wasm_types "github.com/CosmWasm/wasmd/x/wasm/types"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// CallbackType defines the kind of packet lifecycle event which triggered
+// an async callback.
+type CallbackType int32
+
+const (
+	// CALLBACK_TYPE_UNSPECIFIED defines an invalid callback type.
+	CallbackTypeUnspecified CallbackType = 0
+	// CALLBACK_TYPE_ACK defines a callback for a packet acknowledgement.
+	CallbackTypeAck CallbackType = 1
+	// CALLBACK_TYPE_TIMEOUT defines a callback for a packet timeout.
+	CallbackTypeTimeout CallbackType = 2
+)
+
+var CallbackType_name = map[int32]string{
+	0: "CALLBACK_TYPE_UNSPECIFIED",
+	1: "CALLBACK_TYPE_ACK",
+	2: "CALLBACK_TYPE_TIMEOUT",
+}
+
+var CallbackType_value = map[string]int32{
+	"CALLBACK_TYPE_UNSPECIFIED": 0,
+	"CALLBACK_TYPE_ACK":         1,
+	"CALLBACK_TYPE_TIMEOUT":     2,
+}
+
+func (x CallbackType) String() string {
+	return proto.EnumName(CallbackType_name, int32(x))
+}
+
+func (CallbackType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_708cdffe94bc5d8f, []int{0}
+}
+
+// FailedCallback defines an async callback which failed to execute and
+// is waiting in the retry queue.
+type FailedCallback struct {
+	// id is the unique identifier of the failed callback.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// contract is the address of the callback contract.
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	// callback_type is the packet lifecycle event of the callback.
+	CallbackType CallbackType `protobuf:"varint,3,opt,name=callback_type,json=callbackType,proto3,enum=miniwasm.wasmhooks.v1.CallbackType" json:"callback_type,omitempty" yaml:"callback_type"`
+	// source_channel is the source channel of the packet.
+	SourceChannel string `protobuf:"bytes,4,opt,name=source_channel,json=sourceChannel,proto3" json:"source_channel,omitempty" yaml:"source_channel"`
+	// sequence is the sequence of the packet.
+	Sequence uint64 `protobuf:"varint,5,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// msg is the json encoded sudo message delivered to the contract.
+	Msg github_com_CosmWasm_wasmd_x_wasm_types.RawContractMessage `protobuf:"bytes,6,opt,name=msg,proto3,casttype=github.com/CosmWasm/wasmd/x/wasm/types.RawContractMessage" json:"msg,omitempty"`
+	// error is the error returned by the last execution attempt.
+	Error string `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	// expire_time is the time after which the callback is pruned.
+	ExpireTime time.Time `protobuf:"bytes,8,opt,name=expire_time,json=expireTime,proto3,stdtime" json:"expire_time" yaml:"expire_time"`
+}
+
+func (m *FailedCallback) Reset()         { *m = FailedCallback{} }
+func (m *FailedCallback) String() string { return proto.CompactTextString(m) }
+func (*FailedCallback) ProtoMessage()    {}
+func (*FailedCallback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_708cdffe94bc5d8f, []int{0}
+}
+func (m *FailedCallback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FailedCallback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FailedCallback.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FailedCallback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FailedCallback.Merge(m, src)
+}
+func (m *FailedCallback) XXX_Size() int {
+	return m.Size()
+}
+func (m *FailedCallback) XXX_DiscardUnknown() {
+	xxx_messageInfo_FailedCallback.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FailedCallback proto.InternalMessageInfo
+
+func (m *FailedCallback) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *FailedCallback) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *FailedCallback) GetCallbackType() CallbackType {
+	if m != nil {
+		return m.CallbackType
+	}
+	return CallbackTypeUnspecified
+}
+
+func (m *FailedCallback) GetSourceChannel() string {
+	if m != nil {
+		return m.SourceChannel
+	}
+	return ""
+}
+
+func (m *FailedCallback) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *FailedCallback) GetMsg() github_com_CosmWasm_wasmd_x_wasm_types.RawContractMessage {
+	if m != nil {
+		return m.Msg
+	}
+	return nil
+}
+
+func (m *FailedCallback) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *FailedCallback) GetExpireTime() time.Time {
+	if m != nil {
+		return m.ExpireTime
+	}
+	return time.Time{}
+}
+
+// ContractACL defines the wasm hook permissions of a contract. When a
+// contract has an acl, it overrides the ibchooks allowed flag of the contract.
+type ContractACL struct {
+	// contract is the address of the contract.
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// allow_receive defines whether the contract can be executed by the
+	// received packets.
+	AllowReceive bool `protobuf:"varint,2,opt,name=allow_receive,json=allowReceive,proto3" json:"allow_receive,omitempty" yaml:"allow_receive"`
+	// allow_callback defines whether the contract can receive the async
+	// callbacks (ack and timeout) of the sent packets.
+	AllowCallback bool `protobuf:"varint,3,opt,name=allow_callback,json=allowCallback,proto3" json:"allow_callback,omitempty" yaml:"allow_callback"`
+	// channels defines the local channels the contract is allowed on. Empty
+	// means all channels are allowed.
+	Channels []string `protobuf:"bytes,4,rep,name=channels,proto3" json:"channels,omitempty"`
+	// senders defines the counterparty senders which are allowed to execute
+	// the contract. Empty means all senders are allowed. It is not applied to
+	// the async callbacks.
+	Senders []string `protobuf:"bytes,5,rep,name=senders,proto3" json:"senders,omitempty"`
+}
+
+func (m *ContractACL) Reset()         { *m = ContractACL{} }
+func (m *ContractACL) String() string { return proto.CompactTextString(m) }
+func (*ContractACL) ProtoMessage()    {}
+func (*ContractACL) Descriptor() ([]byte, []int) {
+	return fileDescriptor_708cdffe94bc5d8f, []int{1}
+}
+func (m *ContractACL) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractACL) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractACL.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractACL) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractACL.Merge(m, src)
+}
+func (m *ContractACL) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractACL) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractACL.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractACL proto.InternalMessageInfo
+
+func (m *ContractACL) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *ContractACL) GetAllowReceive() bool {
+	if m != nil {
+		return m.AllowReceive
+	}
+	return false
+}
+
+func (m *ContractACL) GetAllowCallback() bool {
+	if m != nil {
+		return m.AllowCallback
+	}
+	return false
+}
+
+func (m *ContractACL) GetChannels() []string {
+	if m != nil {
+		return m.Channels
+	}
+	return nil
+}
+
+func (m *ContractACL) GetSenders() []string {
+	if m != nil {
+		return m.Senders
+	}
+	return nil
+}
+
+// HookPacketData defines a protobuf envelope for the packets of the wasm
+// ports, which requests wasm hook features for arbitrary packet data. The
+// envelope is packed into google.protobuf.Any and unwrapped before the
+// packet is delivered to the contracts.
+type HookPacketData struct {
+	// data is the original packet data.
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// async_callback is the address of the contract which receives the ack
+	// or timeout callback of the packet.
+	AsyncCallback string `protobuf:"bytes,2,opt,name=async_callback,json=asyncCallback,proto3" json:"async_callback,omitempty"`
+}
+
+func (m *HookPacketData) Reset()         { *m = HookPacketData{} }
+func (m *HookPacketData) String() string { return proto.CompactTextString(m) }
+func (*HookPacketData) ProtoMessage()    {}
+func (*HookPacketData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_708cdffe94bc5d8f, []int{2}
+}
+func (m *HookPacketData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HookPacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HookPacketData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HookPacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HookPacketData.Merge(m, src)
+}
+func (m *HookPacketData) XXX_Size() int {
+	return m.Size()
+}
+func (m *HookPacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_HookPacketData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HookPacketData proto.InternalMessageInfo
+
+func (m *HookPacketData) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *HookPacketData) GetAsyncCallback() string {
+	if m != nil {
+		return m.AsyncCallback
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("miniwasm.wasmhooks.v1.CallbackType", CallbackType_name, CallbackType_value)
+	proto.RegisterType((*FailedCallback)(nil), "miniwasm.wasmhooks.v1.FailedCallback")
+	proto.RegisterType((*ContractACL)(nil), "miniwasm.wasmhooks.v1.ContractACL")
+	proto.RegisterType((*HookPacketData)(nil), "miniwasm.wasmhooks.v1.HookPacketData")
+}
+
+func init() { proto.RegisterFile("miniwasm/wasmhooks/v1/types.proto", fileDescriptor_708cdffe94bc5d8f) }
+
+var fileDescriptor_708cdffe94bc5d8f = []byte{
+	// 717 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xc1, 0x4e, 0xdb, 0x4a,
+	0x14, 0x8d, 0x93, 0x00, 0x61, 0x48, 0xf2, 0x78, 0x43, 0x10, 0xc6, 0x4f, 0x72, 0xfc, 0xfc, 0x36,
+	0x11, 0x12, 0xb6, 0xe0, 0x75, 0x53, 0x24, 0xd4, 0x26, 0x26, 0xa8, 0x08, 0x28, 0xc8, 0x04, 0x55,
+	0x6d, 0x17, 0xd1, 0x64, 0x3c, 0x98, 0x51, 0x6c, 0x4f, 0xea, 0x71, 0x80, 0xfc, 0x41, 0xc5, 0x8a,
+	0x1f, 0x60, 0xd5, 0x1f, 0xe8, 0xa2, 0x1f, 0xc1, 0x12, 0x75, 0xd5, 0x45, 0x95, 0x56, 0xf0, 0x07,
+	0x2c, 0x59, 0x55, 0xb6, 0xe3, 0xd4, 0x91, 0x2a, 0xb5, 0x9b, 0xc4, 0xe7, 0xde, 0x73, 0x66, 0xee,
+	0xf5, 0x39, 0x32, 0xf8, 0xd7, 0xa5, 0x1e, 0x3d, 0x47, 0xdc, 0xd5, 0xc3, 0x9f, 0x53, 0xc6, 0xba,
+	0x5c, 0x3f, 0x5b, 0xd3, 0x83, 0x41, 0x8f, 0x70, 0xad, 0xe7, 0xb3, 0x80, 0xc1, 0xc5, 0x84, 0xa2,
+	0x8d, 0x29, 0xda, 0xd9, 0x9a, 0xb4, 0x8c, 0x19, 0x77, 0x19, 0x6f, 0x47, 0x24, 0x3d, 0x06, 0xb1,
+	0x42, 0xaa, 0xd8, 0xcc, 0x66, 0x71, 0x3d, 0x7c, 0x1a, 0x55, 0xab, 0x36, 0x63, 0xb6, 0x43, 0xf4,
+	0x08, 0x75, 0xfa, 0x27, 0x7a, 0x40, 0x5d, 0xc2, 0x03, 0xe4, 0xf6, 0x62, 0x82, 0xfa, 0x35, 0x07,
+	0xca, 0xdb, 0x88, 0x3a, 0xc4, 0x32, 0x90, 0xe3, 0x74, 0x10, 0xee, 0xc2, 0x32, 0xc8, 0x52, 0x4b,
+	0x14, 0x14, 0xa1, 0x96, 0x37, 0xb3, 0xd4, 0x82, 0x4f, 0x40, 0x01, 0x33, 0x2f, 0xf0, 0x11, 0x0e,
+	0xc4, 0xac, 0x22, 0xd4, 0x66, 0x1b, 0xe2, 0xe7, 0x4f, 0xab, 0x95, 0xd1, 0xed, 0x75, 0xcb, 0xf2,
+	0x09, 0xe7, 0x47, 0x81, 0x4f, 0x3d, 0xdb, 0x1c, 0x33, 0x61, 0x07, 0x94, 0xf0, 0xe8, 0xc4, 0x76,
+	0xb8, 0x99, 0x98, 0x53, 0x84, 0x5a, 0x79, 0xfd, 0x3f, 0xed, 0x97, 0x9b, 0x69, 0xc9, 0xed, 0xad,
+	0x41, 0x8f, 0x34, 0xc4, 0x87, 0x61, 0xb5, 0x32, 0x40, 0xae, 0xb3, 0xa1, 0x4e, 0x9c, 0xa1, 0x9a,
+	0x45, 0x9c, 0xe2, 0xc1, 0xe7, 0xa0, 0xcc, 0x59, 0xdf, 0xc7, 0xa4, 0x8d, 0x4f, 0x91, 0xe7, 0x11,
+	0x47, 0xcc, 0x47, 0xf3, 0x2d, 0x3f, 0x0c, 0xab, 0x8b, 0xb1, 0x7e, 0xb2, 0xaf, 0x9a, 0xa5, 0xb8,
+	0x60, 0xc4, 0x18, 0x4a, 0xa0, 0xc0, 0xc9, 0xbb, 0x3e, 0xf1, 0x30, 0x11, 0xa7, 0xa2, 0x8d, 0xc7,
+	0x18, 0x1e, 0x80, 0x9c, 0xcb, 0x6d, 0x71, 0x5a, 0x11, 0x6a, 0xc5, 0xc6, 0xe6, 0xe3, 0xb0, 0xfa,
+	0xd4, 0xa6, 0xc1, 0x69, 0xbf, 0xa3, 0x61, 0xe6, 0xea, 0x06, 0xe3, 0xee, 0xab, 0xc4, 0x42, 0x4b,
+	0xbf, 0x88, 0xfe, 0x47, 0x16, 0x9a, 0xe8, 0xdc, 0x18, 0xbd, 0x86, 0x7d, 0xc2, 0x39, 0xb2, 0x89,
+	0x19, 0x9e, 0x04, 0x2b, 0x60, 0x8a, 0xf8, 0x3e, 0xf3, 0xc5, 0x99, 0x70, 0x4a, 0x33, 0x06, 0xf0,
+	0x2d, 0x98, 0x23, 0x17, 0x3d, 0xea, 0x93, 0x76, 0xe8, 0x8d, 0x58, 0x50, 0x84, 0xda, 0xdc, 0xba,
+	0xa4, 0xc5, 0xc6, 0x69, 0x89, 0x71, 0x5a, 0x2b, 0x31, 0xae, 0x21, 0xdf, 0x0c, 0xab, 0x99, 0x87,
+	0x61, 0x15, 0xc6, 0x1b, 0xa6, 0xc4, 0xea, 0xd5, 0xb7, 0xaa, 0x60, 0x82, 0xb8, 0x12, 0x0a, 0xd4,
+	0x47, 0x01, 0xcc, 0x25, 0xb3, 0xd4, 0x8d, 0xbd, 0x09, 0x2f, 0x85, 0x3f, 0xf6, 0x72, 0x13, 0x94,
+	0x90, 0xe3, 0xb0, 0xf3, 0xb6, 0x4f, 0x30, 0xa1, 0x67, 0x24, 0x8a, 0x41, 0x21, 0x6d, 0xd3, 0x44,
+	0x5b, 0x35, 0x8b, 0x11, 0x36, 0x63, 0x18, 0xda, 0x14, 0xf7, 0x13, 0xf3, 0xa2, 0x2c, 0x14, 0xd2,
+	0x36, 0x4d, 0xf6, 0x55, 0x33, 0xbe, 0x6f, 0x1c, 0x49, 0x09, 0x14, 0x46, 0x0e, 0x72, 0x31, 0xaf,
+	0xe4, 0x6a, 0xb3, 0xe6, 0x18, 0x43, 0x11, 0xcc, 0x70, 0xe2, 0x59, 0xc4, 0xe7, 0xe2, 0x54, 0xd4,
+	0x4a, 0xa0, 0x4a, 0x40, 0xf9, 0x05, 0x63, 0xdd, 0x43, 0x84, 0xbb, 0x24, 0xd8, 0x42, 0x01, 0x82,
+	0x10, 0xe4, 0x2d, 0x14, 0xa0, 0x68, 0xf5, 0xa2, 0x19, 0x3d, 0xc3, 0x67, 0xa0, 0x8c, 0xf8, 0xc0,
+	0xc3, 0x3f, 0xa7, 0xfb, 0x5d, 0xc8, 0x4b, 0x11, 0x3f, 0x19, 0x6e, 0xe5, 0xa3, 0x00, 0x8a, 0xe9,
+	0xf8, 0xc2, 0x0d, 0xb0, 0x6c, 0xd4, 0xf7, 0xf6, 0x1a, 0x75, 0x63, 0xb7, 0xdd, 0x7a, 0x7d, 0xd8,
+	0x6c, 0x1f, 0xbf, 0x3c, 0x3a, 0x6c, 0x1a, 0x3b, 0xdb, 0x3b, 0xcd, 0xad, 0xf9, 0x8c, 0xf4, 0xcf,
+	0xe5, 0xb5, 0xb2, 0x94, 0x16, 0x1c, 0x7b, 0xbc, 0x47, 0x30, 0x3d, 0xa1, 0xc4, 0x82, 0x2b, 0xe0,
+	0xef, 0x49, 0x6d, 0xdd, 0xd8, 0x9d, 0x17, 0xa4, 0x85, 0xcb, 0x6b, 0xe5, 0xaf, 0xb4, 0xa6, 0x8e,
+	0xbb, 0x70, 0x1d, 0x2c, 0x4e, 0x72, 0x5b, 0x3b, 0xfb, 0xcd, 0x83, 0xe3, 0xd6, 0x7c, 0x56, 0x5a,
+	0xba, 0xbc, 0x56, 0x16, 0xd2, 0xfc, 0x30, 0x0d, 0xac, 0x1f, 0x48, 0xf9, 0xf7, 0x1f, 0xe4, 0x4c,
+	0x63, 0xf7, 0xe6, 0x4e, 0x16, 0x6e, 0xef, 0x64, 0xe1, 0xfb, 0x9d, 0x2c, 0x5c, 0xdd, 0xcb, 0x99,
+	0xdb, 0x7b, 0x39, 0xf3, 0xe5, 0x5e, 0xce, 0xbc, 0x59, 0x4b, 0x65, 0x9c, 0x7a, 0x34, 0xa0, 0x68,
+	0xd5, 0x41, 0x1d, 0xae, 0x8f, 0x3f, 0x59, 0x17, 0xa9, 0x8f, 0x56, 0x14, 0xf7, 0xce, 0x74, 0x94,
+	0xd1, 0xff, 0x7f, 0x0c, 0x00, 0xb2, 0xe9, 0x47, 0xf3, 0xd7, 0x04, 0x00, 0x00,
+}
+
+func (m *FailedCallback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FailedCallback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FailedCallback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExpireTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpireTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintTypes(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x42
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.SourceChannel) > 0 {
+		i -= len(m.SourceChannel)
+		copy(dAtA[i:], m.SourceChannel)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.SourceChannel)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.CallbackType != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CallbackType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractACL) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractACL) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractACL) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Senders) > 0 {
+		for iNdEx := len(m.Senders) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Senders[iNdEx])
+			copy(dAtA[i:], m.Senders[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Senders[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Channels) > 0 {
+		for iNdEx := len(m.Channels) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Channels[iNdEx])
+			copy(dAtA[i:], m.Channels[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Channels[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.AllowCallback {
+		i--
+		if m.AllowCallback {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AllowReceive {
+		i--
+		if m.AllowReceive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HookPacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HookPacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HookPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AsyncCallback) > 0 {
+		i -= len(m.AsyncCallback)
+		copy(dAtA[i:], m.AsyncCallback)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.AsyncCallback)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *FailedCallback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTypes(uint64(m.Id))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.CallbackType != 0 {
+		n += 1 + sovTypes(uint64(m.CallbackType))
+	}
+	l = len(m.SourceChannel)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovTypes(uint64(m.Sequence))
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpireTime)
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *ContractACL) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.AllowReceive {
+		n += 2
+	}
+	if m.AllowCallback {
+		n += 2
+	}
+	if len(m.Channels) > 0 {
+		for _, s := range m.Channels {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.Senders) > 0 {
+		for _, s := range m.Senders {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *HookPacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.AsyncCallback)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *FailedCallback) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FailedCallback: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FailedCallback: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackType", wireType)
+			}
+			m.CallbackType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CallbackType |= CallbackType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceChannel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceChannel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = append(m.Msg[:0], dAtA[iNdEx:postIndex]...)
+			if m.Msg == nil {
+				m.Msg = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpireTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExpireTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractACL) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractACL: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractACL: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowReceive", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowReceive = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowCallback", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowCallback = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channels", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channels = append(m.Channels, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Senders", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Senders = append(m.Senders, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HookPacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HookPacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HookPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AsyncCallback", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AsyncCallback = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTypes(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTypes
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTypes
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTypes
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTypes        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTypes          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTypes = fmt.Errorf("proto: unexpected end of group")
+)